@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2client"
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The state shared by the commands: global flags, standard streams and the connection.
+type application struct {
+	address     string
+	clientSet   *g2client.ClientSet
+	color       bool              // Colorize output; set by the shell command.
+	dialOptions []grpc.DialOption // Extra dial options; tests use them to reach an in-memory server.
+	output      string
+	stdin       io.Reader
+	stdout      io.Writer
+	timeout     time.Duration
+	tlsConfig   g2client.TLSConfig
+	useTLS      bool
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+func newApplication(stdin io.Reader, stdout io.Writer) *application {
+	return &application{
+		stdin:  stdin,
+		stdout: stdout,
+	}
+}
+
+/*
+The newRootCommand function returns the g2grpc command and its subcommands, bound to app.
+*/
+func newRootCommand(app *application) *cobra.Command {
+	defaultAddress := os.Getenv(addressEnvironmentVariable)
+	if defaultAddress == "" {
+		defaultAddress = g2client.DefaultAddress
+	}
+	command := &cobra.Command{
+		Use:          "g2grpc",
+		Short:        "Call a Senzing gRPC server",
+		Long:         "g2grpc calls a Senzing gRPC server, printing results as JSON or as tables.\nJSON inputs are read from an argument, from a file given by --file, or from standard input.",
+		SilenceUsage: true,
+	}
+	command.SetIn(app.stdin)
+	command.SetOut(app.stdout)
+	flags := command.PersistentFlags()
+	flags.StringVar(&app.address, "address", defaultAddress, "host:port of the Senzing gRPC server; defaults to $"+addressEnvironmentVariable)
+	flags.StringVarP(&app.output, "output", "o", outputJson, "output format: json or table")
+	flags.DurationVar(&app.timeout, "timeout", 0, "deadline of the whole command, e.g. 30s; 0 uses the SDK's per-method defaults")
+	flags.BoolVar(&app.useTLS, "tls", false, "connect with TLS, verifying the server with the system roots unless --tls-ca-file is set")
+	flags.StringVar(&app.tlsConfig.ServerCAFile, "tls-ca-file", "", "PEM bundle of CAs trusted to sign the server certificate; implies --tls")
+	flags.StringVar(&app.tlsConfig.ClientCertFile, "tls-cert-file", "", "PEM client certificate for mutual TLS; implies --tls")
+	flags.StringVar(&app.tlsConfig.ClientKeyFile, "tls-key-file", "", "PEM private key of --tls-cert-file")
+	flags.StringVar(&app.tlsConfig.ServerNameOverride, "tls-server-name", "", "name verified against the server certificate instead of the host of --address")
+	command.AddCommand(
+		newAddRecordCommand(app),
+		newConfigCommand(app),
+		newDeleteRecordCommand(app),
+		newDiagCommand(app),
+		newExportCommand(app),
+		newFindPathCommand(app),
+		newGetEntityCommand(app),
+		newHowCommand(app),
+		newProductCommand(app),
+		newRedoCommand(app),
+		newSearchCommand(app),
+		newShellCommand(app),
+		newWhyCommand(app),
+	)
+	return command
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Run the g2grpc command with arguments, closing the connection it opened.
+func run(app *application, args []string) error {
+	command := newRootCommand(app)
+	command.SetArgs(args)
+	err := command.Execute()
+	if closeErr := app.close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func parseEntityID(value string) (int64, error) {
+	entityID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid entity ID %q", value)
+	}
+	return entityID, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The ClientSet of the command, connected on first use.
+func (app *application) getClientSet(ctx context.Context) (*g2client.ClientSet, error) {
+	if app.clientSet != nil {
+		return app.clientSet, nil
+	}
+	options := []g2client.Option{
+		g2client.WithAddress(app.address),
+		g2client.WithDeadlines(g2deadline.NewDeadlines()),
+		g2client.WithDialOptions(app.dialOptions...),
+	}
+	if app.useTLS || app.tlsConfig != (g2client.TLSConfig{}) {
+		options = append(options, g2client.WithTLS(app.tlsConfig))
+	}
+	clientSet, err := g2client.NewClientSet(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	app.clientSet = clientSet
+	return clientSet, nil
+}
+
+func (app *application) close() error {
+	if app.clientSet == nil {
+		return nil
+	}
+	err := app.clientSet.Close()
+	app.clientSet = nil
+	return err
+}
+
+// The context of a command, with the deadline set by --timeout.
+func (app *application) context(command *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx := command.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if app.timeout > 0 {
+		return context.WithTimeout(ctx, app.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// The input of a command: the file named by file ("-" for standard input), else the argument, else standard input.
+func (app *application) readInput(file string, args []string) (string, error) {
+	switch {
+	case file == "-":
+	case file != "":
+		contents, err := os.ReadFile(file)
+		return string(contents), err
+	case len(args) > 0:
+		return strings.Join(args, " "), nil
+	}
+	contents, err := io.ReadAll(app.stdin)
+	return string(contents), err
+}
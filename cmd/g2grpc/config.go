@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
+	"github.com/spf13/cobra"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The output of config list.
+type configList struct {
+	Configs         []g2configmgr.ConfigListEntry `json:"CONFIGS"`
+	DefaultConfigID int64                         `json:"DEFAULT_CONFIG_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The configuration ID of an argument, else the default configuration ID.
+func configIDArgument(ctx context.Context, app *application, args []string) (int64, error) {
+	if len(args) == 1 {
+		configID, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid configuration ID %q", args[0])
+		}
+		return configID, nil
+	}
+	clientSet, err := app.getClientSet(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return clientSet.G2configmgr.GetDefaultConfigID(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// Tables
+// ----------------------------------------------------------------------------
+
+func configListTable(document string) ([]*table, error) {
+	configs := configList{}
+	if err := json.Unmarshal([]byte(document), &configs); err != nil {
+		return nil, err
+	}
+	result := &table{columns: []string{"CONFIG_ID", "SYS_CREATE_DT", "DEFAULT", "CONFIG_COMMENTS"}}
+	for _, config := range configs.Configs {
+		isDefault := ""
+		if config.ConfigID == configs.DefaultConfigID {
+			isDefault = "*"
+		}
+		result.rows = append(result.rows, []string{strconv.FormatInt(config.ConfigID, 10), config.SysCreateDt, isDefault, config.ConfigComments})
+	}
+	return []*table{result}, nil
+}
+
+func configTable(document string) ([]*table, error) {
+	config := struct {
+		G2Config struct {
+			CfgDsrc []g2config.DataSource `json:"CFG_DSRC"`
+		} `json:"G2_CONFIG"`
+	}{}
+	if err := json.Unmarshal([]byte(document), &config); err != nil {
+		return nil, err
+	}
+	result := &table{
+		columns: []string{"DSRC_ID", "DSRC_CODE"},
+		title:   "Data sources",
+	}
+	for _, dataSource := range config.G2Config.CfgDsrc {
+		result.rows = append(result.rows, []string{strconv.FormatInt(dataSource.DsrcID, 10), dataSource.DsrcCode})
+	}
+	return []*table{result}, nil
+}
+
+func publishTable(document string) ([]*table, error) {
+	published := g2configmgr.PublishResult{}
+	if err := json.Unmarshal([]byte(document), &published); err != nil {
+		return nil, err
+	}
+	result := &table{columns: []string{"PREVIOUS_CONFIG_ID", "CONFIG_ID", "ADDED_DATA_SOURCES"}}
+	added := []string{}
+	if published.Diff != nil {
+		for _, dataSource := range published.Diff.AddedDataSources {
+			added = append(added, dataSource.DsrcCode)
+		}
+	}
+	result.rows = append(result.rows, []string{strconv.FormatInt(published.PreviousConfigID, 10), strconv.FormatInt(published.ConfigID, 10), strings.Join(added, ",")})
+	return []*table{result}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Commands
+// ----------------------------------------------------------------------------
+
+func newConfigCommand(app *application) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "config",
+		Short: "Work with the configurations stored in the Senzing repository",
+	}
+	command.AddCommand(
+		newConfigAddDataSourceCommand(app),
+		newConfigListCommand(app),
+		newConfigSetDefaultCommand(app),
+		newConfigShowCommand(app),
+	)
+	return command
+}
+
+func newConfigAddDataSourceCommand(app *application) *cobra.Command {
+	var comments string
+	command := &cobra.Command{
+		Use:   "add-datasource DATA_SOURCE...",
+		Short: "Add data sources to the default configuration and publish it as the new default",
+		Long:  "Add data sources to the default configuration and publish it as the new default.\nData sources already in the configuration are skipped; if none are missing, no configuration is added.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			publisher := g2configmgr.NewPublisher(clientSet.G2config, clientSet.G2configmgr)
+			result, err := publisher.Publish(ctx, comments, func(ctx context.Context, session *g2config.ConfigSession) error {
+				dataSources, err := session.ListDataSources(ctx)
+				if err != nil {
+					return err
+				}
+				existing := map[string]bool{}
+				for _, dataSource := range dataSources {
+					existing[dataSource.DsrcCode] = true
+				}
+				for _, dataSourceCode := range args {
+					if existing[dataSourceCode] {
+						continue
+					}
+					if _, err := session.AddDataSource(ctx, dataSourceCode); err != nil {
+						return err
+					}
+					existing[dataSourceCode] = true
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			return app.printValue(result, publishTable)
+		},
+	}
+	command.Flags().StringVar(&comments, "comments", "Added data sources with g2grpc", "comments stored with the new configuration")
+	return command
+}
+
+func newConfigListCommand(app *application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the configurations, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			configs, err := g2configmgr.GetConfigHistory(ctx, clientSet.G2configmgr)
+			if err != nil {
+				return err
+			}
+			defaultConfigID, err := clientSet.G2configmgr.GetDefaultConfigID(ctx)
+			if err != nil {
+				return err
+			}
+			return app.printValue(configList{Configs: configs, DefaultConfigID: defaultConfigID}, configListTable)
+		},
+	}
+}
+
+func newConfigSetDefaultCommand(app *application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-default CONFIG_ID",
+		Short: "Make a configuration the default",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			configID, err := configIDArgument(ctx, app, args)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			if err := clientSet.G2configmgr.SetDefaultConfigID(ctx, configID); err != nil {
+				return err
+			}
+			return app.printValue(map[string]int64{"DEFAULT_CONFIG_ID": configID}, nil)
+		},
+	}
+}
+
+func newConfigShowCommand(app *application) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [CONFIG_ID]",
+		Short: "Show a configuration, by default the default configuration",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			configID, err := configIDArgument(ctx, app, args)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2configmgr.GetConfig(ctx, configID)
+			if err != nil {
+				return err
+			}
+			return app.print(result, configTable)
+		},
+	}
+}
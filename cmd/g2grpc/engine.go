@@ -0,0 +1,545 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	"github.com/spf13/cobra"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A record added or deleted by add-record or delete-record.
+type recordResult struct {
+	AffectedEntities []g2engine.AffectedEntity `json:"AFFECTED_ENTITIES,omitempty"`
+	DataSource       string                    `json:"DATA_SOURCE"`
+	RecordID         string                    `json:"RECORD_ID"`
+}
+
+// The output of add-record and delete-record.
+type recordResults struct {
+	Records []recordResult `json:"RECORDS"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func parseFlags(flagNames string) (int64, error) {
+	flags, err := g2flags.Parse(flagNames)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --flags: %w", err)
+	}
+	return flags.Int64(), nil
+}
+
+// The data source and record ID of a record: the flag values, else the DATA_SOURCE and RECORD_ID of its JSON.
+func recordKey(jsonData string, dataSourceCode string, recordID string) (string, string, error) {
+	record := struct {
+		DataSource string      `json:"DATA_SOURCE"`
+		RecordID   json.Number `json:"RECORD_ID"`
+	}{}
+	if err := json.Unmarshal([]byte(jsonData), &record); err != nil {
+		return "", "", fmt.Errorf("invalid record %q: %w", jsonData, err)
+	}
+	if dataSourceCode == "" {
+		dataSourceCode = record.DataSource
+	}
+	if recordID == "" {
+		recordID = record.RecordID.String()
+	}
+	if dataSourceCode == "" || recordID == "" {
+		return "", "", fmt.Errorf("record %q needs DATA_SOURCE and RECORD_ID, or --data-source and --record-id", jsonData)
+	}
+	return dataSourceCode, recordID, nil
+}
+
+// The records of an input: one JSON document, or JSON Lines.
+func splitRecords(input string) []string {
+	trimmed := strings.TrimSpace(input)
+	if json.Valid([]byte(trimmed)) {
+		return []string{trimmed}
+	}
+	result := []string{}
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+func entityIDs(affectedEntities []g2engine.AffectedEntity) string {
+	result := []string{}
+	for _, affectedEntity := range affectedEntities {
+		result = append(result, strconv.FormatInt(affectedEntity.EntityID, 10))
+	}
+	return strings.Join(result, ",")
+}
+
+// ----------------------------------------------------------------------------
+// Tables
+// ----------------------------------------------------------------------------
+
+func entityTable(document string) ([]*table, error) {
+	entity := g2engine.EntityResponse{}
+	if err := json.Unmarshal([]byte(document), &entity); err != nil {
+		return nil, err
+	}
+	resolved := entity.ResolvedEntity
+	records := &table{
+		columns: []string{"DATA_SOURCE", "RECORD_ID", "MATCH_KEY", "ERRULE_CODE"},
+		title:   fmt.Sprintf("Entity %d: %s", resolved.EntityID, resolved.EntityName),
+	}
+	for _, record := range resolved.Records {
+		records.rows = append(records.rows, []string{record.DataSource, record.RecordID, record.MatchKey, record.ErruleCode})
+	}
+	result := []*table{records}
+	if len(entity.RelatedEntities) > 0 {
+		related := &table{
+			columns: []string{"ENTITY_ID", "ENTITY_NAME", "MATCH_LEVEL_CODE", "MATCH_KEY"},
+			title:   "Related entities",
+		}
+		for _, relatedEntity := range entity.RelatedEntities {
+			related.rows = append(related.rows, []string{strconv.FormatInt(relatedEntity.EntityID, 10), relatedEntity.EntityName, relatedEntity.MatchLevelCode, relatedEntity.MatchKey})
+		}
+		result = append(result, related)
+	}
+	return result, nil
+}
+
+func howTable(document string) ([]*table, error) {
+	how := g2engine.HowResponse{}
+	if err := json.Unmarshal([]byte(document), &how); err != nil {
+		return nil, err
+	}
+	steps := how.HowResults.ResolutionSteps
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Step < steps[j].Step })
+	result := &table{columns: []string{"STEP", "RESULT_VIRTUAL_ENTITY_ID", "INBOUND_VIRTUAL_ENTITY_ID", "MATCH_KEY", "ERRULE_CODE"}}
+	for _, step := range steps {
+		result.rows = append(result.rows, []string{strconv.Itoa(step.Step), step.ResultVirtualEntityID, step.InboundVirtualEntityID, step.MatchInfo.MatchKey, step.MatchInfo.ErruleCode})
+	}
+	return []*table{result}, nil
+}
+
+func pathTable(document string) ([]*table, error) {
+	path := g2engine.PathResponse{}
+	if err := json.Unmarshal([]byte(document), &path); err != nil {
+		return nil, err
+	}
+	names := map[int64]string{}
+	for _, entity := range path.Entities {
+		names[entity.ResolvedEntity.EntityID] = entity.ResolvedEntity.EntityName
+	}
+	result := []*table{}
+	for _, entityPath := range path.EntityPaths {
+		pathEntities := &table{
+			columns: []string{"ENTITY_ID", "ENTITY_NAME"},
+			title:   fmt.Sprintf("Path from %d to %d", entityPath.StartEntityID, entityPath.EndEntityID),
+		}
+		for _, entityID := range entityPath.Entities {
+			pathEntities.rows = append(pathEntities.rows, []string{strconv.FormatInt(entityID, 10), names[entityID]})
+		}
+		result = append(result, pathEntities)
+	}
+	return result, nil
+}
+
+func recordTable(document string) ([]*table, error) {
+	records := recordResults{}
+	if err := json.Unmarshal([]byte(document), &records); err != nil {
+		return nil, err
+	}
+	result := &table{columns: []string{"DATA_SOURCE", "RECORD_ID", "AFFECTED_ENTITIES"}}
+	for _, record := range records.Records {
+		result.rows = append(result.rows, []string{record.DataSource, record.RecordID, entityIDs(record.AffectedEntities)})
+	}
+	return []*table{result}, nil
+}
+
+func searchTable(document string) ([]*table, error) {
+	search := g2engine.SearchResponse{}
+	if err := json.Unmarshal([]byte(document), &search); err != nil {
+		return nil, err
+	}
+	result := &table{columns: []string{"ENTITY_ID", "ENTITY_NAME", "MATCH_LEVEL_CODE", "MATCH_KEY", "ERRULE_CODE"}}
+	for _, found := range search.ResolvedEntities {
+		entity := found.Entity.ResolvedEntity
+		result.rows = append(result.rows, []string{strconv.FormatInt(entity.EntityID, 10), entity.EntityName, found.MatchInfo.MatchLevelCode, found.MatchInfo.MatchKey, found.MatchInfo.ErruleCode})
+	}
+	return []*table{result}, nil
+}
+
+func whyTable(document string) ([]*table, error) {
+	why := g2engine.WhyResponse{}
+	if err := json.Unmarshal([]byte(document), &why); err != nil {
+		return nil, err
+	}
+	result := &table{columns: []string{"ENTITY_ID", "ENTITY_ID_2", "MATCH_LEVEL_CODE", "WHY_KEY", "WHY_ERRULE_CODE"}}
+	for _, whyResult := range why.WhyResults {
+		matchInfo := whyResult.MatchInfo
+		result.rows = append(result.rows, []string{strconv.FormatInt(whyResult.EntityID, 10), strconv.FormatInt(whyResult.EntityID2, 10), matchInfo.MatchLevelCode, matchInfo.WhyKey, matchInfo.WhyErruleCode})
+	}
+	return []*table{result}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Commands
+// ----------------------------------------------------------------------------
+
+func newAddRecordCommand(app *application) *cobra.Command {
+	var dataSourceCode, file, loadID, recordID string
+	var withInfo bool
+	command := &cobra.Command{
+		Use:   "add-record [JSON]",
+		Short: "Add records",
+		Long:  "Add a record, or one record per line of JSON Lines input.\nThe data source and record ID come from --data-source and --record-id, else from DATA_SOURCE and RECORD_ID.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			input, err := app.readInput(file, args)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			results := recordResults{Records: []recordResult{}}
+			for _, jsonData := range splitRecords(input) {
+				recordDataSource, recordRecordID, err := recordKey(jsonData, dataSourceCode, recordID)
+				if err != nil {
+					return err
+				}
+				result := recordResult{DataSource: recordDataSource, RecordID: recordRecordID}
+				if withInfo {
+					info, err := (&g2engine.Typed{G2engine: clientSet.G2engine}).AddRecordWithInfo(ctx, recordDataSource, recordRecordID, jsonData, loadID, 0)
+					if err != nil {
+						return err
+					}
+					result.AffectedEntities = info.AffectedEntities
+				} else if err := clientSet.G2engine.AddRecord(ctx, recordDataSource, recordRecordID, jsonData, loadID); err != nil {
+					return err
+				}
+				results.Records = append(results.Records, result)
+			}
+			return app.printValue(results, recordTable)
+		},
+	}
+	command.Flags().StringVar(&dataSourceCode, "data-source", "", "data source of the records")
+	command.Flags().StringVarP(&file, "file", "f", "", "file of records; - for standard input")
+	command.Flags().StringVar(&loadID, "load-id", "", "load ID of the records")
+	command.Flags().StringVar(&recordID, "record-id", "", "record ID of a single record")
+	command.Flags().BoolVar(&withInfo, "with-info", false, "report the entities affected by each record")
+	return command
+}
+
+func newDeleteRecordCommand(app *application) *cobra.Command {
+	var dataSourceCode, loadID, recordID string
+	var withInfo bool
+	command := &cobra.Command{
+		Use:   "delete-record",
+		Short: "Delete a record",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result := recordResult{DataSource: dataSourceCode, RecordID: recordID}
+			if withInfo {
+				info, err := (&g2engine.Typed{G2engine: clientSet.G2engine}).DeleteRecordWithInfo(ctx, dataSourceCode, recordID, loadID, 0)
+				if err != nil {
+					return err
+				}
+				result.AffectedEntities = info.AffectedEntities
+			} else if err := clientSet.G2engine.DeleteRecord(ctx, dataSourceCode, recordID, loadID); err != nil {
+				return err
+			}
+			return app.printValue(recordResults{Records: []recordResult{result}}, recordTable)
+		},
+	}
+	command.Flags().StringVar(&dataSourceCode, "data-source", "", "data source of the record")
+	command.Flags().StringVar(&loadID, "load-id", "", "load ID of the record")
+	command.Flags().StringVar(&recordID, "record-id", "", "record ID of the record")
+	command.Flags().BoolVar(&withInfo, "with-info", false, "report the entities affected")
+	_ = command.MarkFlagRequired("data-source")
+	_ = command.MarkFlagRequired("record-id")
+	return command
+}
+
+func newExportCommand(app *application) *cobra.Command {
+	var columns, flagNames, format string
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export all entities, one JSON document or CSV line per line",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			engine := clientSet.G2engine.(*g2engine.G2engine)
+			var results <-chan g2engine.ExportResult
+			switch format {
+			case "csv":
+				results = engine.ExportCSVEntityReportIterator(ctx, columns, flags)
+			case "json":
+				results = engine.ExportJSONEntityReportIterator(ctx, flags)
+			default:
+				return fmt.Errorf("unknown --format %q; use \"json\" or \"csv\"", format)
+			}
+			for result := range results {
+				if result.Error != nil {
+					return result.Error
+				}
+				fmt.Fprint(app.stdout, result.Value)
+				if !strings.HasSuffix(result.Value, "\n") {
+					fmt.Fprintln(app.stdout)
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&columns, "columns", "*", "CSV columns, for --format csv")
+	command.Flags().StringVar(&flagNames, "flags", "G2_EXPORT_DEFAULT_FLAGS", "Senzing flags, e.g. \"G2_EXPORT_INCLUDE_ALL_ENTITIES|G2_ENTITY_INCLUDE_RECORD_DATA\"")
+	command.Flags().StringVar(&format, "format", "json", "json or csv")
+	return command
+}
+
+func newFindPathCommand(app *application) *cobra.Command {
+	var flagNames string
+	var maxDegree int
+	command := &cobra.Command{
+		Use:   "find-path ENTITY_ID ENTITY_ID",
+		Short: "Find the shortest path between two entities",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			entityID1, err := parseEntityID(args[0])
+			if err != nil {
+				return err
+			}
+			entityID2, err := parseEntityID(args[1])
+			if err != nil {
+				return err
+			}
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2engine.FindPathByEntityID_V2(ctx, entityID1, entityID2, maxDegree, flags)
+			if err != nil {
+				return err
+			}
+			return app.print(result, pathTable)
+		},
+	}
+	command.Flags().StringVar(&flagNames, "flags", "G2_FIND_PATH_DEFAULT_FLAGS", "Senzing flags")
+	command.Flags().IntVar(&maxDegree, "max-degree", 3, "longest path searched")
+	return command
+}
+
+func newGetEntityCommand(app *application) *cobra.Command {
+	var dataSourceCode, flagNames, recordID string
+	command := &cobra.Command{
+		Use:   "get-entity [ENTITY_ID]",
+		Short: "Get an entity by entity ID, or by the data source and record ID of one of its records",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			if (len(args) == 1) == (dataSourceCode != "" || recordID != "") {
+				return fmt.Errorf("give either ENTITY_ID or --data-source and --record-id")
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			var result string
+			if len(args) == 1 {
+				entityID, err := parseEntityID(args[0])
+				if err != nil {
+					return err
+				}
+				result, err = clientSet.G2engine.GetEntityByEntityID_V2(ctx, entityID, flags)
+				if err != nil {
+					return err
+				}
+			} else {
+				result, err = clientSet.G2engine.GetEntityByRecordID_V2(ctx, dataSourceCode, recordID, flags)
+				if err != nil {
+					return err
+				}
+			}
+			return app.print(result, entityTable)
+		},
+	}
+	command.Flags().StringVar(&dataSourceCode, "data-source", "", "data source of a record of the entity")
+	command.Flags().StringVar(&flagNames, "flags", "G2_ENTITY_DEFAULT_FLAGS", "Senzing flags")
+	command.Flags().StringVar(&recordID, "record-id", "", "record ID of a record of the entity")
+	return command
+}
+
+func newHowCommand(app *application) *cobra.Command {
+	var flagNames string
+	command := &cobra.Command{
+		Use:   "how ENTITY_ID",
+		Short: "Explain how an entity was resolved",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			entityID, err := parseEntityID(args[0])
+			if err != nil {
+				return err
+			}
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2engine.HowEntityByEntityID_V2(ctx, entityID, flags)
+			if err != nil {
+				return err
+			}
+			return app.print(result, howTable)
+		},
+	}
+	command.Flags().StringVar(&flagNames, "flags", "G2_HOW_ENTITY_DEFAULT_FLAGS", "Senzing flags")
+	return command
+}
+
+func newRedoCommand(app *application) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "redo",
+		Short: "Work with the redo queue",
+	}
+	drainCommand := &cobra.Command{
+		Use:   "drain",
+		Short: "Process redo records until the queue is empty",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			processed := 0
+			for {
+				redoRecord, err := clientSet.G2engine.ProcessRedoRecord(ctx)
+				if err != nil {
+					return err
+				}
+				if redoRecord == "" {
+					break
+				}
+				processed++
+			}
+			return app.printValue(map[string]int{"PROCESSED": processed}, nil)
+		},
+	}
+	command.AddCommand(drainCommand)
+	return command
+}
+
+func newSearchCommand(app *application) *cobra.Command {
+	var file, flagNames string
+	command := &cobra.Command{
+		Use:   "search [JSON]",
+		Short: "Search for entities by attributes, e.g. '{\"NAME_FULL\": \"Robert Smith\"}'",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			jsonData, err := app.readInput(file, args)
+			if err != nil {
+				return err
+			}
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2engine.SearchByAttributes_V2(ctx, strings.TrimSpace(jsonData), flags)
+			if err != nil {
+				return err
+			}
+			return app.print(result, searchTable)
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "", "file of search attributes; - for standard input")
+	command.Flags().StringVar(&flagNames, "flags", "G2_SEARCH_BY_ATTRIBUTES_DEFAULT_FLAGS", "Senzing flags")
+	return command
+}
+
+func newWhyCommand(app *application) *cobra.Command {
+	var flagNames string
+	command := &cobra.Command{
+		Use:   "why ENTITY_ID ENTITY_ID",
+		Short: "Explain why two entities did or did not resolve",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			entityID1, err := parseEntityID(args[0])
+			if err != nil {
+				return err
+			}
+			entityID2, err := parseEntityID(args[1])
+			if err != nil {
+				return err
+			}
+			flags, err := parseFlags(flagNames)
+			if err != nil {
+				return err
+			}
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2engine.WhyEntities_V2(ctx, entityID1, entityID2, flags)
+			if err != nil {
+				return err
+			}
+			return app.print(result, whyTable)
+		},
+	}
+	command.Flags().StringVar(&flagNames, "flags", "G2_WHY_ENTITY_DEFAULT_FLAGS", "Senzing flags")
+	return command
+}
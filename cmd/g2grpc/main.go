@@ -0,0 +1,45 @@
+/*
+ *
+ */
+
+// Command g2grpc calls a Senzing gRPC server from the command line.
+package main
+
+import (
+	"os"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Output formats accepted by --output.
+const (
+	outputJson  = "json"
+	outputTable = "table"
+)
+
+// ANSI colors of colorized output.
+const (
+	colorBold    = "\x1b[1m"
+	colorCyan    = "\x1b[36m"
+	colorGreen   = "\x1b[32m"
+	colorMagenta = "\x1b[35m"
+	colorRed     = "\x1b[31m"
+	colorReset   = "\x1b[0m"
+	colorYellow  = "\x1b[33m"
+)
+
+// Environment variable holding the default of --address.
+const addressEnvironmentVariable = "SENZING_TOOLS_GRPC_ADDRESS"
+
+// ----------------------------------------------------------------------------
+// Main
+// ----------------------------------------------------------------------------
+
+func main() {
+	app := newApplication(os.Stdin, os.Stdout)
+	if err := run(app, os.Args[1:]); err != nil {
+		os.Exit(1)
+	}
+}
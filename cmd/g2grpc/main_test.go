@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+var (
+	testServer *grpctest.Server
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Run g2grpc against the test server, returning its standard output.
+func runCommand(test *testing.T, stdin string, args ...string) (string, error) {
+	stdout := &bytes.Buffer{}
+	app := newApplication(strings.NewReader(stdin), stdout)
+	app.dialOptions = []grpc.DialOption{testServer.DialOption()}
+	err := run(app, args)
+	return stdout.String(), err
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	testServer = grpctest.NewServer()
+	code := m.Run()
+	testServer.Close()
+	os.Exit(code)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestG2grpc_AddRecord_Stdin(test *testing.T) {
+	testServer.Reset()
+	input := `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "NAME_FULL": "Bob Smith"}
+`
+	output, err := runCommand(test, input, "add-record", "--with-info")
+	testError(test, err)
+	results := recordResults{}
+	testError(test, json.Unmarshal([]byte(output), &results))
+	assert.Len(test, results.Records, 2)
+	assert.Equal(test, "2", results.Records[1].RecordID)
+	assert.NotEmpty(test, results.Records[1].AffectedEntities)
+}
+
+func TestG2grpc_AddRecord_MissingRecordID(test *testing.T) {
+	testServer.Reset()
+	_, err := runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST"}`)
+	assert.ErrorContains(test, err, "RECORD_ID")
+}
+
+func TestG2grpc_GetEntity_Table(test *testing.T) {
+	testServer.Reset()
+	_, err := runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`)
+	testError(test, err)
+	output, err := runCommand(test, "", "get-entity", "--data-source", "TEST", "--record-id", "1", "--output", "table")
+	testError(test, err)
+	assert.Contains(test, output, "Robert Smith")
+	assert.Contains(test, output, "DATA_SOURCE")
+}
+
+func TestG2grpc_GetEntity_InvalidEntityID(test *testing.T) {
+	_, err := runCommand(test, "", "get-entity", "one")
+	assert.ErrorContains(test, err, "invalid entity ID")
+}
+
+func TestG2grpc_Search(test *testing.T) {
+	testServer.Reset()
+	_, err := runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`)
+	testError(test, err)
+	output, err := runCommand(test, `{"NAME_FULL": "Robert Smith"}`, "search", "--output", "table")
+	testError(test, err)
+	assert.Contains(test, output, "ENTITY_ID")
+	assert.Contains(test, output, "Robert Smith")
+}
+
+func TestG2grpc_ConfigAddDataSource(test *testing.T) {
+	testServer.Reset()
+	_, err := runCommand(test, "", "config", "add-datasource", "CUSTOMERS", "TEST")
+	testError(test, err)
+	output, err := runCommand(test, "", "config", "show", "--output", "table")
+	testError(test, err)
+	assert.Contains(test, output, "CUSTOMERS")
+	output, err = runCommand(test, "", "config", "list")
+	testError(test, err)
+	configs := configList{}
+	testError(test, json.Unmarshal([]byte(output), &configs))
+	assert.Len(test, configs.Configs, 2)
+	assert.Equal(test, configs.Configs[1].ConfigID, configs.DefaultConfigID)
+}
+
+func TestG2grpc_ProductVersion(test *testing.T) {
+	output, err := runCommand(test, "", "product", "version")
+	testError(test, err)
+	assert.True(test, json.Valid([]byte(output)))
+}
+
+func TestG2grpc_DefaultDeadline(test *testing.T) {
+	var deadline time.Time
+	record := func(ctx context.Context, method string, request, reply interface{}, connection *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		deadline, _ = ctx.Deadline()
+		return invoker(ctx, method, request, reply, connection, opts...)
+	}
+	app := newApplication(strings.NewReader(""), &bytes.Buffer{})
+	app.dialOptions = []grpc.DialOption{testServer.DialOption(), grpc.WithChainUnaryInterceptor(record)}
+
+	// Without --timeout, calls get the SDK's per-method default deadline.
+	testError(test, run(app, []string{"product", "version"}))
+	assert.WithinDuration(test, time.Now().Add(g2deadline.DefaultTimeout), deadline, 10*time.Second)
+}
+
+func TestG2grpc_RedoDrain(test *testing.T) {
+	testServer.Reset()
+	testServer.AddRedoRecord(`{"DATA_SOURCE": "TEST", "RECORD_ID": "1"}`)
+	output, err := runCommand(test, "", "redo", "drain")
+	testError(test, err)
+	assert.JSONEq(test, `{"PROCESSED": 1}`, output)
+}
+
+func TestG2grpc_UnknownOutput(test *testing.T) {
+	_, err := runCommand(test, "", "product", "version", "--output", "xml")
+	assert.ErrorContains(test, err, "unknown output format")
+}
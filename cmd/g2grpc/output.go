@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Rows printed, under column headings, by the table output format.
+type table struct {
+	columns []string
+	rows    [][]string
+	title   string
+}
+
+// Builds the tables of a JSON document; nil uses keyValueTable().
+type tableFunc func(document string) ([]*table, error)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A table with a row for each top-level key of a JSON object, nested values printed as compact JSON.
+func keyValueTable(document string) ([]*table, error) {
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(document), &object); err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := &table{columns: []string{"KEY", "VALUE"}}
+	for _, key := range keys {
+		result.rows = append(result.rows, []string{key, cell(object[key])})
+	}
+	return []*table{result}, nil
+}
+
+// A JSON value as a table cell: strings unquoted, anything else compact JSON.
+func cell(value json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		return text
+	}
+	buffer := &bytes.Buffer{}
+	if err := json.Compact(buffer, value); err != nil {
+		return string(value)
+	}
+	return buffer.String()
+}
+
+// Wrap text in an ANSI color.
+func colorize(color string, text string) string {
+	return color + text + colorReset
+}
+
+// Colorize an indented JSON document: keys, strings, numbers and literals each in their own color.
+func colorizeJson(document string) string {
+	result := &strings.Builder{}
+	for index := 0; index < len(document); {
+		character := document[index]
+		switch {
+		case character == '"':
+			end := index + 1
+			for end < len(document) && document[end] != '"' {
+				if document[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end++; end > len(document) {
+				end = len(document)
+			}
+			color := colorGreen
+			if rest := strings.TrimLeft(document[end:], " \t\r\n"); strings.HasPrefix(rest, ":") {
+				color = colorCyan
+			}
+			result.WriteString(colorize(color, document[index:end]))
+			index = end
+		case character == '-' || (character >= '0' && character <= '9') || (character >= 'a' && character <= 'z'):
+			end := index
+			for end < len(document) && strings.IndexByte(",]} \t\r\n", document[end]) < 0 {
+				end++
+			}
+			color := colorYellow
+			if character >= 'a' && character <= 'z' {
+				color = colorMagenta
+			}
+			result.WriteString(colorize(color, document[index:end]))
+			index = end
+		default:
+			result.WriteByte(character)
+			index++
+		}
+	}
+	return result.String()
+}
+
+// Encode a value as a JSON document.
+func toJson(value interface{}) (string, error) {
+	document, err := json.Marshal(value)
+	return string(document), err
+}
+
+func writeTable(writer io.Writer, result *table) error {
+	if result.title != "" {
+		fmt.Fprintln(writer, result.title)
+	}
+	tabWriter := tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tabWriter, strings.Join(result.columns, "\t"))
+	for _, row := range result.rows {
+		fmt.Fprintln(tabWriter, strings.Join(row, "\t"))
+	}
+	return tabWriter.Flush()
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Print a JSON document in the output format chosen by --output.
+func (app *application) print(document string, toTable tableFunc) error {
+	switch app.output {
+	case outputJson:
+		buffer := &bytes.Buffer{}
+		if err := json.Indent(buffer, []byte(document), "", "  "); err != nil {
+			fmt.Fprintln(app.stdout, document)
+			return nil
+		}
+		if app.color {
+			fmt.Fprintln(app.stdout, colorizeJson(buffer.String()))
+			return nil
+		}
+		fmt.Fprintln(app.stdout, buffer.String())
+		return nil
+	case outputTable:
+		if toTable == nil {
+			toTable = keyValueTable
+		}
+		tables, err := toTable(document)
+		if err != nil {
+			return err
+		}
+		return app.printTables(tables)
+	default:
+		return fmt.Errorf("unknown output format %q; use %q or %q", app.output, outputJson, outputTable)
+	}
+}
+
+// Print tables, separated by blank lines.
+func (app *application) printTables(tables []*table) error {
+	for index, result := range tables {
+		if index > 0 {
+			fmt.Fprintln(app.stdout)
+		}
+		if app.color && result.title != "" {
+			titled := *result
+			titled.title = colorize(colorBold, result.title)
+			result = &titled
+		}
+		if err := writeTable(app.stdout, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Print a value, encoded as JSON, in the output format chosen by --output.
+func (app *application) printValue(value interface{}, toTable tableFunc) error {
+	document, err := toJson(value)
+	if err != nil {
+		return err
+	}
+	return app.print(document, toTable)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// ----------------------------------------------------------------------------
+// Commands
+// ----------------------------------------------------------------------------
+
+func newDiagCommand(app *application) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "diag",
+		Short: "Diagnose the Senzing repository",
+	}
+	dbInfoCommand := &cobra.Command{
+		Use:   "db-info",
+		Short: "Show the databases of the Senzing repository",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2diagnostic.GetDBInfo(ctx)
+			if err != nil {
+				return err
+			}
+			return app.print(result, nil)
+		},
+	}
+	command.AddCommand(dbInfoCommand)
+	return command
+}
+
+func newProductCommand(app *application) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "product",
+		Short: "Show the Senzing product installed on the server",
+	}
+	licenseCommand := &cobra.Command{
+		Use:   "license",
+		Short: "Show the Senzing license",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2product.License(ctx)
+			if err != nil {
+				return err
+			}
+			return app.print(result, nil)
+		},
+	}
+	versionCommand := &cobra.Command{
+		Use:   "version",
+		Short: "Show the Senzing version",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx, cancel := app.context(command)
+			defer cancel()
+			clientSet, err := app.getClientSet(ctx)
+			if err != nil {
+				return err
+			}
+			result, err := clientSet.G2product.Version(ctx)
+			if err != nil {
+				return err
+			}
+			return app.print(result, nil)
+		},
+	}
+	command.AddCommand(licenseCommand, versionCommand)
+	return command
+}
@@ -0,0 +1,517 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterh/liner"
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	"github.com/spf13/cobra"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The state of an interactive session: where the analyst is and how they got there.
+type shell struct {
+	app             *application
+	currentEntityID int64
+	currentRecord   *recordResult
+	dataSources     []string // Nil until loaded; completes data source codes.
+	previous        []int64  // Entities visited before currentEntityID, for back.
+	related         []int64  // Related entities of currentEntityID, in the order listed.
+}
+
+// A command of the shell.
+type shellCommand struct {
+	arguments string
+	help      string
+	run       func(sh *shell, ctx context.Context, arguments string) error
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Returned by a command that ends the session.
+var errQuit = errors.New("quit")
+
+// The commands of the shell, by name; set in init() as the help command refers to it.
+var shellCommands map[string]shellCommand
+
+func init() {
+	shellCommands = map[string]shellCommand{
+		"back":        {"", "return to the previous entity", (*shell).back},
+		"datasources": {"", "reload and list the data sources of the default configuration", (*shell).listDataSources},
+		"entity":      {"", "show the entity of the current record", (*shell).entity},
+		"exit":        {"", "end the session", (*shell).quit},
+		"get":         {"ENTITY_ID | DATA_SOURCE RECORD_ID", "show an entity by entity ID or by one of its records", (*shell).get},
+		"help":        {"", "list the commands", (*shell).help},
+		"how":         {"[ENTITY_ID]", "explain how an entity, by default the current one, was resolved", (*shell).how},
+		"quit":        {"", "end the session", (*shell).quit},
+		"record":      {"DATA_SOURCE RECORD_ID", "show a record", (*shell).record},
+		"related":     {"[N]", "list the related entities of the current entity, or show the Nth", (*shell).relatedEntities},
+		"search":      {"JSON", "search by attributes, e.g. search {\"NAME_FULL\": \"Robert Smith\"}", (*shell).search},
+		"why":         {"[ENTITY_ID] ENTITY_ID", "explain why two entities, by default the current one and another, did or did not resolve", (*shell).why},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func commandNames() []string {
+	result := []string{}
+	for name := range shellCommands {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Complete prefix from candidates, ignoring case.
+func completeWord(prefix string, candidates []string) []string {
+	result := []string{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToUpper(candidate), strings.ToUpper(prefix)) {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".g2grpc_history")
+}
+
+// Report whether output should be colorized for a --color value.
+func useColor(color string, stdout io.Writer) (bool, error) {
+	switch color {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		file, ok := stdout.(*os.File)
+		if !ok || os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+			return false, nil
+		}
+		info, err := file.Stat()
+		return err == nil && info.Mode()&os.ModeCharDevice != 0, nil
+	default:
+		return false, fmt.Errorf("unknown --color %q; use \"auto\", \"always\" or \"never\"", color)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The context of one command, with the deadline set by --timeout.
+func (sh *shell) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if sh.app.timeout > 0 {
+		return context.WithTimeout(ctx, sh.app.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// Run one line typed by the analyst.
+func (sh *shell) execute(ctx context.Context, line string) error {
+	name, arguments, _ := strings.Cut(strings.TrimSpace(line), " ")
+	if name == "" {
+		return nil
+	}
+	command, ok := shellCommands[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("unknown command %q; type help for the commands", name)
+	}
+	ctx, cancel := sh.context(ctx)
+	defer cancel()
+	return command.run(sh, ctx, strings.TrimSpace(arguments))
+}
+
+// The completions of a line: command names for the first word, data source codes for the first argument of get and record.
+func (sh *shell) complete(line string) []string {
+	fields := strings.Fields(line)
+	if strings.HasSuffix(line, " ") || len(fields) == 0 {
+		fields = append(fields, "")
+	}
+	word := fields[len(fields)-1]
+	head := line[:len(line)-len(word)]
+	candidates := []string{}
+	switch {
+	case len(fields) == 1:
+		candidates = commandNames()
+	case len(fields) == 2 && (strings.EqualFold(fields[0], "get") || strings.EqualFold(fields[0], "record")):
+		if sh.dataSources == nil {
+			ctx, cancel := sh.context(context.Background())
+			defer cancel()
+			if err := sh.loadDataSources(ctx); err != nil {
+				return nil
+			}
+		}
+		candidates = sh.dataSources
+	}
+	result := []string{}
+	for _, completion := range completeWord(word, candidates) {
+		result = append(result, head+completion+" ")
+	}
+	return result
+}
+
+// Read the data sources of the default configuration.
+func (sh *shell) loadDataSources(ctx context.Context) error {
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	configID, err := clientSet.G2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		return err
+	}
+	jsonConfig, err := clientSet.G2configmgr.GetConfig(ctx, configID)
+	if err != nil {
+		return err
+	}
+	session, err := g2config.NewConfigSession(ctx, clientSet.G2config, jsonConfig)
+	if err != nil {
+		return err
+	}
+	defer session.Close(ctx)
+	dataSources, err := session.ListDataSources(ctx)
+	if err != nil {
+		return err
+	}
+	sh.dataSources = []string{}
+	for _, dataSource := range dataSources {
+		sh.dataSources = append(sh.dataSources, dataSource.DsrcCode)
+	}
+	sort.Strings(sh.dataSources)
+	return nil
+}
+
+func (sh *shell) prompt() string {
+	if sh.currentEntityID != 0 {
+		return fmt.Sprintf("g2grpc entity %d> ", sh.currentEntityID)
+	}
+	return "g2grpc> "
+}
+
+// Read and run lines until the analyst quits or input ends, keeping history in historyFile.
+func (sh *shell) run(ctx context.Context, historyFile string) error {
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	line.SetCompleter(sh.complete)
+	if historyFile != "" {
+		if file, err := os.Open(historyFile); err == nil {
+			_, _ = line.ReadHistory(file)
+			file.Close()
+		}
+	}
+	fmt.Fprintln(sh.app.stdout, "Senzing gRPC server", sh.app.address+"; type help for the commands.")
+	for {
+		input, err := line.Prompt(sh.prompt())
+		if errors.Is(err, liner.ErrPromptAborted) {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			fmt.Fprintln(sh.app.stdout)
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(input) == "" {
+			continue
+		}
+		line.AppendHistory(input)
+		err = sh.execute(ctx, input)
+		if errors.Is(err, errQuit) {
+			break
+		}
+		if err != nil {
+			message := "error: " + err.Error()
+			if sh.app.color {
+				message = colorize(colorRed, message)
+			}
+			fmt.Fprintln(sh.app.stdout, message)
+		}
+	}
+	if historyFile == "" {
+		return nil
+	}
+	file, err := os.OpenFile(historyFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = line.WriteHistory(file)
+	return err
+}
+
+// Show an entity by entity ID, making it the current entity.
+func (sh *shell) showEntity(ctx context.Context, entityID int64) error {
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.GetEntityByEntityID_V2(ctx, entityID, g2flags.EntityDefault.Int64())
+	if err != nil {
+		return err
+	}
+	return sh.visit(result)
+}
+
+// Make the entity of a GetEntity result the current entity and print it.
+func (sh *shell) visit(result string) error {
+	entity := g2engine.EntityResponse{}
+	if err := json.Unmarshal([]byte(result), &entity); err != nil {
+		return err
+	}
+	entityID := entity.ResolvedEntity.EntityID
+	if sh.currentEntityID != 0 && sh.currentEntityID != entityID {
+		sh.previous = append(sh.previous, sh.currentEntityID)
+	}
+	sh.currentEntityID = entityID
+	sh.related = []int64{}
+	for _, relatedEntity := range entity.RelatedEntities {
+		sh.related = append(sh.related, relatedEntity.EntityID)
+	}
+	return sh.app.print(result, entityTable)
+}
+
+// ----------------------------------------------------------------------------
+// Shell commands
+// ----------------------------------------------------------------------------
+
+func (sh *shell) back(ctx context.Context, arguments string) error {
+	if len(sh.previous) == 0 {
+		return fmt.Errorf("no previous entity")
+	}
+	entityID := sh.previous[len(sh.previous)-1]
+	sh.previous = sh.previous[:len(sh.previous)-1]
+	sh.currentEntityID = 0
+	return sh.showEntity(ctx, entityID)
+}
+
+func (sh *shell) entity(ctx context.Context, arguments string) error {
+	if sh.currentRecord == nil {
+		return fmt.Errorf("no current record; use record DATA_SOURCE RECORD_ID")
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.GetEntityByRecordID_V2(ctx, sh.currentRecord.DataSource, sh.currentRecord.RecordID, g2flags.EntityDefault.Int64())
+	if err != nil {
+		return err
+	}
+	return sh.visit(result)
+}
+
+func (sh *shell) get(ctx context.Context, arguments string) error {
+	fields := strings.Fields(arguments)
+	switch len(fields) {
+	case 1:
+		entityID, err := parseEntityID(fields[0])
+		if err != nil {
+			return err
+		}
+		return sh.showEntity(ctx, entityID)
+	case 2:
+		clientSet, err := sh.app.getClientSet(ctx)
+		if err != nil {
+			return err
+		}
+		result, err := clientSet.G2engine.GetEntityByRecordID_V2(ctx, strings.ToUpper(fields[0]), fields[1], g2flags.EntityDefault.Int64())
+		if err != nil {
+			return err
+		}
+		return sh.visit(result)
+	default:
+		return fmt.Errorf("usage: get %s", shellCommands["get"].arguments)
+	}
+}
+
+func (sh *shell) help(ctx context.Context, arguments string) error {
+	result := &table{columns: []string{"COMMAND", "ARGUMENTS", "DESCRIPTION"}}
+	for _, name := range commandNames() {
+		command := shellCommands[name]
+		result.rows = append(result.rows, []string{name, command.arguments, command.help})
+	}
+	return sh.app.printTables([]*table{result})
+}
+
+func (sh *shell) how(ctx context.Context, arguments string) error {
+	entityID := sh.currentEntityID
+	if arguments != "" {
+		var err error
+		entityID, err = parseEntityID(arguments)
+		if err != nil {
+			return err
+		}
+	}
+	if entityID == 0 {
+		return fmt.Errorf("usage: how %s", shellCommands["how"].arguments)
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.HowEntityByEntityID_V2(ctx, entityID, g2flags.HowEntityDefault.Int64())
+	if err != nil {
+		return err
+	}
+	return sh.app.print(result, howTable)
+}
+
+func (sh *shell) listDataSources(ctx context.Context, arguments string) error {
+	if err := sh.loadDataSources(ctx); err != nil {
+		return err
+	}
+	return sh.app.printValue(map[string][]string{"DATA_SOURCES": sh.dataSources}, nil)
+}
+
+func (sh *shell) quit(ctx context.Context, arguments string) error {
+	return errQuit
+}
+
+func (sh *shell) record(ctx context.Context, arguments string) error {
+	fields := strings.Fields(arguments)
+	if len(fields) != 2 {
+		return fmt.Errorf("usage: record %s", shellCommands["record"].arguments)
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	dataSourceCode := strings.ToUpper(fields[0])
+	result, err := clientSet.G2engine.GetRecord(ctx, dataSourceCode, fields[1])
+	if err != nil {
+		return err
+	}
+	sh.currentRecord = &recordResult{DataSource: dataSourceCode, RecordID: fields[1]}
+	return sh.app.print(result, nil)
+}
+
+func (sh *shell) relatedEntities(ctx context.Context, arguments string) error {
+	if sh.currentEntityID == 0 {
+		return fmt.Errorf("no current entity; use get")
+	}
+	if arguments != "" {
+		index, err := strconv.Atoi(arguments)
+		if err != nil || index < 1 || index > len(sh.related) {
+			return fmt.Errorf("entity %d has %d related entities; use related 1 to related %d", sh.currentEntityID, len(sh.related), len(sh.related))
+		}
+		return sh.showEntity(ctx, sh.related[index-1])
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.GetEntityByEntityID_V2(ctx, sh.currentEntityID, g2flags.EntityDefault.Int64())
+	if err != nil {
+		return err
+	}
+	entity := g2engine.EntityResponse{}
+	if err := json.Unmarshal([]byte(result), &entity); err != nil {
+		return err
+	}
+	sh.related = []int64{}
+	relatedTable := &table{
+		columns: []string{"#", "ENTITY_ID", "ENTITY_NAME", "MATCH_LEVEL_CODE", "MATCH_KEY"},
+		title:   fmt.Sprintf("Entities related to %d", sh.currentEntityID),
+	}
+	for index, relatedEntity := range entity.RelatedEntities {
+		sh.related = append(sh.related, relatedEntity.EntityID)
+		relatedTable.rows = append(relatedTable.rows, []string{strconv.Itoa(index + 1), strconv.FormatInt(relatedEntity.EntityID, 10), relatedEntity.EntityName, relatedEntity.MatchLevelCode, relatedEntity.MatchKey})
+	}
+	return sh.app.printTables([]*table{relatedTable})
+}
+
+func (sh *shell) search(ctx context.Context, arguments string) error {
+	if arguments == "" {
+		return fmt.Errorf("usage: search %s", shellCommands["search"].arguments)
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.SearchByAttributes_V2(ctx, arguments, g2flags.SearchByAttributesDefault.Int64())
+	if err != nil {
+		return err
+	}
+	return sh.app.print(result, searchTable)
+}
+
+func (sh *shell) why(ctx context.Context, arguments string) error {
+	entityIDs := []int64{}
+	for _, field := range strings.Fields(arguments) {
+		entityID, err := parseEntityID(field)
+		if err != nil {
+			return err
+		}
+		entityIDs = append(entityIDs, entityID)
+	}
+	if len(entityIDs) == 1 && sh.currentEntityID != 0 {
+		entityIDs = append([]int64{sh.currentEntityID}, entityIDs...)
+	}
+	if len(entityIDs) != 2 {
+		return fmt.Errorf("usage: why %s", shellCommands["why"].arguments)
+	}
+	clientSet, err := sh.app.getClientSet(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := clientSet.G2engine.WhyEntities_V2(ctx, entityIDs[0], entityIDs[1], g2flags.WhyEntityDefault.Int64())
+	if err != nil {
+		return err
+	}
+	return sh.app.print(result, whyTable)
+}
+
+// ----------------------------------------------------------------------------
+// Commands
+// ----------------------------------------------------------------------------
+
+func newShellCommand(app *application) *cobra.Command {
+	var color, historyFile string
+	command := &cobra.Command{
+		Use:   "shell",
+		Short: "Explore the Senzing repository interactively",
+		Long:  "Explore the Senzing repository interactively: get entities and records, pivot to related entities,\nand ask why and how. Tab completes commands and data source codes.",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			var err error
+			app.color, err = useColor(color, app.stdout)
+			if err != nil {
+				return err
+			}
+			ctx := command.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			sh := &shell{app: app}
+			return sh.run(ctx, historyFile)
+		},
+	}
+	command.Flags().StringVar(&color, "color", "auto", "colorize output: auto, always or never")
+	command.Flags().StringVar(&historyFile, "history-file", defaultHistoryFile(), "file keeping the command history; empty for none")
+	return command
+}
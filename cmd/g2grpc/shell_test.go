@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A shell connected to the test server, and its output.
+func getTestShell(test *testing.T) (*shell, *bytes.Buffer) {
+	testServer.Reset()
+	stdout := &bytes.Buffer{}
+	app := newApplication(strings.NewReader(""), stdout)
+	app.dialOptions = []grpc.DialOption{testServer.DialOption()}
+	app.output = outputJson
+	test.Cleanup(func() { _ = app.close() })
+	return &shell{app: app}, stdout
+}
+
+// Run shell lines, failing the test on the first error.
+func execute(test *testing.T, sh *shell, lines ...string) {
+	for _, line := range lines {
+		testError(test, sh.execute(context.TODO(), line))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestShell_Complete(test *testing.T) {
+	sh, _ := getTestShell(test)
+	assert.Equal(test, []string{"record ", "related "}, sh.complete("re"))
+	assert.Equal(test, []string{"get TEST "}, sh.complete("get te"))
+	assert.Equal(test, []string{"record SEARCH ", "record TEST "}, sh.complete("record "))
+	assert.Empty(test, sh.complete("why 1 "))
+}
+
+func TestShell_Complete_NewDataSource(test *testing.T) {
+	sh, stdout := getTestShell(test)
+	_, err := runCommand(test, "", "config", "add-datasource", "CUSTOMERS")
+	testError(test, err)
+	execute(test, sh, "datasources")
+	assert.Contains(test, stdout.String(), "CUSTOMERS")
+	assert.Equal(test, []string{"get CUSTOMERS "}, sh.complete("get cu"))
+}
+
+func TestShell_Pivot(test *testing.T) {
+	sh, stdout := getTestShell(test)
+	_, err := runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`)
+	testError(test, err)
+	_, err = runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "NAME_FULL": "Mary Jones"}`)
+	testError(test, err)
+	execute(test, sh, "record test 1", "entity")
+	first := sh.currentEntityID
+	assert.NotZero(test, first)
+	assert.Equal(test, fmt.Sprintf("g2grpc entity %d> ", first), sh.prompt())
+	execute(test, sh, "related")
+	assert.Empty(test, sh.related)
+
+	// Entities of the fake server are never related, so relate them here.
+	execute(test, sh, "get TEST 2")
+	second := sh.currentEntityID
+	execute(test, sh, "back")
+	sh.related = []int64{second}
+	execute(test, sh, "related 1")
+	assert.Equal(test, second, sh.currentEntityID)
+	execute(test, sh, "back")
+	assert.Equal(test, first, sh.currentEntityID)
+	assert.Contains(test, stdout.String(), "Mary Jones")
+	assert.ErrorContains(test, sh.execute(context.TODO(), "related 2"), "related entities")
+}
+
+func TestShell_Errors(test *testing.T) {
+	sh, _ := getTestShell(test)
+	assert.ErrorContains(test, sh.execute(context.TODO(), "frobnicate"), "unknown command")
+	assert.ErrorContains(test, sh.execute(context.TODO(), "related"), "no current entity")
+	assert.ErrorContains(test, sh.execute(context.TODO(), "why 1"), "usage")
+	assert.ErrorIs(test, sh.execute(context.TODO(), "quit"), errQuit)
+}
+
+func TestShell_Search(test *testing.T) {
+	sh, stdout := getTestShell(test)
+	_, err := runCommand(test, "", "add-record", `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Robert Smith"}`)
+	testError(test, err)
+	execute(test, sh, `search {"NAME_FULL": "Robert Smith"}`)
+	assert.Contains(test, stdout.String(), "RESOLVED_ENTITIES")
+}
+
+func TestColorizeJson(test *testing.T) {
+	colorized := colorizeJson("{\n  \"KEY\": \"value\",\n  \"N\": -1.5,\n  \"B\": true\n}")
+	assert.Contains(test, colorized, colorCyan+`"KEY"`+colorReset)
+	assert.Contains(test, colorized, colorGreen+`"value"`+colorReset)
+	assert.Contains(test, colorized, colorYellow+"-1.5"+colorReset)
+	assert.Contains(test, colorized, colorMagenta+"true"+colorReset)
+}
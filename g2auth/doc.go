@@ -0,0 +1,22 @@
+/*
+The g2auth package authenticates Senzing SDK calls to a Senzing gRPC server behind an authenticating proxy.
+
+Credentials implements credentials.PerRPCCredentials: it gets a token from a TokenSource for each call
+and sends it as call metadata, by default as "authorization: Bearer <token>".
+Given to g2client.WithPerRPCCredentials(), or as grpc.WithPerRPCCredentials() to a connection,
+it authenticates every call of the G2config, G2configmgr, G2diagnostic, G2engine and G2product clients:
+
+	tokenSource, err := g2auth.NewFileToken("/var/run/secrets/tokens/senzing")
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithTLS(tlsConfig), g2client.WithPerRPCCredentials(g2auth.NewBearerCredentials(tokenSource)))
+
+Token sources:
+  - StaticToken: a fixed token, such as an API key sent with NewAPIKeyCredentials().
+  - FileToken: a token read from a file and re-read when the file changes, such as a Kubernetes projected service account token.
+  - NewClientCredentialsToken(): an OAuth2 access token from the client credentials flow, refreshed before it expires.
+  - NewOAuth2Token(): any other golang.org/x/oauth2 token source.
+
+Tokens are only sent over connections with transport security, unless Credentials.AllowInsecure is set,
+e.g. for a proxy listening on localhost. A call whose token cannot be had fails with codes.Unauthenticated
+before it is sent.
+*/
+package g2auth
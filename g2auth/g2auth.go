@@ -0,0 +1,223 @@
+/*
+ *
+ */
+
+// Package g2auth authenticates Senzing SDK calls with per-RPC credentials.
+package g2auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Credentials sends a token from a TokenSource as metadata of every call.
+It implements google.golang.org/grpc/credentials.PerRPCCredentials. Set its fields before the first call.
+*/
+type Credentials struct {
+	AllowInsecure bool        // Send the token over connections without transport security, e.g. to a proxy on localhost.
+	Header        string      // The metadata key of the token; AuthorizationHeader if empty.
+	Scheme        string      // Put before the token, e.g. BearerScheme; if empty, the token is sent alone.
+	TokenSource   TokenSource // Where the token of each call comes from.
+}
+
+// FileToken is a TokenSource reading a token from a file, re-read when its modification time changes.
+type FileToken struct {
+	filename string
+	lock     sync.Mutex
+	modTime  time.Time
+	token    string
+}
+
+// StaticToken is a TokenSource supplying the same token to every call, e.g. an API key.
+type StaticToken string
+
+// TokenSource supplies the token of a call. Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns the token to send with a call made with ctx.
+	Token(ctx context.Context) (string, error)
+}
+
+// Adapts an oauth2.TokenSource to a TokenSource.
+type oauth2TokenSource struct {
+	source oauth2.TokenSource
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewAPIKeyCredentials function returns Credentials sending a token alone under a metadata key.
+
+Input
+  - header: The metadata key, e.g. APIKeyHeader; it is lowercased.
+  - tokenSource: Where the token comes from, e.g. a StaticToken.
+*/
+func NewAPIKeyCredentials(header string, tokenSource TokenSource) *Credentials {
+	return &Credentials{
+		Header:      strings.ToLower(header),
+		TokenSource: tokenSource,
+	}
+}
+
+/*
+The NewBearerCredentials function returns Credentials sending "authorization: Bearer <token>".
+
+Input
+  - tokenSource: Where the token comes from.
+*/
+func NewBearerCredentials(tokenSource TokenSource) *Credentials {
+	return &Credentials{
+		Header:      AuthorizationHeader,
+		Scheme:      BearerScheme,
+		TokenSource: tokenSource,
+	}
+}
+
+/*
+The NewClientCredentialsToken function returns a TokenSource getting OAuth2 access tokens by the client credentials flow.
+A token is reused until shortly before it expires, then a new one is requested.
+
+Input
+  - ctx: A context whose oauth2.HTTPClient value, if any, is the HTTP client used to request tokens.
+    It must outlive the TokenSource; cancelling it fails later token requests.
+  - config: The client ID and secret, token URL and scopes.
+*/
+func NewClientCredentialsToken(ctx context.Context, config *clientcredentials.Config) TokenSource {
+	return NewOAuth2Token(config.TokenSource(ctx))
+}
+
+/*
+The NewFileToken function returns a TokenSource reading a token from a file.
+The file is read immediately, so that a missing file is reported early,
+and again whenever its modification time changes. Surrounding white space is removed.
+
+Input
+  - filename: The file holding the token, e.g. a Kubernetes projected service account token.
+*/
+func NewFileToken(filename string) (*FileToken, error) {
+	fileToken := &FileToken{filename: filename}
+	if _, err := fileToken.Token(context.Background()); err != nil {
+		return nil, err
+	}
+	return fileToken, nil
+}
+
+/*
+The NewOAuth2Token function returns a TokenSource supplying the access tokens of an oauth2.TokenSource.
+
+Input
+  - source: The token source, usually one caching tokens, such as those of golang.org/x/oauth2 configurations.
+*/
+func NewOAuth2Token(source oauth2.TokenSource) TokenSource {
+	return &oauth2TokenSource{source: source}
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The GetRequestMetadata method returns the metadata carrying the token of a call.
+It implements credentials.PerRPCCredentials.
+
+Input
+  - ctx: The context of the call.
+  - uri: The URI of the service called; unused.
+
+Output
+  - The metadata to add to the call.
+  - An error with codes.Unauthenticated if no token could be had, which fails the call before it is sent.
+*/
+func (creds *Credentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if creds.TokenSource == nil {
+		return nil, status.Error(codes.Unauthenticated, "g2auth: no token source")
+	}
+	token, err := creds.TokenSource.Token(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "g2auth: getting token: %v", err)
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "g2auth: empty token")
+	}
+	header := creds.Header
+	if header == "" {
+		header = AuthorizationHeader
+	}
+	if creds.Scheme != "" {
+		token = creds.Scheme + " " + token
+	}
+	return map[string]string{header: token}, nil
+}
+
+/*
+The RequireTransportSecurity method reports whether the token may only be sent over a secure connection,
+which is unless AllowInsecure is set. It implements credentials.PerRPCCredentials.
+*/
+func (creds *Credentials) RequireTransportSecurity() bool {
+	return !creds.AllowInsecure
+}
+
+/*
+The Token method returns the content of the file, re-reading it if its modification time has changed.
+
+Input
+  - ctx: The context of the call; unused.
+*/
+func (fileToken *FileToken) Token(ctx context.Context) (string, error) {
+	fileToken.lock.Lock()
+	defer fileToken.lock.Unlock()
+	fileInfo, err := os.Stat(fileToken.filename)
+	if err != nil {
+		return "", err
+	}
+	if fileToken.token == "" || !fileInfo.ModTime().Equal(fileToken.modTime) {
+		content, err := os.ReadFile(fileToken.filename)
+		if err != nil {
+			return "", err
+		}
+		token := strings.TrimSpace(string(content))
+		if token == "" {
+			return "", fmt.Errorf("no token in %s", fileToken.filename)
+		}
+		fileToken.token = token
+		fileToken.modTime = fileInfo.ModTime()
+	}
+	return fileToken.token, nil
+}
+
+/*
+The Token method returns the token.
+
+Input
+  - ctx: The context of the call; unused.
+*/
+func (staticToken StaticToken) Token(ctx context.Context) (string, error) {
+	if staticToken == "" {
+		return "", errors.New("empty static token")
+	}
+	return string(staticToken), nil
+}
+
+func (source *oauth2TokenSource) Token(ctx context.Context) (string, error) {
+	token, err := source.source.Token()
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,248 @@
+package g2auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2product"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// A server enforcing that calls carry one of the accepted values of a metadata key, as an authenticating proxy does.
+type authServer struct {
+	*grpctest.Server
+	accepted map[string]bool
+	header   string
+	lock     sync.Mutex
+	seen     []string
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newAuthServer(test *testing.T, header string, accepted ...string) *authServer {
+	server := &authServer{
+		accepted: map[string]bool{},
+		header:   header,
+	}
+	for _, value := range accepted {
+		server.accepted[value] = true
+	}
+	server.Server = grpctest.NewServer(grpc.ChainUnaryInterceptor(server.authenticate))
+	test.Cleanup(server.Close)
+	return server
+}
+
+// A G2product client sending the credentials to the server.
+func getTestObject(ctx context.Context, test *testing.T, server *authServer, creds *Credentials) *g2product.G2product {
+	grpcConnection, err := server.Dial(ctx, grpc.WithPerRPCCredentials(creds))
+	testError(test, err)
+	test.Cleanup(func() { grpcConnection.Close() })
+	return &g2product.G2product{GrpcClient: g2productpb.NewG2ProductClient(grpcConnection)}
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// A token endpoint issuing "token-1", "token-2"... valid for expiresIn seconds, and counting requests.
+func newTokenServer(test *testing.T, expiresIn int, requests *int32) *httptest.Server {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		clientID, clientSecret, ok := request.BasicAuth()
+		if !ok || clientID != "senzing" || clientSecret != "secret" || request.FormValue("grant_type") != "client_credentials" {
+			writer.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		count := atomic.AddInt32(requests, 1)
+		writer.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", count),
+			"expires_in":   expiresIn,
+			"token_type":   "Bearer",
+		})
+	}))
+	test.Cleanup(tokenServer.Close)
+	return tokenServer
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (server *authServer) authenticate(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	values := incoming.Get(server.header)
+	server.lock.Lock()
+	server.seen = append(server.seen, values...)
+	server.lock.Unlock()
+	if len(values) != 1 || !server.accepted[values[0]] {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return handler(ctx, request)
+}
+
+func (server *authServer) lastSeen() string {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if len(server.seen) == 0 {
+		return ""
+	}
+	return server.seen[len(server.seen)-1]
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCredentials_Bearer(test *testing.T) {
+	ctx := context.TODO()
+	server := newAuthServer(test, AuthorizationHeader, "Bearer good")
+	creds := NewBearerCredentials(StaticToken("good"))
+	creds.AllowInsecure = true
+	_, err := getTestObject(ctx, test, server, creds).Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, "Bearer good", server.lastSeen())
+
+	creds = NewBearerCredentials(StaticToken("bad"))
+	creds.AllowInsecure = true
+	_, err = getTestObject(ctx, test, server, creds).Version(ctx)
+	assert.Equal(test, codes.Unauthenticated, status.Code(err))
+}
+
+func TestCredentials_APIKey(test *testing.T) {
+	ctx := context.TODO()
+	server := newAuthServer(test, APIKeyHeader, "key-1")
+	creds := NewAPIKeyCredentials("X-API-Key", StaticToken("key-1"))
+	creds.AllowInsecure = true
+	_, err := getTestObject(ctx, test, server, creds).Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, "key-1", server.lastSeen())
+}
+
+func TestCredentials_NoToken(test *testing.T) {
+	ctx := context.TODO()
+	server := newAuthServer(test, AuthorizationHeader, "Bearer good")
+	creds := NewBearerCredentials(StaticToken(""))
+	creds.AllowInsecure = true
+	_, err := getTestObject(ctx, test, server, creds).Version(ctx)
+	assert.Equal(test, codes.Unauthenticated, status.Code(err))
+	assert.Contains(test, err.Error(), "g2auth: getting token")
+	assert.Equal(test, "", server.lastSeen(), "the call is not sent")
+}
+
+func TestCredentials_RequireTransportSecurity(test *testing.T) {
+	ctx := context.TODO()
+	creds := NewBearerCredentials(StaticToken("good"))
+	assert.True(test, creds.RequireTransportSecurity())
+	_, err := grpc.DialContext(ctx, "localhost:8258", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithPerRPCCredentials(creds))
+	assert.NotNil(test, err, "tokens are not sent in plaintext unless allowed")
+}
+
+func TestFileToken(test *testing.T) {
+	ctx := context.TODO()
+	filename := filepath.Join(test.TempDir(), "token")
+	testError(test, os.WriteFile(filename, []byte("first\n"), 0600))
+	fileToken, err := NewFileToken(filename)
+	testError(test, err)
+	server := newAuthServer(test, AuthorizationHeader, "Bearer first", "Bearer second")
+	creds := NewBearerCredentials(fileToken)
+	creds.AllowInsecure = true
+	client := getTestObject(ctx, test, server, creds)
+	_, err = client.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, "Bearer first", server.lastSeen())
+
+	// Rotate the token; the next call sends the new one.
+	testError(test, os.WriteFile(filename, []byte("second\n"), 0600))
+	later := time.Now().Add(time.Minute)
+	testError(test, os.Chtimes(filename, later, later))
+	_, err = client.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, "Bearer second", server.lastSeen())
+}
+
+func TestFileToken_Missing(test *testing.T) {
+	_, err := NewFileToken(filepath.Join(test.TempDir(), "missing"))
+	assert.NotNil(test, err)
+}
+
+func TestClientCredentialsToken(test *testing.T) {
+	ctx := context.TODO()
+	var requests int32
+	tokenServer := newTokenServer(test, 3600, &requests)
+	tokenSource := NewClientCredentialsToken(ctx, &clientcredentials.Config{
+		ClientID:     "senzing",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	})
+	server := newAuthServer(test, AuthorizationHeader, "Bearer token-1")
+	creds := NewBearerCredentials(tokenSource)
+	creds.AllowInsecure = true
+	client := getTestObject(ctx, test, server, creds)
+	for i := 0; i < 3; i++ {
+		_, err := client.Version(ctx)
+		assert.Nil(test, err)
+	}
+	assert.Equal(test, int32(1), atomic.LoadInt32(&requests), "the token is reused until it expires")
+}
+
+func TestClientCredentialsToken_Refresh(test *testing.T) {
+	ctx := context.TODO()
+	var requests int32
+	// Tokens expiring within a few seconds are refreshed before every call.
+	tokenServer := newTokenServer(test, 1, &requests)
+	tokenSource := NewClientCredentialsToken(ctx, &clientcredentials.Config{
+		ClientID:     "senzing",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	})
+	server := newAuthServer(test, AuthorizationHeader, "Bearer token-1", "Bearer token-2")
+	creds := NewBearerCredentials(tokenSource)
+	creds.AllowInsecure = true
+	client := getTestObject(ctx, test, server, creds)
+	_, err := client.Version(ctx)
+	assert.Nil(test, err)
+	_, err = client.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, "Bearer token-2", server.lastSeen())
+}
+
+func TestClientCredentialsToken_Rejected(test *testing.T) {
+	ctx := context.TODO()
+	var requests int32
+	tokenServer := newTokenServer(test, 3600, &requests)
+	tokenSource := NewClientCredentialsToken(ctx, &clientcredentials.Config{
+		ClientID:     "senzing",
+		ClientSecret: "wrong",
+		TokenURL:     tokenServer.URL,
+	})
+	server := newAuthServer(test, AuthorizationHeader, "Bearer token-1")
+	creds := NewBearerCredentials(tokenSource)
+	creds.AllowInsecure = true
+	_, err := getTestObject(ctx, test, server, creds).Version(ctx)
+	assert.Equal(test, codes.Unauthenticated, status.Code(err))
+}
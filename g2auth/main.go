@@ -0,0 +1,14 @@
+package g2auth
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Metadata keys carrying a token; gRPC metadata keys are lowercase.
+const (
+	APIKeyHeader        = "x-api-key"
+	AuthorizationHeader = "authorization"
+)
+
+// The scheme of OAuth2 access tokens and other bearer tokens in AuthorizationHeader.
+const BearerScheme = "Bearer"
@@ -0,0 +1,7 @@
+/*
+The g2client package dials a Senzing gRPC server once and builds all five Senzing SDK clients
+(G2config, G2configmgr, G2diagnostic, G2engine and G2product) on the shared connection.
+The gRPC definitions are at https://github.com/Senzing/g2-sdk-proto.
+The Senzing gRPC server is at https://github.com/Senzing/servegrpc.
+*/
+package g2client
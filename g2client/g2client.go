@@ -0,0 +1,542 @@
+/*
+ *
+ */
+
+// Package g2client builds Senzing SDK clients over a single gRPC connection.
+package g2client
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2diagnostic"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/g2health"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
+	"github.com/senzing/g2-sdk-go-grpc/g2otel"
+	"github.com/senzing/g2-sdk-go-grpc/g2pool"
+	"github.com/senzing/g2-sdk-go-grpc/g2product"
+	"github.com/senzing/g2-sdk-go-grpc/g2retry"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
+	g2configmgrpb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+	g2diagnosticpb "github.com/senzing/g2-sdk-proto/go/g2diagnostic"
+	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ClientSet owns a gRPC connection and the five Senzing SDK clients built on it.
+type ClientSet struct {
+	G2config     g2api.G2config
+	G2configmgr  g2api.G2configmgr
+	G2diagnostic g2api.G2diagnostic
+	G2engine     g2api.G2engine
+	G2product    g2api.G2product
+	closeErr     error
+	closeOnce    sync.Once
+	connection   *grpc.ClientConn
+	pool         *g2pool.Pool
+}
+
+type clientSetOptions struct {
+	address              string
+	balancingPolicy      g2pool.Policy
+	deadlines            *g2deadline.Deadlines
+	dialOptions          []grpc.DialOption
+	endpoints            []string
+	err                  error
+	handleTracker        *g2handle.Tracker
+	keepaliveParams      *keepalive.ClientParameters
+	maxRecvMsgSize       int
+	maxSendMsgSize       int
+	observerCapacity     int
+	observerOverflow     g2observer.OverflowPolicy
+	observerQueues       bool
+	perRPCCredentials    []credentials.PerRPCCredentials
+	streamInterceptors   []grpc.StreamClientInterceptor
+	transportCredentials credentials.TransportCredentials
+	unaryInterceptors    []grpc.UnaryClientInterceptor
+}
+
+// ----------------------------------------------------------------------------
+// Options
+// ----------------------------------------------------------------------------
+
+/*
+The WithAddress option sets the "host:port" of the Senzing gRPC server.
+The default is DefaultAddress.
+
+Input
+  - address: A gRPC target, e.g. "localhost:8258" or "dns:///senzing.example.com:8258".
+*/
+func WithAddress(address string) Option {
+	return func(options *clientSetOptions) {
+		options.address = address
+	}
+}
+
+/*
+The WithBalancingPolicy option sets how calls are spread over the servers of WithEndpoints().
+The default is g2pool.RoundRobin.
+
+Input
+  - policy: g2pool.RoundRobin or g2pool.LeastOutstanding.
+*/
+func WithBalancingPolicy(policy g2pool.Policy) Option {
+	return func(options *clientSetOptions) {
+		options.balancingPolicy = policy
+	}
+}
+
+/*
+The WithDeadlines option sets the default deadlines of calls, made by any of the five clients, whose context has none.
+Without it, the clients use g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts.
+
+Input
+  - deadlines: The default timeout of each method, usually created by g2deadline.NewDeadlines().
+*/
+func WithDeadlines(deadlines *g2deadline.Deadlines) Option {
+	return func(options *clientSetOptions) {
+		options.deadlines = deadlines
+	}
+}
+
+/*
+The WithDialOptions option appends raw gRPC dial options.
+They are applied after the options built from the other With...() functions.
+
+Input
+  - dialOptions: Additional grpc.DialOption values.
+*/
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(options *clientSetOptions) {
+		options.dialOptions = append(options.dialOptions, dialOptions...)
+	}
+}
+
+/*
+The WithEndpoints option spreads calls over several Senzing gRPC servers sharing one database, instead of WithAddress().
+Calls carrying a server-side handle go to the server that created it. See g2pool.Pool.
+
+Input
+  - endpoints: gRPC targets, e.g. "senzing-1:8258"; "dns:///host:port" adds an endpoint for each address of host.
+*/
+func WithEndpoints(endpoints ...string) Option {
+	return func(options *clientSetOptions) {
+		options.endpoints = append(options.endpoints, endpoints...)
+	}
+}
+
+/*
+The WithFlagValidation option fails G2engine calls whose flags have no effect on the method called,
+before they are sent. See g2flags.Flags.Validate().
+*/
+func WithFlagValidation() Option {
+	return func(options *clientSetOptions) {
+		options.unaryInterceptors = append(options.unaryInterceptors, g2flags.UnaryClientInterceptor())
+	}
+}
+
+/*
+The WithHandleTracker option records the handles created by the G2config, G2diagnostic and G2engine clients in one Tracker.
+Without it, each client uses a Tracker of its own, created by g2handle.NewTracker().
+
+Input
+  - tracker: The Tracker, usually created by g2handle.NewTracker().
+*/
+func WithHandleTracker(tracker *g2handle.Tracker) Option {
+	return func(options *clientSetOptions) {
+		options.handleTracker = tracker
+	}
+}
+
+/*
+The WithKeepalive option sets the client-side keepalive parameters of the connection.
+
+Input
+  - keepaliveParams: The gRPC keepalive parameters.
+*/
+func WithKeepalive(keepaliveParams keepalive.ClientParameters) Option {
+	return func(options *clientSetOptions) {
+		options.keepaliveParams = &keepaliveParams
+	}
+}
+
+/*
+The WithMaxMessageSize option sets the maximum size, in bytes, of messages received and sent.
+A value of 0 keeps the gRPC default.
+
+Input
+  - maxRecvMsgSize: Maximum size of a response message.
+  - maxSendMsgSize: Maximum size of a request message.
+*/
+func WithMaxMessageSize(maxRecvMsgSize int, maxSendMsgSize int) Option {
+	return func(options *clientSetOptions) {
+		options.maxRecvMsgSize = maxRecvMsgSize
+		options.maxSendMsgSize = maxSendMsgSize
+	}
+}
+
+/*
+The WithMetadata option sends default metadata, such as the name of the application, with every call.
+Values set on the context of a call by g2metadata.WithCaller() and the like win over them.
+
+Input
+  - defaults: The values sent with calls lacking them; its CorrelationID is ignored.
+*/
+func WithMetadata(defaults g2metadata.Metadata) Option {
+	return func(options *clientSetOptions) {
+		options.unaryInterceptors = append(options.unaryInterceptors, g2metadata.UnaryClientInterceptor(defaults))
+	}
+}
+
+/*
+The WithObserverQueue option sets how many messages to observers each of the five clients holds
+waiting for delivery, and what happens to a message when that many are waiting.
+Without it, each client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - capacity: The most messages waiting for delivery per client; if zero or less, there is no limit.
+  - overflow: g2observer.Block or g2observer.Drop.
+*/
+func WithObserverQueue(capacity int, overflow g2observer.OverflowPolicy) Option {
+	return func(options *clientSetOptions) {
+		options.observerCapacity = capacity
+		options.observerOverflow = overflow
+		options.observerQueues = true
+	}
+}
+
+/*
+The WithPerRPCCredentials option sends credentials with every call of the five clients, e.g. a bearer token for an authenticating proxy.
+Unless the credentials allow it, the connection must have transport security, e.g. from WithTLS().
+
+Input
+  - perRPCCredentials: The credentials, e.g. from g2auth.NewBearerCredentials().
+*/
+func WithPerRPCCredentials(perRPCCredentials credentials.PerRPCCredentials) Option {
+	return func(options *clientSetOptions) {
+		options.perRPCCredentials = append(options.perRPCCredentials, perRPCCredentials)
+	}
+}
+
+/*
+The WithRetryPolicy option retries calls that fail with transient errors.
+The retry interceptor is placed ahead of interceptors added later.
+
+Input
+  - retryPolicy: The policy, usually created by g2retry.NewRetryPolicy().
+*/
+func WithRetryPolicy(retryPolicy *g2retry.RetryPolicy) Option {
+	return func(options *clientSetOptions) {
+		options.unaryInterceptors = append(options.unaryInterceptors, retryPolicy.UnaryClientInterceptor())
+	}
+}
+
+/*
+The WithStreamInterceptors option adds stream client interceptors.
+Interceptors are chained in the order they are added.
+
+Input
+  - interceptors: The stream client interceptors.
+*/
+func WithStreamInterceptors(interceptors ...grpc.StreamClientInterceptor) Option {
+	return func(options *clientSetOptions) {
+		options.streamInterceptors = append(options.streamInterceptors, interceptors...)
+	}
+}
+
+/*
+The WithTelemetry option traces and measures calls with OpenTelemetry.
+Added before WithRetryPolicy(), a call produces one span covering all its attempts;
+added after, each attempt produces its own span.
+
+Input
+  - telemetry: The OpenTelemetry providers. The zero value uses the global providers.
+*/
+func WithTelemetry(telemetry *g2otel.Telemetry) Option {
+	return func(options *clientSetOptions) {
+		options.unaryInterceptors = append(options.unaryInterceptors, telemetry.UnaryClientInterceptor())
+	}
+}
+
+/*
+The WithTransportCredentials option sets the transport security of the connection.
+The default is an insecure (plaintext) connection.
+
+Input
+  - transportCredentials: The gRPC transport credentials.
+*/
+func WithTransportCredentials(transportCredentials credentials.TransportCredentials) Option {
+	return func(options *clientSetOptions) {
+		options.transportCredentials = transportCredentials
+	}
+}
+
+/*
+The WithUnaryInterceptors option adds unary client interceptors.
+Interceptors are chained in the order they are added.
+
+Input
+  - interceptors: The unary client interceptors.
+*/
+func WithUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(options *clientSetOptions) {
+		options.unaryInterceptors = append(options.unaryInterceptors, interceptors...)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Build the five clients on a connection or a pool.
+func newClientSet(connection grpc.ClientConnInterface) *ClientSet {
+	return &ClientSet{
+		G2config: &g2config.G2config{
+			GrpcClient: g2configpb.NewG2ConfigClient(connection),
+		},
+		G2configmgr: &g2configmgr.G2configmgr{
+			GrpcClient: g2configmgrpb.NewG2ConfigMgrClient(connection),
+		},
+		G2diagnostic: &g2diagnostic.G2diagnostic{
+			GrpcClient: g2diagnosticpb.NewG2DiagnosticClient(connection),
+		},
+		G2engine: &g2engine.G2engine{
+			GrpcClient: g2enginepb.NewG2EngineClient(connection),
+		},
+		G2product: &g2product.G2product{
+			GrpcClient: g2productpb.NewG2ProductClient(connection),
+		},
+	}
+}
+
+func (options *clientSetOptions) getDialOptions() []grpc.DialOption {
+	result := []grpc.DialOption{}
+	transportCredentials := options.transportCredentials
+	if transportCredentials == nil {
+		transportCredentials = insecure.NewCredentials()
+	}
+	result = append(result, grpc.WithTransportCredentials(transportCredentials))
+	if options.keepaliveParams != nil {
+		result = append(result, grpc.WithKeepaliveParams(*options.keepaliveParams))
+	}
+	for _, perRPCCredentials := range options.perRPCCredentials {
+		result = append(result, grpc.WithPerRPCCredentials(perRPCCredentials))
+	}
+	callOptions := []grpc.CallOption{}
+	if options.maxRecvMsgSize > 0 {
+		callOptions = append(callOptions, grpc.MaxCallRecvMsgSize(options.maxRecvMsgSize))
+	}
+	if options.maxSendMsgSize > 0 {
+		callOptions = append(callOptions, grpc.MaxCallSendMsgSize(options.maxSendMsgSize))
+	}
+	if len(callOptions) > 0 {
+		result = append(result, grpc.WithDefaultCallOptions(callOptions...))
+	}
+	if len(options.unaryInterceptors) > 0 {
+		result = append(result, grpc.WithChainUnaryInterceptor(options.unaryInterceptors...))
+	}
+	if len(options.streamInterceptors) > 0 {
+		result = append(result, grpc.WithChainStreamInterceptor(options.streamInterceptors...))
+	}
+	return append(result, options.dialOptions...)
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewClientSet function dials a Senzing gRPC server and builds the five Senzing SDK clients on that connection.
+Dialing does not block; connection errors surface on the first call.
+
+Input
+  - ctx: A context to control lifecycle.
+  - opts: Options controlling the address, security and behavior of the connection.
+
+Output
+  - A ClientSet whose Close() method must be called to release the connection.
+*/
+func NewClientSet(ctx context.Context, opts ...Option) (*ClientSet, error) {
+	options := &clientSetOptions{
+		address: DefaultAddress,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.err != nil {
+		return nil, options.err
+	}
+	var clientSet *ClientSet
+	if len(options.endpoints) > 0 {
+		pool, err := g2pool.NewPool(ctx, options.endpoints, options.getDialOptions()...)
+		if err != nil {
+			return nil, err
+		}
+		pool.Policy = options.balancingPolicy
+		clientSet = NewClientSetFromPool(pool)
+	} else {
+		connection, err := grpc.DialContext(ctx, options.address, options.getDialOptions()...)
+		if err != nil {
+			return nil, err
+		}
+		clientSet = NewClientSetFromConnection(connection)
+	}
+	if options.deadlines != nil {
+		clientSet.G2config.(*g2config.G2config).SetDeadlines(options.deadlines)
+		clientSet.G2configmgr.(*g2configmgr.G2configmgr).SetDeadlines(options.deadlines)
+		clientSet.G2diagnostic.(*g2diagnostic.G2diagnostic).SetDeadlines(options.deadlines)
+		clientSet.G2engine.(*g2engine.G2engine).SetDeadlines(options.deadlines)
+		clientSet.G2product.(*g2product.G2product).SetDeadlines(options.deadlines)
+	}
+	if options.handleTracker != nil {
+		clientSet.G2config.(*g2config.G2config).SetHandleTracker(options.handleTracker)
+		clientSet.G2diagnostic.(*g2diagnostic.G2diagnostic).SetHandleTracker(options.handleTracker)
+		clientSet.G2engine.(*g2engine.G2engine).SetHandleTracker(options.handleTracker)
+	}
+	if options.observerQueues {
+		newQueue := func() *g2observer.Queue {
+			return &g2observer.Queue{Capacity: options.observerCapacity, Overflow: options.observerOverflow}
+		}
+		clientSet.G2config.(*g2config.G2config).SetObserverQueue(newQueue())
+		clientSet.G2configmgr.(*g2configmgr.G2configmgr).SetObserverQueue(newQueue())
+		clientSet.G2diagnostic.(*g2diagnostic.G2diagnostic).SetObserverQueue(newQueue())
+		clientSet.G2engine.(*g2engine.G2engine).SetObserverQueue(newQueue())
+		clientSet.G2product.(*g2product.G2product).SetObserverQueue(newQueue())
+	}
+	return clientSet, nil
+}
+
+/*
+The NewClientSetFromConnection function builds the five Senzing SDK clients on an existing connection.
+The ClientSet takes ownership of the connection; Close() will close it.
+
+Input
+  - connection: An established gRPC client connection.
+*/
+func NewClientSetFromConnection(connection *grpc.ClientConn) *ClientSet {
+	clientSet := newClientSet(connection)
+	clientSet.connection = connection
+	return clientSet
+}
+
+/*
+The NewClientSetFromPool function builds the five Senzing SDK clients on a pool of connections to several servers.
+The ClientSet takes ownership of the pool; Close() will close it.
+
+Input
+  - pool: A g2pool.Pool, e.g. from g2pool.NewPool().
+*/
+func NewClientSetFromPool(pool *g2pool.Pool) *ClientSet {
+	clientSet := newClientSet(pool)
+	clientSet.pool = pool
+	return clientSet
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Close method closes the underlying gRPC connection.
+It is safe to call more than once; subsequent calls return the result of the first.
+It does not call Destroy() on the Senzing objects held by the server.
+*/
+func (clientSet *ClientSet) Close() error {
+	clientSet.closeOnce.Do(func() {
+		if clientSet.pool != nil {
+			clientSet.closeErr = clientSet.pool.Close()
+			return
+		}
+		clientSet.closeErr = clientSet.connection.Close()
+	})
+	return clientSet.closeErr
+}
+
+/*
+The Connection method returns the gRPC connection shared by the clients,
+or nil if they share a pool of connections; see Pool().
+*/
+func (clientSet *ClientSet) Connection() *grpc.ClientConn {
+	return clientSet.connection
+}
+
+/*
+The Pool method returns the pool of connections shared by the clients,
+or nil if they share a single connection; see Connection().
+*/
+func (clientSet *ClientSet) Pool() *g2pool.Pool {
+	return clientSet.pool
+}
+
+/*
+The FlushObservers method waits until the messages to observers queued by the five clients before the call
+are delivered. Call it before Close() when the process exits.
+Clients replaced by other implementations of their interface are not flushed.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (clientSet *ClientSet) FlushObservers(ctx context.Context) error {
+	for _, client := range []interface{}{clientSet.G2config, clientSet.G2configmgr, clientSet.G2diagnostic, clientSet.G2engine, clientSet.G2product} {
+		if flusher, ok := client.(interface{ FlushObservers(context.Context) error }); ok {
+			if err := flusher.FlushObservers(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/*
+The Health method checks that the Senzing gRPC server is reachable and ready for calls.
+See g2health.Checker for the checks made.
+When the clients share a pool, the connection is not checked; see g2pool.Pool.Endpoints().
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds waiting for the connection.
+*/
+func (clientSet *ClientSet) Health(ctx context.Context) *g2health.Report {
+	return g2health.NewChecker(clientSet.connection, clientSet.G2engine, clientSet.G2configmgr, clientSet.G2product).Health(ctx)
+}
+
+/*
+The OutstandingHandles method returns the handles created on the server by the
+G2config, G2diagnostic and G2engine clients and not yet closed, oldest first.
+Clients replaced by other implementations of their interface are not included.
+*/
+func (clientSet *ClientSet) OutstandingHandles() []g2handle.Handle {
+	result := []g2handle.Handle{}
+	if client, ok := clientSet.G2config.(*g2config.G2config); ok {
+		result = append(result, client.OutstandingHandles()...)
+	}
+	if client, ok := clientSet.G2diagnostic.(*g2diagnostic.G2diagnostic); ok {
+		result = append(result, client.OutstandingHandles()...)
+	}
+	if client, ok := clientSet.G2engine.(*g2engine.G2engine); ok {
+		result = append(result, client.OutstandingHandles()...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result
+}
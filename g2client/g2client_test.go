@@ -0,0 +1,302 @@
+package g2client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2auth"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/g2health"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufferSize    = 1024 * 1024
+	versionResult = `{"PRODUCT_NAME":"Senzing API","VERSION":"3.4.2"}`
+)
+
+var (
+	listener *bufconn.Listener
+	server   *grpc.Server
+)
+
+// An observer counting its messages.
+type countingObserver struct {
+	count int32
+}
+
+type g2productServer struct {
+	g2productpb.UnimplementedG2ProductServer
+}
+
+func (server *g2productServer) Version(ctx context.Context, request *g2productpb.VersionRequest) (*g2productpb.VersionResponse, error) {
+	return &g2productpb.VersionResponse{Result: versionResult}, nil
+}
+
+func (observer *countingObserver) GetObserverId(ctx context.Context) string {
+	return "countingObserver"
+}
+
+func (observer *countingObserver) UpdateObserver(ctx context.Context, message string) {
+	atomic.AddInt32(&observer.count, 1)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func bufDialer(ctx context.Context, address string) (net.Conn, error) {
+	return listener.DialContext(ctx)
+}
+
+func getTestObject(ctx context.Context, test *testing.T, opts ...Option) *ClientSet {
+	opts = append([]Option{WithAddress("bufnet"), WithDialOptions(grpc.WithContextDialer(bufDialer))}, opts...)
+	clientSet, err := NewClientSet(ctx, opts...)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	return clientSet
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2productpb.RegisterG2ProductServer(server, &g2productServer{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestClientSet_NewClientSet(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	assert.NotNil(test, clientSet.G2config)
+	assert.NotNil(test, clientSet.G2configmgr)
+	assert.NotNil(test, clientSet.G2diagnostic)
+	assert.NotNil(test, clientSet.G2engine)
+	assert.NotNil(test, clientSet.G2product)
+	assert.NotNil(test, clientSet.Connection())
+	actual, err := clientSet.G2product.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, versionResult, actual)
+}
+
+func TestClientSet_WithUnaryInterceptors(test *testing.T) {
+	ctx := context.TODO()
+	methods := []string{}
+	interceptor := func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methods = append(methods, method)
+		return invoker(ctx, method, request, reply, cc, opts...)
+	}
+	clientSet := getTestObject(ctx, test, WithUnaryInterceptors(interceptor), WithMaxMessageSize(bufferSize, bufferSize))
+	defer clientSet.Close()
+	_, err := clientSet.G2product.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, []string{"/g2product.G2Product/Version"}, methods)
+}
+
+func TestClientSet_Close(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	assert.Nil(test, clientSet.Close())
+	assert.Nil(test, clientSet.Close())
+	_, err := clientSet.G2product.Version(ctx)
+	assert.NotNil(test, err)
+}
+
+func TestClientSet_Health(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	report := clientSet.Health(ctx)
+	statuses := map[string]g2health.Status{}
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	assert.Equal(test, g2health.StatusPass, statuses[g2health.CheckConnection])
+	assert.Equal(test, g2health.StatusSkip, statuses[g2health.CheckGrpcHealth])
+	assert.Equal(test, g2health.StatusFail, statuses[g2health.CheckConfiguration], "the test server has no G2Engine service")
+	assert.False(test, report.Ready())
+}
+
+func TestClientSet_OutstandingHandles(test *testing.T) {
+	ctx := context.TODO()
+	fakeServer := grpctest.NewServer()
+	defer fakeServer.Close()
+	tracker := g2handle.NewTracker()
+	clientSet, err := NewClientSet(ctx, WithAddress("bufnet"), WithDialOptions(fakeServer.DialOption()), WithHandleTracker(tracker))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer clientSet.Close()
+	configHandle, err := clientSet.G2config.Create(ctx)
+	assert.Nil(test, err)
+	_, err = clientSet.G2engine.ExportJSONEntityReport(ctx, 0)
+	assert.Nil(test, err)
+	handles := clientSet.OutstandingHandles()
+	assert.Len(test, handles, 2)
+	assert.Equal(test, g2handle.ConfigHandle, handles[0].Kind)
+	assert.Equal(test, g2handle.ExportHandle, handles[1].Kind)
+	assert.Equal(test, handles, tracker.Outstanding(nil), "the clients share the tracker")
+	assert.Nil(test, clientSet.G2config.Close(ctx, configHandle))
+	assert.Len(test, clientSet.OutstandingHandles(), 1)
+}
+
+func TestClientSet_WithPerRPCCredentials(test *testing.T) {
+	ctx := context.TODO()
+	authenticated := map[string]bool{}
+	interceptor := func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		incoming, _ := metadata.FromIncomingContext(ctx)
+		if values := incoming.Get("authorization"); len(values) != 1 || values[0] != "Bearer good" {
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+		service, _, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		authenticated[service] = true
+		return handler(ctx, request)
+	}
+	fakeServer := grpctest.NewServer(grpc.ChainUnaryInterceptor(interceptor))
+	defer fakeServer.Close()
+	creds := g2auth.NewBearerCredentials(g2auth.StaticToken("good"))
+	creds.AllowInsecure = true
+	clientSet, err := NewClientSet(ctx, WithAddress("bufnet"), WithDialOptions(fakeServer.DialOption()), WithPerRPCCredentials(creds))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer clientSet.Close()
+	configHandle, err := clientSet.G2config.Create(ctx)
+	assert.Nil(test, err)
+	assert.Nil(test, clientSet.G2config.Close(ctx, configHandle))
+	_, err = clientSet.G2configmgr.GetDefaultConfigID(ctx)
+	assert.Nil(test, err)
+	_, err = clientSet.G2diagnostic.GetLogicalCores(ctx)
+	assert.Nil(test, err)
+	_, err = clientSet.G2engine.CountRedoRecords(ctx)
+	assert.Nil(test, err)
+	_, err = clientSet.G2product.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, map[string]bool{
+		"g2config.G2Config":         true,
+		"g2configmgr.G2ConfigMgr":   true,
+		"g2diagnostic.G2Diagnostic": true,
+		"g2engine.G2Engine":         true,
+		"g2product.G2Product":       true,
+	}, authenticated)
+
+	// Without credentials, every call is rejected.
+	unauthenticated, err := NewClientSet(ctx, WithAddress("bufnet"), WithDialOptions(fakeServer.DialOption()))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer unauthenticated.Close()
+	_, err = unauthenticated.G2product.Version(ctx)
+	assert.Equal(test, codes.Unauthenticated, status.Code(err))
+}
+
+func TestClientSet_WithMetadata(test *testing.T) {
+	ctx := context.TODO()
+	callers := map[string]string{}
+	interceptor := func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		incoming, _ := metadata.FromIncomingContext(ctx)
+		callers[info.FullMethod] = strings.Join(incoming.Get(g2metadata.CallerHeader), ",")
+		return handler(ctx, request)
+	}
+	fakeServer := grpctest.NewServer(grpc.ChainUnaryInterceptor(interceptor))
+	defer fakeServer.Close()
+	clientSet, err := NewClientSet(ctx, WithAddress("bufnet"), WithDialOptions(fakeServer.DialOption()), WithMetadata(g2metadata.Metadata{Caller: "loader"}))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer clientSet.Close()
+	_, err = clientSet.G2product.Version(ctx)
+	assert.Nil(test, err)
+	_, err = clientSet.G2engine.CountRedoRecords(g2metadata.WithCaller(ctx, "redo"))
+	assert.Nil(test, err)
+	assert.Equal(test, map[string]string{
+		"/g2product.G2Product/Version":        "loader",
+		"/g2engine.G2Engine/CountRedoRecords": "redo",
+	}, callers)
+}
+
+func TestClientSet_FlushObservers(test *testing.T) {
+	ctx := context.TODO()
+	fakeServer := grpctest.NewServer()
+	defer fakeServer.Close()
+	clientSet, err := NewClientSet(ctx, WithAddress("bufnet"), WithDialOptions(fakeServer.DialOption()), WithObserverQueue(1, g2observer.Block))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer clientSet.Close()
+	observer := &countingObserver{}
+	assert.Nil(test, clientSet.G2configmgr.RegisterObserver(ctx, observer))
+	assert.Nil(test, clientSet.G2product.RegisterObserver(ctx, observer))
+	for i := 0; i < 10; i++ {
+		_, err = clientSet.G2configmgr.GetDefaultConfigID(ctx)
+		assert.Nil(test, err)
+		_, err = clientSet.G2product.Version(ctx)
+		assert.Nil(test, err)
+	}
+
+	// Once flushed, every message has been delivered.
+	assert.Nil(test, clientSet.FlushObservers(ctx))
+	assert.Equal(test, int32(22), atomic.LoadInt32(&observer.count))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleNewClientSet() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2client/g2client_test.go
+	ctx := context.TODO()
+	clientSet, err := NewClientSet(ctx, WithAddress("localhost:8258"))
+	if err != nil {
+		fmt.Println(err)
+	}
+	defer clientSet.Close()
+	// Output:
+}
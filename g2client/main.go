@@ -0,0 +1,15 @@
+package g2client
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The address used when no WithAddress() option is given.
+const DefaultAddress = "localhost:8258"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An Option configures how NewClientSet() dials the Senzing gRPC server.
+type Option func(*clientSetOptions)
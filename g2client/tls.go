@@ -0,0 +1,183 @@
+package g2client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// TLSConfig describes the files used to secure a connection to a Senzing gRPC server.
+// Files are re-read when their modification time changes, so rotated certificates
+// are used by the next TLS handshake without restarting the client.
+type TLSConfig struct {
+	ClientCertFile     string // PEM client certificate for mutual TLS. Requires ClientKeyFile.
+	ClientKeyFile      string // PEM private key matching ClientCertFile.
+	ServerCAFile       string // PEM bundle of CAs trusted to sign the server certificate. Empty uses the system roots.
+	ServerNameOverride string // Name verified against the server certificate instead of the dialed host.
+}
+
+// Reloads certificate material when the underlying files change.
+type certificateReloader struct {
+	clientCert    *tls.Certificate
+	clientModTime time.Time
+	config        TLSConfig
+	lock          sync.Mutex
+	serverCAs     *x509.CertPool
+	serverModTime time.Time
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func modTime(filename string) (time.Time, error) {
+	fileInfo, err := os.Stat(filename)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fileInfo.ModTime(), nil
+}
+
+// Return the client certificate, reloading it if either file has changed.
+func (reloader *certificateReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	reloader.lock.Lock()
+	defer reloader.lock.Unlock()
+	certModTime, err := modTime(reloader.config.ClientCertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyModTime, err := modTime(reloader.config.ClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if keyModTime.After(certModTime) {
+		certModTime = keyModTime
+	}
+	if reloader.clientCert == nil || !certModTime.Equal(reloader.clientModTime) {
+		clientCert, err := tls.LoadX509KeyPair(reloader.config.ClientCertFile, reloader.config.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		reloader.clientCert = &clientCert
+		reloader.clientModTime = certModTime
+	}
+	return reloader.clientCert, nil
+}
+
+// Return the pool of trusted server CAs, reloading it if the file has changed.
+func (reloader *certificateReloader) getServerCAs() (*x509.CertPool, error) {
+	reloader.lock.Lock()
+	defer reloader.lock.Unlock()
+	if reloader.config.ServerCAFile == "" {
+		return x509.SystemCertPool()
+	}
+	caModTime, err := modTime(reloader.config.ServerCAFile)
+	if err != nil {
+		return nil, err
+	}
+	if reloader.serverCAs == nil || !caModTime.Equal(reloader.serverModTime) {
+		pemBytes, err := os.ReadFile(reloader.config.ServerCAFile)
+		if err != nil {
+			return nil, err
+		}
+		serverCAs := x509.NewCertPool()
+		if !serverCAs.AppendCertsFromPEM(pemBytes) {
+			return nil, fmt.Errorf("no certificates found in %s", reloader.config.ServerCAFile)
+		}
+		reloader.serverCAs = serverCAs
+		reloader.serverModTime = caModTime
+	}
+	return reloader.serverCAs, nil
+}
+
+// Verify the server certificate chain against the current pool of trusted CAs.
+func (reloader *certificateReloader) verifyConnection(connectionState tls.ConnectionState) error {
+	if len(connectionState.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	serverCAs, err := reloader.getServerCAs()
+	if err != nil {
+		return err
+	}
+	serverName := reloader.config.ServerNameOverride
+	if serverName == "" {
+		serverName = connectionState.ServerName
+	}
+	verifyOptions := x509.VerifyOptions{
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+		Roots:         serverCAs,
+	}
+	for _, certificate := range connectionState.PeerCertificates[1:] {
+		verifyOptions.Intermediates.AddCert(certificate)
+	}
+	_, err = connectionState.PeerCertificates[0].Verify(verifyOptions)
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewTLSCredentials function builds gRPC transport credentials from a TLSConfig.
+The files are read immediately so that configuration errors are reported early.
+
+Input
+  - tlsConfig: Locations of the CA bundle and the optional client key pair.
+
+Output
+  - Transport credentials usable with WithTransportCredentials() or grpc.WithTransportCredentials().
+*/
+func NewTLSCredentials(tlsConfig TLSConfig) (credentials.TransportCredentials, error) {
+	if (tlsConfig.ClientCertFile == "") != (tlsConfig.ClientKeyFile == "") {
+		return nil, errors.New("ClientCertFile and ClientKeyFile must be specified together")
+	}
+	reloader := &certificateReloader{
+		config: tlsConfig,
+	}
+	if _, err := reloader.getServerCAs(); err != nil {
+		return nil, err
+	}
+	result := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         tlsConfig.ServerNameOverride,
+		InsecureSkipVerify: true, // Verification is performed by VerifyConnection so the CA bundle can be reloaded.
+		VerifyConnection:   reloader.verifyConnection,
+	}
+	if tlsConfig.ClientCertFile != "" {
+		if _, err := reloader.getClientCertificate(nil); err != nil {
+			return nil, err
+		}
+		result.GetClientCertificate = reloader.getClientCertificate
+	}
+	return credentials.NewTLS(result), nil
+}
+
+/*
+The WithTLS option secures the connection with TLS, or mutual TLS when a client key pair is given.
+Errors reading the files are returned by NewClientSet().
+
+Input
+  - tlsConfig: Locations of the CA bundle and the optional client key pair.
+*/
+func WithTLS(tlsConfig TLSConfig) Option {
+	return func(options *clientSetOptions) {
+		transportCredentials, err := NewTLSCredentials(tlsConfig)
+		if err != nil {
+			options.err = err
+			return
+		}
+		options.transportCredentials = transportCredentials
+	}
+}
@@ -0,0 +1,240 @@
+package g2client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCertificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pemBytes    []byte
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+var serialNumber int64 = 1
+
+func newCertificate(test *testing.T, template *x509.Certificate, parent *testCertificateAuthority) (*x509.Certificate, *ecdsa.PrivateKey, []byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(test, err)
+	serialNumber++
+	template.SerialNumber = big.NewInt(serialNumber)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parentCertificate, parentKey := template, key
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.key
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &key.PublicKey, parentKey)
+	assert.Nil(test, err)
+	certificate, err := x509.ParseCertificate(derBytes)
+	assert.Nil(test, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(test, err)
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+	return certificate, key, certPem, keyPem
+}
+
+func newCertificateAuthority(test *testing.T, name string) *testCertificateAuthority {
+	certificate, key, certPem, _ := newCertificate(test, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}, nil)
+	return &testCertificateAuthority{certificate: certificate, key: key, pemBytes: certPem}
+}
+
+func newLeaf(test *testing.T, ca *testCertificateAuthority, name string, extKeyUsage x509.ExtKeyUsage) (tls.Certificate, []byte, []byte) {
+	_, _, certPem, keyPem := newCertificate(test, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		DNSNames:    []string{name},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{extKeyUsage},
+	}, ca)
+	keyPair, err := tls.X509KeyPair(certPem, keyPem)
+	assert.Nil(test, err)
+	return keyPair, certPem, keyPem
+}
+
+func writeFile(test *testing.T, directory string, name string, contents []byte) string {
+	filename := filepath.Join(directory, name)
+	assert.Nil(test, os.WriteFile(filename, contents, 0600))
+	return filename
+}
+
+// Start a TLS server. If clientCAs is not nil, client certificates are required.
+func startTLSServer(test *testing.T, serverCert tls.Certificate, clientCAs *x509.CertPool) string {
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAs != nil {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsConfig.ClientCAs = clientCAs
+	}
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(test, err)
+	tlsServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	g2productpb.RegisterG2ProductServer(tlsServer, &g2productServer{})
+	go func() {
+		_ = tlsServer.Serve(tcpListener)
+	}()
+	test.Cleanup(tlsServer.Stop)
+	return tcpListener.Addr().String()
+}
+
+func callVersion(test *testing.T, address string, tlsConfig TLSConfig) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	clientSet, err := NewClientSet(ctx, WithAddress(address), WithTLS(tlsConfig))
+	if err != nil {
+		return err
+	}
+	defer clientSet.Close()
+	actual, err := clientSet.G2product.Version(ctx)
+	if err == nil {
+		assert.Equal(test, versionResult, actual)
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestTLS_ServerAuthentication(test *testing.T) {
+	directory := test.TempDir()
+	ca := newCertificateAuthority(test, "Test CA")
+	serverCert, _, _ := newLeaf(test, ca, "localhost", x509.ExtKeyUsageServerAuth)
+	address := startTLSServer(test, serverCert, nil)
+	caFile := writeFile(test, directory, "ca.pem", ca.pemBytes)
+
+	err := callVersion(test, address, TLSConfig{ServerCAFile: caFile, ServerNameOverride: "localhost"})
+	assert.Nil(test, err)
+}
+
+func TestTLS_UntrustedServer(test *testing.T) {
+	directory := test.TempDir()
+	ca := newCertificateAuthority(test, "Test CA")
+	otherCa := newCertificateAuthority(test, "Other CA")
+	serverCert, _, _ := newLeaf(test, ca, "localhost", x509.ExtKeyUsageServerAuth)
+	address := startTLSServer(test, serverCert, nil)
+	caFile := writeFile(test, directory, "ca.pem", otherCa.pemBytes)
+
+	err := callVersion(test, address, TLSConfig{ServerCAFile: caFile, ServerNameOverride: "localhost"})
+	assert.NotNil(test, err)
+}
+
+func TestTLS_ServerNameMismatch(test *testing.T) {
+	directory := test.TempDir()
+	ca := newCertificateAuthority(test, "Test CA")
+	serverCert, _, _ := newLeaf(test, ca, "senzing.example.com", x509.ExtKeyUsageServerAuth)
+	address := startTLSServer(test, serverCert, nil)
+	caFile := writeFile(test, directory, "ca.pem", ca.pemBytes)
+
+	err := callVersion(test, address, TLSConfig{ServerCAFile: caFile, ServerNameOverride: "localhost"})
+	assert.NotNil(test, err)
+	err = callVersion(test, address, TLSConfig{ServerCAFile: caFile, ServerNameOverride: "senzing.example.com"})
+	assert.Nil(test, err)
+}
+
+func TestTLS_MutualAuthentication(test *testing.T) {
+	directory := test.TempDir()
+	ca := newCertificateAuthority(test, "Test CA")
+	serverCert, _, _ := newLeaf(test, ca, "localhost", x509.ExtKeyUsageServerAuth)
+	_, clientCertPem, clientKeyPem := newLeaf(test, ca, "client", x509.ExtKeyUsageClientAuth)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.certificate)
+	address := startTLSServer(test, serverCert, clientCAs)
+	tlsConfig := TLSConfig{
+		ClientCertFile:     writeFile(test, directory, "client.pem", clientCertPem),
+		ClientKeyFile:      writeFile(test, directory, "client-key.pem", clientKeyPem),
+		ServerCAFile:       writeFile(test, directory, "ca.pem", ca.pemBytes),
+		ServerNameOverride: "localhost",
+	}
+
+	err := callVersion(test, address, tlsConfig)
+	assert.Nil(test, err)
+
+	// Without a client certificate the server rejects the handshake.
+
+	err = callVersion(test, address, TLSConfig{ServerCAFile: tlsConfig.ServerCAFile, ServerNameOverride: "localhost"})
+	assert.NotNil(test, err)
+}
+
+func TestTLS_CertificateRotation(test *testing.T) {
+	directory := test.TempDir()
+	ca := newCertificateAuthority(test, "Test CA")
+	otherCa := newCertificateAuthority(test, "Other CA")
+	serverCert, _, _ := newLeaf(test, ca, "localhost", x509.ExtKeyUsageServerAuth)
+	_, clientCertPem, clientKeyPem := newLeaf(test, otherCa, "client", x509.ExtKeyUsageClientAuth)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.certificate)
+	address := startTLSServer(test, serverCert, clientCAs)
+	tlsConfig := TLSConfig{
+		ClientCertFile:     writeFile(test, directory, "client.pem", clientCertPem),
+		ClientKeyFile:      writeFile(test, directory, "client-key.pem", clientKeyPem),
+		ServerCAFile:       writeFile(test, directory, "ca.pem", ca.pemBytes),
+		ServerNameOverride: "localhost",
+	}
+	transportCredentials, err := NewTLSCredentials(tlsConfig)
+	assert.Nil(test, err)
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	// Client certificate is signed by the wrong CA.
+
+	clientSet, err := NewClientSet(ctx, WithAddress(address), WithTransportCredentials(transportCredentials))
+	assert.Nil(test, err)
+	_, err = clientSet.G2product.Version(ctx)
+	assert.NotNil(test, err)
+	clientSet.Close()
+
+	// Rotate the client key pair on disk; the same credentials pick it up.
+
+	_, clientCertPem, clientKeyPem = newLeaf(test, ca, "client", x509.ExtKeyUsageClientAuth)
+	writeFile(test, directory, "client.pem", clientCertPem)
+	writeFile(test, directory, "client-key.pem", clientKeyPem)
+	future := time.Now().Add(time.Minute)
+	assert.Nil(test, os.Chtimes(tlsConfig.ClientCertFile, future, future))
+
+	clientSet, err = NewClientSet(ctx, WithAddress(address), WithTransportCredentials(transportCredentials))
+	assert.Nil(test, err)
+	defer clientSet.Close()
+	actual, err := clientSet.G2product.Version(ctx)
+	assert.Nil(test, err)
+	assert.Equal(test, versionResult, actual)
+}
+
+func TestTLS_BadConfiguration(test *testing.T) {
+	directory := test.TempDir()
+	_, err := NewTLSCredentials(TLSConfig{ClientCertFile: "client.pem"})
+	assert.NotNil(test, err)
+	_, err = NewTLSCredentials(TLSConfig{ServerCAFile: filepath.Join(directory, "missing.pem")})
+	assert.NotNil(test, err)
+	_, err = NewClientSet(context.TODO(), WithTLS(TLSConfig{ServerCAFile: writeFile(test, directory, "empty.pem", []byte{})}))
+	assert.NotNil(test, err)
+}
@@ -0,0 +1,274 @@
+/*
+ *
+ */
+
+package g2config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Attribute is an attribute of a configuration, the name of a value in a record, e.g. NAME_FULL.
+type Attribute struct {
+	AttrClass string `json:"ATTR_CLASS,omitempty"`
+	AttrCode  string `json:"ATTR_CODE"`
+	AttrID    int64  `json:"ATTR_ID,omitempty"`
+	FelemCode string `json:"FELEM_CODE,omitempty"`
+	FtypeCode string `json:"FTYPE_CODE,omitempty"`
+}
+
+// ConfigDiff is what changed between two Senzing configurations.
+type ConfigDiff struct {
+	AddedAttributes     []Attribute   `json:"ADDED_ATTRIBUTES,omitempty"`
+	AddedDataSources    []DataSource  `json:"ADDED_DATA_SOURCES,omitempty"`
+	AddedFeatureTypes   []FeatureType `json:"ADDED_FEATURE_TYPES,omitempty"`
+	AddedRules          []Rule        `json:"ADDED_RULES,omitempty"`
+	ChangedRules        []RuleChange  `json:"CHANGED_RULES,omitempty"`
+	RemovedAttributes   []Attribute   `json:"REMOVED_ATTRIBUTES,omitempty"`
+	RemovedDataSources  []DataSource  `json:"REMOVED_DATA_SOURCES,omitempty"`
+	RemovedFeatureTypes []FeatureType `json:"REMOVED_FEATURE_TYPES,omitempty"`
+	RemovedRules        []Rule        `json:"REMOVED_RULES,omitempty"`
+}
+
+// DataSource is a data source of a configuration.
+type DataSource struct {
+	DsrcCode string `json:"DSRC_CODE"`
+	DsrcID   int64  `json:"DSRC_ID,omitempty"`
+}
+
+// FeatureType is a feature type of a configuration, e.g. NAME or ADDRESS.
+type FeatureType struct {
+	FtypeCode string `json:"FTYPE_CODE"`
+	FtypeID   int64  `json:"FTYPE_ID,omitempty"`
+}
+
+// FieldChange is a setting whose value differs between two configurations.
+type FieldChange struct {
+	Field    string      `json:"FIELD"`
+	NewValue interface{} `json:"NEW_VALUE"`
+	OldValue interface{} `json:"OLD_VALUE"`
+}
+
+// Rule is an entity resolution rule of a configuration, in "CFG_ERRULE".
+type Rule struct {
+	ErruleCode string `json:"ERRULE_CODE"`
+	ErruleID   int64  `json:"ERRULE_ID,omitempty"`
+}
+
+// RuleChange is a rule in both configurations whose settings differ, sorted by field.
+type RuleChange struct {
+	Changes    []FieldChange `json:"CHANGES"`
+	ErruleCode string        `json:"ERRULE_CODE"`
+}
+
+// The parts of a configuration document that are compared.
+type configDocument struct {
+	G2Config struct {
+		CfgAttr   []Attribute              `json:"CFG_ATTR"`
+		CfgDsrc   []DataSource             `json:"CFG_DSRC"`
+		CfgErrule []map[string]interface{} `json:"CFG_ERRULE"`
+		CfgFtype  []FeatureType            `json:"CFG_FTYPE"`
+	} `json:"G2_CONFIG"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func parseConfigDocument(jsonConfig string) (*configDocument, error) {
+	document := &configDocument{}
+	if err := json.Unmarshal([]byte(jsonConfig), document); err != nil {
+		return nil, fmt.Errorf("g2config: invalid configuration: %w", err)
+	}
+	return document, nil
+}
+
+// The rules of a configuration, keyed by ERRULE_CODE.
+func rulesByCode(entries []map[string]interface{}) map[string]map[string]interface{} {
+	result := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		if code, ok := entry["ERRULE_CODE"].(string); ok {
+			result[code] = entry
+		}
+	}
+	return result
+}
+
+func newRule(entry map[string]interface{}) Rule {
+	rule := Rule{}
+	rule.ErruleCode, _ = entry["ERRULE_CODE"].(string)
+	if id, ok := entry["ERRULE_ID"].(float64); ok {
+		rule.ErruleID = int64(id)
+	}
+	return rule
+}
+
+// The rules of oldEntries and newEntries whose code is in only one of them, and the changes to the others.
+func diffRules(oldEntries []map[string]interface{}, newEntries []map[string]interface{}) ([]Rule, []Rule, []RuleChange) {
+	oldRules := rulesByCode(oldEntries)
+	newRules := rulesByCode(newEntries)
+	added := []Rule{}
+	removed := []Rule{}
+	changed := []RuleChange{}
+	for code, newEntry := range newRules {
+		oldEntry, ok := oldRules[code]
+		if !ok {
+			added = append(added, newRule(newEntry))
+			continue
+		}
+		change := RuleChange{ErruleCode: code, Changes: diffFields(oldEntry, newEntry)}
+		if len(change.Changes) > 0 {
+			changed = append(changed, change)
+		}
+	}
+	for code, oldEntry := range oldRules {
+		if _, ok := newRules[code]; !ok {
+			removed = append(removed, newRule(oldEntry))
+		}
+	}
+	sortByCode(added, func(rule Rule) string { return rule.ErruleCode })
+	sortByCode(removed, func(rule Rule) string { return rule.ErruleCode })
+	sortByCode(changed, func(change RuleChange) string { return change.ErruleCode })
+	return added, removed, changed
+}
+
+// The fields whose values differ, sorted by field.
+func diffFields(oldEntry map[string]interface{}, newEntry map[string]interface{}) []FieldChange {
+	fields := map[string]bool{}
+	for field := range oldEntry {
+		fields[field] = true
+	}
+	for field := range newEntry {
+		fields[field] = true
+	}
+	result := []FieldChange{}
+	for field := range fields {
+		if !reflect.DeepEqual(oldEntry[field], newEntry[field]) {
+			result = append(result, FieldChange{Field: field, OldValue: oldEntry[field], NewValue: newEntry[field]})
+		}
+	}
+	sortByCode(result, func(change FieldChange) string { return change.Field })
+	return result
+}
+
+func sortByCode[T any](items []T, code func(T) string) {
+	sort.Slice(items, func(i, j int) bool { return code(items[i]) < code(items[j]) })
+}
+
+// The items whose code is not the code of any of others, sorted by code.
+func subtract[T any](items []T, others []T, code func(T) string) []T {
+	codes := map[string]bool{}
+	for _, other := range others {
+		codes[code(other)] = true
+	}
+	result := []T{}
+	for _, item := range items {
+		if !codes[code(item)] {
+			result = append(result, item)
+		}
+	}
+	sortByCode(result, code)
+	return result
+}
+
+func attributeCode(attribute Attribute) string       { return attribute.AttrCode }
+func dataSourceCode(dataSource DataSource) string    { return dataSource.DsrcCode }
+func featureTypeCode(featureType FeatureType) string { return featureType.FtypeCode }
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The IsEmpty method returns true if the configurations compared are the same.
+*/
+func (diff *ConfigDiff) IsEmpty() bool {
+	return len(diff.AddedAttributes) == 0 && len(diff.RemovedAttributes) == 0 &&
+		len(diff.AddedDataSources) == 0 && len(diff.RemovedDataSources) == 0 &&
+		len(diff.AddedFeatureTypes) == 0 && len(diff.RemovedFeatureTypes) == 0 &&
+		len(diff.AddedRules) == 0 && len(diff.RemovedRules) == 0 && len(diff.ChangedRules) == 0
+}
+
+/*
+The String method returns the differences one per line, each starting with
+"+" for an addition, "-" for a removal or "~" for a change.
+*/
+func (diff *ConfigDiff) String() string {
+	var builder strings.Builder
+	for _, dataSource := range diff.AddedDataSources {
+		fmt.Fprintf(&builder, "+ data source %s (DSRC_ID %d)\n", dataSource.DsrcCode, dataSource.DsrcID)
+	}
+	for _, dataSource := range diff.RemovedDataSources {
+		fmt.Fprintf(&builder, "- data source %s (DSRC_ID %d)\n", dataSource.DsrcCode, dataSource.DsrcID)
+	}
+	for _, featureType := range diff.AddedFeatureTypes {
+		fmt.Fprintf(&builder, "+ feature type %s (FTYPE_ID %d)\n", featureType.FtypeCode, featureType.FtypeID)
+	}
+	for _, featureType := range diff.RemovedFeatureTypes {
+		fmt.Fprintf(&builder, "- feature type %s (FTYPE_ID %d)\n", featureType.FtypeCode, featureType.FtypeID)
+	}
+	for _, attribute := range diff.AddedAttributes {
+		fmt.Fprintf(&builder, "+ attribute %s (ATTR_ID %d)\n", attribute.AttrCode, attribute.AttrID)
+	}
+	for _, attribute := range diff.RemovedAttributes {
+		fmt.Fprintf(&builder, "- attribute %s (ATTR_ID %d)\n", attribute.AttrCode, attribute.AttrID)
+	}
+	for _, rule := range diff.AddedRules {
+		fmt.Fprintf(&builder, "+ rule %s (ERRULE_ID %d)\n", rule.ErruleCode, rule.ErruleID)
+	}
+	for _, rule := range diff.RemovedRules {
+		fmt.Fprintf(&builder, "- rule %s (ERRULE_ID %d)\n", rule.ErruleCode, rule.ErruleID)
+	}
+	for _, rule := range diff.ChangedRules {
+		for _, change := range rule.Changes {
+			fmt.Fprintf(&builder, "~ rule %s %s: %v -> %v\n", rule.ErruleCode, change.Field, change.OldValue, change.NewValue)
+		}
+	}
+	return builder.String()
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The DiffConfigs function compares two configuration documents, as returned by Save() or G2configmgr.GetConfig().
+Data sources, feature types, attributes and rules are matched by code.
+
+Input
+  - oldJsonConfig: The configuration before the change.
+  - newJsonConfig: The configuration after the change.
+
+Output
+  - What was added to, removed from and changed in oldJsonConfig to get newJsonConfig.
+*/
+func DiffConfigs(oldJsonConfig string, newJsonConfig string) (*ConfigDiff, error) {
+	oldDocument, err := parseConfigDocument(oldJsonConfig)
+	if err != nil {
+		return nil, err
+	}
+	newDocument, err := parseConfigDocument(newJsonConfig)
+	if err != nil {
+		return nil, err
+	}
+	oldConfig := oldDocument.G2Config
+	newConfig := newDocument.G2Config
+	diff := &ConfigDiff{
+		AddedAttributes:     subtract(newConfig.CfgAttr, oldConfig.CfgAttr, attributeCode),
+		AddedDataSources:    subtract(newConfig.CfgDsrc, oldConfig.CfgDsrc, dataSourceCode),
+		AddedFeatureTypes:   subtract(newConfig.CfgFtype, oldConfig.CfgFtype, featureTypeCode),
+		RemovedAttributes:   subtract(oldConfig.CfgAttr, newConfig.CfgAttr, attributeCode),
+		RemovedDataSources:  subtract(oldConfig.CfgDsrc, newConfig.CfgDsrc, dataSourceCode),
+		RemovedFeatureTypes: subtract(oldConfig.CfgFtype, newConfig.CfgFtype, featureTypeCode),
+	}
+	diff.AddedRules, diff.RemovedRules, diff.ChangedRules = diffRules(oldConfig.CfgErrule, newConfig.CfgErrule)
+	return diff, nil
+}
@@ -0,0 +1,102 @@
+package g2config
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	oldDiffConfig = `{"G2_CONFIG":{
+		"CFG_ATTR":[{"ATTR_ID":1001,"ATTR_CODE":"DATA_SOURCE","ATTR_CLASS":"OBSERVATION","FTYPE_CODE":null,"FELEM_CODE":null},{"ATTR_ID":1601,"ATTR_CODE":"ACCT_NUM","ATTR_CLASS":"IDENTIFIER","FTYPE_CODE":"ACCT_NUM","FELEM_CODE":"ID_NUM"}],
+		"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":1001,"DSRC_CODE":"CUSTOMERS"}],
+		"CFG_ERRULE":[{"ERRULE_ID":100,"ERRULE_CODE":"SAME_A1","RESOLVE":"Yes","RELATE":"No","ERRULE_TIER":10},{"ERRULE_ID":108,"ERRULE_CODE":"SF1","RESOLVE":"Yes","RELATE":"No","ERRULE_TIER":18}],
+		"CFG_FTYPE":[{"FTYPE_ID":1,"FTYPE_CODE":"NAME"},{"FTYPE_ID":19,"FTYPE_CODE":"ACCT_NUM"}]}}`
+	newDiffConfig = `{"G2_CONFIG":{
+		"CFG_ATTR":[{"ATTR_ID":1001,"ATTR_CODE":"DATA_SOURCE","ATTR_CLASS":"OBSERVATION","FTYPE_CODE":null,"FELEM_CODE":null},{"ATTR_ID":2001,"ATTR_CODE":"LOYALTY_ID","ATTR_CLASS":"IDENTIFIER","FTYPE_CODE":"LOYALTY_ID","FELEM_CODE":"ID_NUM"}],
+		"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":1002,"DSRC_CODE":"WATCHLIST"}],
+		"CFG_ERRULE":[{"ERRULE_ID":100,"ERRULE_CODE":"SAME_A1","RESOLVE":"Yes","RELATE":"No","ERRULE_TIER":10},{"ERRULE_ID":108,"ERRULE_CODE":"SF1","RESOLVE":"No","RELATE":"Yes","ERRULE_TIER":18},{"ERRULE_ID":200,"ERRULE_CODE":"CNAME_CFF","RESOLVE":"Yes","RELATE":"No","ERRULE_TIER":20}],
+		"CFG_FTYPE":[{"FTYPE_ID":1,"FTYPE_CODE":"NAME"},{"FTYPE_ID":1001,"FTYPE_CODE":"LOYALTY_ID"}]}}`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestDiffConfigs(test *testing.T) {
+	diff, err := DiffConfigs(oldDiffConfig, newDiffConfig)
+	testError(test, nil, nil, err)
+	assert.False(test, diff.IsEmpty())
+	assert.Equal(test, []DataSource{{DsrcCode: "WATCHLIST", DsrcID: 1002}}, diff.AddedDataSources)
+	assert.Equal(test, []DataSource{{DsrcCode: "CUSTOMERS", DsrcID: 1001}}, diff.RemovedDataSources)
+	assert.Equal(test, []FeatureType{{FtypeCode: "LOYALTY_ID", FtypeID: 1001}}, diff.AddedFeatureTypes)
+	assert.Equal(test, []FeatureType{{FtypeCode: "ACCT_NUM", FtypeID: 19}}, diff.RemovedFeatureTypes)
+	assert.Equal(test, []Attribute{{AttrClass: "IDENTIFIER", AttrCode: "LOYALTY_ID", AttrID: 2001, FelemCode: "ID_NUM", FtypeCode: "LOYALTY_ID"}}, diff.AddedAttributes)
+	assert.Equal(test, []Attribute{{AttrClass: "IDENTIFIER", AttrCode: "ACCT_NUM", AttrID: 1601, FelemCode: "ID_NUM", FtypeCode: "ACCT_NUM"}}, diff.RemovedAttributes)
+	assert.Equal(test, []Rule{{ErruleCode: "CNAME_CFF", ErruleID: 200}}, diff.AddedRules)
+	assert.Empty(test, diff.RemovedRules)
+	assert.Equal(test, []RuleChange{{ErruleCode: "SF1", Changes: []FieldChange{
+		{Field: "RELATE", OldValue: "No", NewValue: "Yes"},
+		{Field: "RESOLVE", OldValue: "Yes", NewValue: "No"},
+	}}}, diff.ChangedRules)
+}
+
+func TestDiffConfigs_String(test *testing.T) {
+	diff, err := DiffConfigs(oldDiffConfig, newDiffConfig)
+	testError(test, nil, nil, err)
+	expected := `+ data source WATCHLIST (DSRC_ID 1002)
+- data source CUSTOMERS (DSRC_ID 1001)
++ feature type LOYALTY_ID (FTYPE_ID 1001)
+- feature type ACCT_NUM (FTYPE_ID 19)
++ attribute LOYALTY_ID (ATTR_ID 2001)
+- attribute ACCT_NUM (ATTR_ID 1601)
++ rule CNAME_CFF (ERRULE_ID 200)
+~ rule SF1 RELATE: No -> Yes
+~ rule SF1 RESOLVE: Yes -> No
+`
+	assert.Equal(test, expected, diff.String())
+}
+
+func TestDiffConfigs_Json(test *testing.T) {
+	diff, err := DiffConfigs(newDiffConfig, oldDiffConfig)
+	testError(test, nil, nil, err)
+	diffJson, err := json.Marshal(diff)
+	testError(test, nil, nil, err)
+	assert.JSONEq(test, `{
+		"ADDED_ATTRIBUTES":[{"ATTR_CLASS":"IDENTIFIER","ATTR_CODE":"ACCT_NUM","ATTR_ID":1601,"FELEM_CODE":"ID_NUM","FTYPE_CODE":"ACCT_NUM"}],
+		"ADDED_DATA_SOURCES":[{"DSRC_CODE":"CUSTOMERS","DSRC_ID":1001}],
+		"ADDED_FEATURE_TYPES":[{"FTYPE_CODE":"ACCT_NUM","FTYPE_ID":19}],
+		"CHANGED_RULES":[{"ERRULE_CODE":"SF1","CHANGES":[{"FIELD":"RELATE","OLD_VALUE":"Yes","NEW_VALUE":"No"},{"FIELD":"RESOLVE","OLD_VALUE":"No","NEW_VALUE":"Yes"}]}],
+		"REMOVED_ATTRIBUTES":[{"ATTR_CLASS":"IDENTIFIER","ATTR_CODE":"LOYALTY_ID","ATTR_ID":2001,"FELEM_CODE":"ID_NUM","FTYPE_CODE":"LOYALTY_ID"}],
+		"REMOVED_DATA_SOURCES":[{"DSRC_CODE":"WATCHLIST","DSRC_ID":1002}],
+		"REMOVED_FEATURE_TYPES":[{"FTYPE_CODE":"LOYALTY_ID","FTYPE_ID":1001}],
+		"REMOVED_RULES":[{"ERRULE_CODE":"CNAME_CFF","ERRULE_ID":200}]
+	}`, string(diffJson))
+}
+
+func TestDiffConfigs_Same(test *testing.T) {
+	diff, err := DiffConfigs(oldDiffConfig, oldDiffConfig)
+	testError(test, nil, nil, err)
+	assert.True(test, diff.IsEmpty())
+	assert.Equal(test, "", diff.String())
+	_, err = DiffConfigs(oldDiffConfig, `{"G2_CONFIG":`)
+	assert.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleDiffConfigs() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2config/diff_test.go
+	oldJsonConfig := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"}]}}`
+	newJsonConfig := `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":1001,"DSRC_CODE":"CUSTOMERS"}]}}`
+	diff, err := DiffConfigs(oldJsonConfig, newJsonConfig)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Print(diff)
+	// Output: + data source CUSTOMERS (DSRC_ID 1001)
+}
@@ -7,17 +7,22 @@ package g2config
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
 	g2configapi "github.com/senzing/g2-sdk-go/g2config"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2config"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
 	"github.com/senzing/go-observing/observer"
-	"github.com/senzing/go-observing/subject"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 // ----------------------------------------------------------------------------
@@ -25,16 +30,29 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2config struct {
-	GrpcClient g2pb.G2ConfigClient
-	isTrace    bool
-	logger     messagelogger.MessageLoggerInterface
-	observers  subject.Subject
+	GrpcClient  g2pb.G2ConfigClient
+	deadlines   *g2deadline.Deadlines
+	handles     *g2handle.Tracker
+	handlesOnce sync.Once
+	isTrace     bool
+	logger      messagelogger.MessageLoggerInterface
+	observers   *g2observer.Queue
 }
 
 // ----------------------------------------------------------------------------
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// Get the handle Tracker, creating one on first use.
+func (client *G2config) getHandles() *g2handle.Tracker {
+	client.handlesOnce.Do(func() {
+		if client.handles == nil {
+			client.handles = g2handle.NewTracker()
+		}
+	})
+	return client.handles
+}
+
 // Get the Logger singleton.
 func (client *G2config) getLogger() messagelogger.MessageLoggerInterface {
 	if client.logger == nil {
@@ -43,31 +61,35 @@ func (client *G2config) getLogger() messagelogger.MessageLoggerInterface {
 	return client.logger
 }
 
+// Log a handle left open, as a warning that SetLogLevel() can filter out.
+func (client *G2config) logHandleLeak(message string) {
+	client.getLogger().Log(3001, message)
+}
+
 // Notify registered observers.
 func (client *G2config) notify(ctx context.Context, messageId int, err error, details map[string]string) {
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
 	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	details["correlationID"] = g2metadata.CorrelationID(ctx)
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	err = client.observers.Notify(ctx, details)
 	if err != nil {
 		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
 	}
 }
 
 // Trace method entry.
-func (client *G2config) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2config) traceEntry(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // Trace method exit.
-func (client *G2config) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2config) traceExit(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // ----------------------------------------------------------------------------
@@ -88,26 +110,27 @@ Output
     See the example output.
 */
 func (client *G2config) AddDataSource(ctx context.Context, configHandle uintptr, inputJson string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(1, configHandle, inputJson)
+		client.traceEntry(ctx, 1, configHandle, inputJson)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddDataSourceRequest{
 		ConfigHandle: int64(configHandle),
 		InputJson:    inputJson,
 	}
-	response, err := client.GrpcClient.AddDataSource(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "AddDataSource", &request, client.GrpcClient.AddDataSource, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ConfigHandle, configHandle, "AddDataSource", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"inputJson": inputJson,
-				"return":    response.GetResult(),
-			}
-			client.notify(ctx, 8001, err, details)
-		}()
+		details := map[string]string{
+			"inputJson": inputJson,
+			"return":    response.GetResult(),
+		}
+		client.notify(ctx, 8001, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(2, configHandle, inputJson, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 2, configHandle, inputJson, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -121,22 +144,23 @@ Input
   - configHandle: An identifier of an in-memory configuration.
 */
 func (client *G2config) Close(ctx context.Context, configHandle uintptr) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(5, configHandle)
+		client.traceEntry(ctx, 5, configHandle)
 	}
 	entryTime := time.Now()
 	request := g2pb.CloseRequest{
 		ConfigHandle: int64(configHandle),
 	}
-	_, err := client.GrpcClient.Close(ctx, &request)
+	callPeer := peer.Peer{}
+	_, err := g2deadline.Call(ctx, client.deadlines, "Close", &request, client.GrpcClient.Close, grpc.Peer(&callPeer))
+	err = client.getHandles().Release(g2handle.ConfigHandle, configHandle, "Close", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8002, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8002, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(6, configHandle, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 6, configHandle, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -155,20 +179,23 @@ Output
   - A Pointer to an in-memory Senzing configuration.
 */
 func (client *G2config) Create(ctx context.Context) (uintptr, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(7)
+		client.traceEntry(ctx, 7)
 	}
 	entryTime := time.Now()
 	request := g2pb.CreateRequest{}
-	response, err := client.GrpcClient.Create(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "Create", &request, client.GrpcClient.Create, grpc.Peer(&callPeer))
+	if err == nil {
+		client.getHandles().Open(g2handle.ConfigHandle, uintptr(response.GetResult()), "Create", callPeer.Addr, client, client.logHandleLeak)
+	}
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8003, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8003, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(8, (uintptr)(response.GetResult()), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 8, (uintptr)(response.GetResult()), err, time.Since(entryTime))
 	}
 	return uintptr(response.GetResult()), err
 }
@@ -183,25 +210,26 @@ Input
   - inputJson: A JSON document in the format `{"DSRC_CODE": "NAME_OF_DATASOURCE"}`.
 */
 func (client *G2config) DeleteDataSource(ctx context.Context, configHandle uintptr, inputJson string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(9, configHandle, inputJson)
+		client.traceEntry(ctx, 9, configHandle, inputJson)
 	}
 	entryTime := time.Now()
 	request := g2pb.DeleteDataSourceRequest{
 		ConfigHandle: int64(configHandle),
 		InputJson:    inputJson,
 	}
-	_, err := client.GrpcClient.DeleteDataSource(ctx, &request)
+	callPeer := peer.Peer{}
+	_, err := g2deadline.Call(ctx, client.deadlines, "DeleteDataSource", &request, client.GrpcClient.DeleteDataSource, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ConfigHandle, configHandle, "DeleteDataSource", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"inputJson": inputJson,
-			}
-			client.notify(ctx, 8004, err, details)
-		}()
+		details := map[string]string{
+			"inputJson": inputJson,
+		}
+		client.notify(ctx, 8004, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(10, configHandle, inputJson, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 10, configHandle, inputJson, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -209,29 +237,51 @@ func (client *G2config) DeleteDataSource(ctx context.Context, configHandle uintp
 /*
 The Destroy method will destroy and perform cleanup for the Senzing G2Config object.
 It should be called after all other calls are complete.
+The configuration handles the client created that are still open are closed first; see OutstandingHandles().
+Handles created by other clients sharing its Tracker are left open.
 
 Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2config) Destroy(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(11)
+		client.traceEntry(ctx, 11)
 	}
 	entryTime := time.Now()
+	for _, handle := range client.getHandles().Outstanding(client, g2handle.ConfigHandle) {
+		_ = client.Close(ctx, handle.Value)
+	}
+	client.getHandles().Forget(client, g2handle.ConfigHandle)
 	request := g2pb.DestroyRequest{}
-	_, err := client.GrpcClient.Destroy(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Destroy", &request, client.GrpcClient.Destroy)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8005, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8005, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(12, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 12, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The FlushObservers method waits until the messages to observers queued before the call are delivered,
+e.g. before the process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (client *G2config) FlushObservers(ctx context.Context) error {
+	if client.observers == nil {
+		return nil
+	}
+	return client.observers.Flush(ctx)
+}
+
 /*
 The GetSdkId method returns the identifier of this particular Software Development Kit (SDK).
 It is handy when working with multiple implementations of the same G2configInterface.
@@ -241,19 +291,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2config) GetSdkId(ctx context.Context) string {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(31)
+		client.traceEntry(ctx, 31)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8010, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8010, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(32, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 32, err, time.Since(entryTime))
 	}
 	return "grpc"
 }
@@ -269,8 +318,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2config) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(17, moduleName, iniParams, verboseLogging)
+		client.traceEntry(ctx, 17, moduleName, iniParams, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitRequest{
@@ -278,19 +328,17 @@ func (client *G2config) Init(ctx context.Context, moduleName string, iniParams s
 		IniParams:      iniParams,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.Init(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Init", &request, client.GrpcClient.Init)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8006, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8006, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -308,22 +356,23 @@ Output
     See the example output.
 */
 func (client *G2config) ListDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(19, configHandle)
+		client.traceEntry(ctx, 19, configHandle)
 	}
 	entryTime := time.Now()
 	request := g2pb.ListDataSourcesRequest{
 		ConfigHandle: int64(configHandle),
 	}
-	response, err := client.GrpcClient.ListDataSources(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "ListDataSources", &request, client.GrpcClient.ListDataSources, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ConfigHandle, configHandle, "ListDataSources", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8007, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8007, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(20, configHandle, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 20, configHandle, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -338,27 +387,35 @@ Input
   - jsonConfig: A JSON document containing the Senzing configuration.
 */
 func (client *G2config) Load(ctx context.Context, configHandle uintptr, jsonConfig string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(21, configHandle, jsonConfig)
+		client.traceEntry(ctx, 21, configHandle, jsonConfig)
 	}
 	entryTime := time.Now()
 	request := g2pb.LoadRequest{
 		ConfigHandle: int64(configHandle),
 		JsonConfig:   jsonConfig,
 	}
-	_, err := client.GrpcClient.Load(ctx, &request)
+	callPeer := peer.Peer{}
+	_, err := g2deadline.Call(ctx, client.deadlines, "Load", &request, client.GrpcClient.Load, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ConfigHandle, configHandle, "Load", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8008, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8008, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(22, configHandle, jsonConfig, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 22, configHandle, jsonConfig, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The OutstandingHandles method returns the configuration handles the client created with Create() and not yet closed, oldest first.
+*/
+func (client *G2config) OutstandingHandles() []g2handle.Handle {
+	return client.getHandles().Outstanding(client, g2handle.ConfigHandle)
+}
+
 /*
 The RegisterObserver method adds the observer to the list of observers notified.
 
@@ -367,24 +424,23 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2config) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(27, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 27, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
+		client.observers = g2observer.NewQueue()
 	}
 	err := client.observers.RegisterObserver(ctx, observer)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverId(ctx),
-			}
-			client.notify(ctx, 8011, err, details)
-		}()
+		details := map[string]string{
+			"observerID": observer.GetObserverId(ctx),
+		}
+		client.notify(ctx, 8011, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(28, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 28, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -402,26 +458,52 @@ Output
     See the example output.
 */
 func (client *G2config) Save(ctx context.Context, configHandle uintptr) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(23, configHandle)
+		client.traceEntry(ctx, 23, configHandle)
 	}
 	entryTime := time.Now()
 	request := g2pb.SaveRequest{
 		ConfigHandle: int64(configHandle),
 	}
-	response, err := client.GrpcClient.Save(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "Save", &request, client.GrpcClient.Save, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ConfigHandle, configHandle, "Save", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8009, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8009, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(24, configHandle, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 24, configHandle, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
 
+/*
+The SetDeadlines method sets the default deadlines of calls whose context has none.
+Without it, the client uses g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts;
+use g2deadline.NoTimeout in Deadlines to opt a method out.
+
+Input
+  - deadlines: The default timeout of each method.
+*/
+func (client *G2config) SetDeadlines(deadlines *g2deadline.Deadlines) {
+	client.deadlines = deadlines
+}
+
+/*
+The SetHandleTracker method sets the Tracker recording the handles the client creates.
+Without it, the client uses a Tracker of its own, created by g2handle.NewTracker().
+Call it before the client creates a handle.
+
+Input
+  - tracker: The Tracker, which may be shared with other clients.
+*/
+func (client *G2config) SetHandleTracker(tracker *g2handle.Tracker) {
+	client.handlesOnce.Do(func() {})
+	client.handles = tracker
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -430,27 +512,44 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2config) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(25, logLevel)
+		client.traceEntry(ctx, 25, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
 	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevel": logger.LevelToTextMap[logLevel],
-			}
-			client.notify(ctx, 8012, err, details)
-		}()
+		details := map[string]string{
+			"logLevel": logger.LevelToTextMap[logLevel],
+		}
+		client.notify(ctx, 8012, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(26, logLevel, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 26, logLevel, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetObserverQueue method sets the Queue delivering the messages of the client to its observers.
+The observers already registered are registered on it.
+Without it, the client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - queue: The Queue, usually created by g2observer.NewQueue().
+*/
+func (client *G2config) SetObserverQueue(queue *g2observer.Queue) {
+	if client.observers != nil {
+		ctx := context.Background()
+		for _, observer := range client.observers.GetObservers(ctx) {
+			_ = queue.RegisterObserver(ctx, observer)
+		}
+	}
+	client.observers = queue
+}
+
 /*
 The UnregisterObserver method removes the observer to the list of observers notified.
 
@@ -459,27 +558,22 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2config) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(29, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 29, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// The message is queued before the observer is removed, so the observer gets it.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8013, err, details)
-	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+		err = client.observers.UnregisterObserver(ctx, observer)
 	}
 	if client.isTrace {
-		defer client.traceExit(30, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 30, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
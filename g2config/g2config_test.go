@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 	"github.com/senzing/g2-sdk-go/g2api"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2config"
 	"github.com/senzing/go-logging/logger"
@@ -28,6 +32,22 @@ var (
 	grpcConnection    *grpc.ClientConn
 )
 
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// A log output sending each line written to the channel.
+type logLines chan string
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (lines logLines) Write(line []byte) (int, error) {
+	lines <- string(line)
+	return len(line), nil
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -35,7 +55,11 @@ var (
 func getGrpcConnection() *grpc.ClientConn {
 	var err error
 	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if grpctest.Enabled() {
+			grpcConnection, err = grpctest.Dial(context.TODO())
+		} else {
+			grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
 		if err != nil {
 			fmt.Printf("Did not connect: %v\n", err)
 		}
@@ -217,6 +241,59 @@ func TestG2config_Save(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2config_OutstandingHandles(test *testing.T) {
+	ctx := context.TODO()
+	g2config := getTestObject(ctx, test).(*G2config)
+	outstanding := len(g2config.OutstandingHandles())
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	handles := g2config.OutstandingHandles()
+	assert.Len(test, handles, outstanding+1)
+	handle := handles[len(handles)-1]
+	assert.Equal(test, configHandle, handle.Value)
+	assert.Equal(test, "Create", handle.Method)
+	assert.Contains(test, handle.Caller, "g2config_test.go")
+	err = g2config.Close(ctx, configHandle)
+	testError(test, ctx, g2config, err)
+	assert.Len(test, g2config.OutstandingHandles(), outstanding)
+}
+
+func TestG2config_HandleLeak(test *testing.T) {
+	ctx := context.TODO()
+	logged := make(logLines, 10)
+	log.SetOutput(logged)
+	defer log.SetOutput(os.Stderr)
+	tracker := g2handle.NewTracker()
+	tracker.LeakThreshold = 10 * time.Millisecond
+	g2config := &G2config{GrpcClient: g2pb.NewG2ConfigClient(getGrpcConnection())}
+	g2config.SetHandleTracker(tracker)
+	configHandle, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	defer g2config.Close(ctx, configHandle)
+
+	// A handle left open is logged as a warning of its own.
+	select {
+	case line := <-logged:
+		assert.Contains(test, line, `"level":"WARN"`)
+		assert.Contains(test, line, `"id":"senzing-60213001"`)
+		assert.Contains(test, line, "has been open for more than 10ms")
+	case <-time.After(time.Second):
+		assert.Fail(test, "the handle left open was not logged")
+	}
+
+	// SetLogLevel() filters it out.
+	err = g2config.SetLogLevel(ctx, logger.LevelError)
+	testError(test, ctx, g2config, err)
+	configHandle, err = g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	defer g2config.Close(ctx, configHandle)
+	select {
+	case line := <-logged:
+		assert.Fail(test, "the warning was logged at the ERROR level: "+line)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 func TestG2config_Init(test *testing.T) {
 	ctx := context.TODO()
 	g2config := getTestObject(ctx, test)
@@ -230,8 +307,33 @@ func TestG2config_Init(test *testing.T) {
 func TestG2config_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2config := getTestObject(ctx, test)
-	err := g2config.Destroy(ctx)
+	_, err := g2config.Create(ctx)
+	testError(test, ctx, g2config, err)
+	err = g2config.Destroy(ctx)
 	expectError(test, ctx, g2config, err, "senzing-60114001")
+	assert.Empty(test, g2config.(*G2config).OutstandingHandles())
+}
+
+func TestG2config_Destroy_SharedTracker(test *testing.T) {
+	ctx := context.TODO()
+	tracker := g2handle.NewTracker()
+	first := &G2config{GrpcClient: g2pb.NewG2ConfigClient(getGrpcConnection())}
+	first.SetHandleTracker(tracker)
+	second := &G2config{GrpcClient: g2pb.NewG2ConfigClient(getGrpcConnection())}
+	second.SetHandleTracker(tracker)
+	_, err := first.Create(ctx)
+	testError(test, ctx, first, err)
+	configHandle, err := second.Create(ctx)
+	testError(test, ctx, second, err)
+	defer second.Close(ctx, configHandle)
+
+	// Destroy() closes the handles of its own client only.
+	_ = first.Destroy(ctx)
+	assert.Empty(test, first.OutstandingHandles())
+	handles := second.OutstandingHandles()
+	assert.Len(test, handles, 1)
+	assert.Equal(test, configHandle, handles[0].Value)
+	assert.Len(test, tracker.Outstanding(nil), 1)
 }
 
 // ----------------------------------------------------------------------------
@@ -1,8 +1,19 @@
 package g2config
 
+import (
+	"errors"
+)
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2config package found messages having the format "senzing-6021xxxx".
 const ProductId = 6021
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrSessionClosed is returned by the methods of a ConfigSession that has been closed.
+var ErrSessionClosed = errors.New("g2config: config session is closed")
@@ -0,0 +1,248 @@
+/*
+ *
+ */
+
+package g2config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+ConfigSession edits one in-memory configuration on the server.
+It owns the configuration handle, so callers never pass one around, and it must be closed:
+
+	session, err := g2config.NewConfigSession(ctx, client, jsonConfig)
+	if err != nil {
+		return err
+	}
+	defer session.Close(ctx)
+
+A ConfigSession that is garbage collected without being closed is logged as a warning
+by its G2config client, like the handles the client's Tracker finds left open.
+It is safe for concurrent use.
+*/
+type ConfigSession struct {
+	G2config     g2api.G2config
+	closed       bool
+	configHandle uintptr
+	createdAt    string
+	lock         sync.Mutex
+	originalJson string
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewConfigSession function creates an in-memory configuration on the server and loads a configuration into it.
+
+Input
+  - ctx: A context to control lifecycle.
+  - g2config: The client used for every call of the session.
+  - jsonConfig: A configuration document, e.g. from G2configmgr.GetConfig(), or "" for the server's template configuration.
+
+Output
+  - A ConfigSession, which the caller must close.
+*/
+func NewConfigSession(ctx context.Context, g2config g2api.G2config, jsonConfig string) (*ConfigSession, error) {
+	configHandle, err := g2config.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if jsonConfig == "" {
+		jsonConfig, err = g2config.Save(ctx, configHandle)
+	} else {
+		err = g2config.Load(ctx, configHandle, jsonConfig)
+	}
+	if err != nil {
+		_ = g2config.Close(ctx, configHandle)
+		return nil, err
+	}
+	session := &ConfigSession{
+		G2config:     g2config,
+		configHandle: configHandle,
+		createdAt:    "unknown location",
+		originalJson: jsonConfig,
+	}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		session.createdAt = fmt.Sprintf("%s:%d", file, line)
+	}
+	runtime.SetFinalizer(session, finalizeConfigSession)
+	return session, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func finalizeConfigSession(session *ConfigSession) {
+	if !session.closed {
+		session.logLeak(fmt.Sprintf("g2config: ConfigSession created at %s was not closed; configuration handle %d is leaked on the server", session.createdAt, session.configHandle))
+	}
+}
+
+func dataSourceJson(dataSourceCode string) string {
+	inputJson, _ := json.Marshal(DataSource{DsrcCode: dataSourceCode})
+	return string(inputJson)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The configuration handle; the caller holds the lock.
+func (session *ConfigSession) handle() (uintptr, error) {
+	if session.closed {
+		return 0, ErrSessionClosed
+	}
+	return session.configHandle, nil
+}
+
+// Log a leaked session through its client's logger; other g2api.G2config implementations get a default one.
+func (session *ConfigSession) logLeak(message string) {
+	client, ok := session.G2config.(*G2config)
+	if !ok {
+		client = &G2config{}
+	}
+	client.logHandleLeak(message)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddDataSource method adds a data source to the configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: The code of the new data source, e.g. "CUSTOMERS".
+
+Output
+  - The data source added, with the DSRC_ID the server assigned.
+*/
+func (session *ConfigSession) AddDataSource(ctx context.Context, dataSourceCode string) (DataSource, error) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	result := DataSource{DsrcCode: dataSourceCode}
+	configHandle, err := session.handle()
+	if err != nil {
+		return result, err
+	}
+	response, err := session.G2config.AddDataSource(ctx, configHandle, dataSourceJson(dataSourceCode))
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal([]byte(response), &result)
+	return result, err
+}
+
+/*
+The Close method closes the in-memory configuration on the server.
+Closing a closed ConfigSession does nothing.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (session *ConfigSession) Close(ctx context.Context) error {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	if session.closed {
+		return nil
+	}
+	session.closed = true
+	return session.G2config.Close(ctx, session.configHandle)
+}
+
+/*
+The DeleteDataSource method removes a data source from the configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: The code of the data source, e.g. "CUSTOMERS".
+*/
+func (session *ConfigSession) DeleteDataSource(ctx context.Context, dataSourceCode string) error {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	configHandle, err := session.handle()
+	if err != nil {
+		return err
+	}
+	return session.G2config.DeleteDataSource(ctx, configHandle, dataSourceJson(dataSourceCode))
+}
+
+/*
+The Diff method compares the configuration with the one the session was created with.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - What was added to and removed from the original configuration.
+*/
+func (session *ConfigSession) Diff(ctx context.Context) (*ConfigDiff, error) {
+	jsonConfig, err := session.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return DiffConfigs(session.originalJson, jsonConfig)
+}
+
+/*
+The ListDataSources method returns the data sources of the configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (session *ConfigSession) ListDataSources(ctx context.Context) ([]DataSource, error) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	configHandle, err := session.handle()
+	if err != nil {
+		return nil, err
+	}
+	response, err := session.G2config.ListDataSources(ctx, configHandle)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		DataSources []DataSource `json:"DATA_SOURCES"`
+	}{}
+	err = json.Unmarshal([]byte(response), &result)
+	return result.DataSources, err
+}
+
+/*
+The OriginalJson method returns the configuration document the session was created with.
+*/
+func (session *ConfigSession) OriginalJson() string {
+	return session.originalJson
+}
+
+/*
+The Save method returns the configuration document as it is now, e.g. for G2configmgr.AddConfig().
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (session *ConfigSession) Save(ctx context.Context) (string, error) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	configHandle, err := session.handle()
+	if err != nil {
+		return "", err
+	}
+	return session.G2config.Save(ctx, configHandle)
+}
@@ -0,0 +1,180 @@
+package g2config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2config"
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A G2config connected to its own fake server, so sessions can be tested without a Senzing server.
+func getSessionG2config(ctx context.Context, test *testing.T) g2api.G2config {
+	server := grpctest.NewServer()
+	test.Cleanup(server.Close)
+	connection, err := server.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { connection.Close() })
+	return &G2config{
+		GrpcClient: g2pb.NewG2ConfigClient(connection),
+	}
+}
+
+func getSession(ctx context.Context, test *testing.T, jsonConfig string) *ConfigSession {
+	session, err := NewConfigSession(ctx, getSessionG2config(ctx, test), jsonConfig)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { session.Close(ctx) })
+	return session
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestConfigSession_DataSources(test *testing.T) {
+	ctx := context.TODO()
+	session := getSession(ctx, test, "")
+	added, err := session.AddDataSource(ctx, "CUSTOMERS")
+	testError(test, ctx, nil, err)
+	assert.Equal(test, DataSource{DsrcCode: "CUSTOMERS", DsrcID: 1001}, added)
+	_, err = session.AddDataSource(ctx, "WATCHLIST")
+	testError(test, ctx, nil, err)
+	testError(test, ctx, nil, session.DeleteDataSource(ctx, "TEST"))
+	dataSources, err := session.ListDataSources(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, []DataSource{{DsrcCode: "SEARCH", DsrcID: 2}, {DsrcCode: "CUSTOMERS", DsrcID: 1001}, {DsrcCode: "WATCHLIST", DsrcID: 1002}}, dataSources)
+	_, err = session.AddDataSource(ctx, "CUSTOMERS")
+	assert.Error(test, err)
+}
+
+func TestConfigSession_Diff(test *testing.T) {
+	ctx := context.TODO()
+	session := getSession(ctx, test, "")
+	diff, err := session.Diff(ctx)
+	testError(test, ctx, nil, err)
+	assert.True(test, diff.IsEmpty())
+	_, err = session.AddDataSource(ctx, "WATCHLIST")
+	testError(test, ctx, nil, err)
+	_, err = session.AddDataSource(ctx, "CUSTOMERS")
+	testError(test, ctx, nil, err)
+	testError(test, ctx, nil, session.DeleteDataSource(ctx, "TEST"))
+	diff, err = session.Diff(ctx)
+	testError(test, ctx, nil, err)
+	assert.False(test, diff.IsEmpty())
+	assert.Equal(test, []DataSource{{DsrcCode: "CUSTOMERS", DsrcID: 1002}, {DsrcCode: "WATCHLIST", DsrcID: 1001}}, diff.AddedDataSources)
+	assert.Equal(test, []DataSource{{DsrcCode: "TEST", DsrcID: 1}}, diff.RemovedDataSources)
+	assert.Equal(test, "+ data source CUSTOMERS (DSRC_ID 1002)\n+ data source WATCHLIST (DSRC_ID 1001)\n- data source TEST (DSRC_ID 1)\n", diff.String())
+	diffJson, err := json.Marshal(diff)
+	testError(test, ctx, nil, err)
+	assert.JSONEq(test, `{"ADDED_DATA_SOURCES":[{"DSRC_CODE":"CUSTOMERS","DSRC_ID":1002},{"DSRC_CODE":"WATCHLIST","DSRC_ID":1001}],"REMOVED_DATA_SOURCES":[{"DSRC_CODE":"TEST","DSRC_ID":1}]}`, string(diffJson))
+}
+
+func TestConfigSession_Load(test *testing.T) {
+	ctx := context.TODO()
+	session := getSession(ctx, test, "")
+	_, err := session.AddDataSource(ctx, "CUSTOMERS")
+	testError(test, ctx, nil, err)
+	jsonConfig, err := session.Save(ctx)
+	testError(test, ctx, nil, err)
+
+	loaded := getSession(ctx, test, jsonConfig)
+	assert.Equal(test, jsonConfig, loaded.OriginalJson())
+	dataSources, err := loaded.ListDataSources(ctx)
+	testError(test, ctx, nil, err)
+	assert.Len(test, dataSources, 3)
+	diff, err := loaded.Diff(ctx)
+	testError(test, ctx, nil, err)
+	assert.True(test, diff.IsEmpty())
+
+	_, err = NewConfigSession(ctx, getSessionG2config(ctx, test), `{"G2_CONFIG":`)
+	assert.Error(test, err)
+}
+
+func TestConfigSession_Close(test *testing.T) {
+	ctx := context.TODO()
+	session := getSession(ctx, test, "")
+	testError(test, ctx, nil, session.Close(ctx))
+	testError(test, ctx, nil, session.Close(ctx))
+	_, err := session.ListDataSources(ctx)
+	assert.ErrorIs(test, err, ErrSessionClosed)
+	_, err = session.AddDataSource(ctx, "CUSTOMERS")
+	assert.ErrorIs(test, err, ErrSessionClosed)
+	assert.ErrorIs(test, session.DeleteDataSource(ctx, "TEST"), ErrSessionClosed)
+	_, err = session.Diff(ctx)
+	assert.ErrorIs(test, err, ErrSessionClosed)
+}
+
+func TestConfigSession_Leak(test *testing.T) {
+	ctx := context.TODO()
+	g2config := getSessionG2config(ctx, test)
+	logged := make(logLines, 10)
+	log.SetOutput(logged)
+	defer log.SetOutput(os.Stderr)
+	func() {
+		session, err := NewConfigSession(ctx, g2config, "")
+		testError(test, ctx, nil, err)
+		testError(test, ctx, nil, session.Close(ctx))
+		_, err = NewConfigSession(ctx, g2config, "")
+		testError(test, ctx, nil, err)
+	}()
+	deadline := time.After(5 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case line := <-logged:
+			assert.Contains(test, line, `"level":"WARN"`)
+			assert.Contains(test, line, `"id":"senzing-60213001"`)
+			assert.Contains(test, line, "session_test.go")
+			assert.Contains(test, line, "was not closed")
+			runtime.GC()
+			time.Sleep(10 * time.Millisecond)
+			assert.Empty(test, logged, "a closed session was reported")
+			return
+		case <-deadline:
+			assert.FailNow(test, "the leaked session was not reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleConfigSession_Diff() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2config/session_test.go
+	ctx := context.TODO()
+	g2config := getG2Config(ctx)
+	session, err := NewConfigSession(ctx, g2config, "")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer session.Close(ctx)
+	_, err = session.AddDataSource(ctx, "GO_TEST")
+	if err != nil {
+		fmt.Println(err)
+	}
+	diff, err := session.Diff(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(len(diff.AddedDataSources), diff.AddedDataSources[0].DsrcCode)
+	// Output: 1 GO_TEST
+}
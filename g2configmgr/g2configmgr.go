@@ -7,17 +7,18 @@ package g2configmgr
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
 	g2configmgrapi "github.com/senzing/g2-sdk-go/g2configmgr"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
 	"github.com/senzing/go-observing/observer"
-	"github.com/senzing/go-observing/subject"
 )
 
 // ----------------------------------------------------------------------------
@@ -26,9 +27,10 @@ import (
 
 type G2configmgr struct {
 	GrpcClient g2pb.G2ConfigMgrClient
+	deadlines  *g2deadline.Deadlines
 	isTrace    bool
 	logger     messagelogger.MessageLoggerInterface
-	observers  subject.Subject
+	observers  *g2observer.Queue
 }
 
 // ----------------------------------------------------------------------------
@@ -49,25 +51,24 @@ func (client *G2configmgr) notify(ctx context.Context, messageId int, err error,
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
 	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	details["correlationID"] = g2metadata.CorrelationID(ctx)
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	err = client.observers.Notify(ctx, details)
 	if err != nil {
 		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
 	}
 }
 
 // Trace method entry.
-func (client *G2configmgr) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2configmgr) traceEntry(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // Trace method exit.
-func (client *G2configmgr) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2configmgr) traceExit(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // ----------------------------------------------------------------------------
@@ -86,25 +87,24 @@ Output
   - A configuration identifier.
 */
 func (client *G2configmgr) AddConfig(ctx context.Context, configStr string, configComments string) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(1, configStr, configComments)
+		client.traceEntry(ctx, 1, configStr, configComments)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddConfigRequest{
 		ConfigStr:      configStr,
 		ConfigComments: configComments,
 	}
-	response, err := client.GrpcClient.AddConfig(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "AddConfig", &request, client.GrpcClient.AddConfig)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configComments": configComments,
-			}
-			client.notify(ctx, 8001, err, details)
-		}()
+		details := map[string]string{
+			"configComments": configComments,
+		}
+		client.notify(ctx, 8001, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(2, configStr, configComments, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 2, configStr, configComments, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -117,24 +117,40 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2configmgr) Destroy(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(5)
+		client.traceEntry(ctx, 5)
 	}
 	entryTime := time.Now()
 	request := g2pb.DestroyRequest{}
-	_, err := client.GrpcClient.Destroy(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Destroy", &request, client.GrpcClient.Destroy)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8002, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8002, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(6, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 6, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The FlushObservers method waits until the messages to observers queued before the call are delivered,
+e.g. before the process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (client *G2configmgr) FlushObservers(ctx context.Context) error {
+	if client.observers == nil {
+		return nil
+	}
+	return client.observers.Flush(ctx)
+}
+
 /*
 The GetConfig method retrieves a specific Senzing configuration JSON document from the Senzing database.
 
@@ -147,22 +163,21 @@ Output
     See the example output.
 */
 func (client *G2configmgr) GetConfig(ctx context.Context, configID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(7, configID)
+		client.traceEntry(ctx, 7, configID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetConfigRequest{
 		ConfigID: configID,
 	}
-	response, err := client.GrpcClient.GetConfig(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetConfig", &request, client.GrpcClient.GetConfig)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8003, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8003, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(8, configID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 8, configID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -178,20 +193,19 @@ Output
     See the example output.
 */
 func (client *G2configmgr) GetConfigList(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(9)
+		client.traceEntry(ctx, 9)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetConfigListRequest{}
-	response, err := client.GrpcClient.GetConfigList(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetConfigList", &request, client.GrpcClient.GetConfigList)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8004, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8004, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(10, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 10, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -206,20 +220,19 @@ Output
   - A configuration identifier which identifies the current configuration in use.
 */
 func (client *G2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(11)
+		client.traceEntry(ctx, 11)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetDefaultConfigIDRequest{}
-	response, err := client.GrpcClient.GetDefaultConfigID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetDefaultConfigID", &request, client.GrpcClient.GetDefaultConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8005, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8005, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(12, response.GetConfigID(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 12, response.GetConfigID(), err, time.Since(entryTime))
 	}
 	return response.GetConfigID(), err
 }
@@ -233,19 +246,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2configmgr) GetSdkId(ctx context.Context) string {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(29)
+		client.traceEntry(ctx, 29)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8010, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8010, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(30, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 30, err, time.Since(entryTime))
 	}
 	return "grpc"
 }
@@ -261,8 +273,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2configmgr) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(17, moduleName, iniParams, verboseLogging)
+		client.traceEntry(ctx, 17, moduleName, iniParams, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitRequest{
@@ -270,19 +283,17 @@ func (client *G2configmgr) Init(ctx context.Context, moduleName string, iniParam
 		IniParams:      iniParams,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.Init(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Init", &request, client.GrpcClient.Init)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8006, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8006, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 18, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -295,24 +306,23 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2configmgr) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(25, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 25, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
+		client.observers = g2observer.NewQueue()
 	}
 	err := client.observers.RegisterObserver(ctx, observer)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverId(ctx),
-			}
-			client.notify(ctx, 8010, err, details)
-		}()
+		details := map[string]string{
+			"observerID": observer.GetObserverId(ctx),
+		}
+		client.notify(ctx, 8010, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(26, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 26, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -329,25 +339,24 @@ Input
   - newConfigID: The configuration identifier to use as the default.
 */
 func (client *G2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfigID int64, newConfigID int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(19, oldConfigID, newConfigID)
+		client.traceEntry(ctx, 19, oldConfigID, newConfigID)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReplaceDefaultConfigIDRequest{
 		OldConfigID: oldConfigID,
 		NewConfigID: newConfigID,
 	}
-	_, err := client.GrpcClient.ReplaceDefaultConfigID(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "ReplaceDefaultConfigID", &request, client.GrpcClient.ReplaceDefaultConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"newConfigID": strconv.FormatInt(newConfigID, 10),
-			}
-			client.notify(ctx, 8007, err, details)
-		}()
+		details := map[string]string{
+			"newConfigID": strconv.FormatInt(newConfigID, 10),
+		}
+		client.notify(ctx, 8007, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(20, oldConfigID, newConfigID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 20, oldConfigID, newConfigID, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -361,28 +370,39 @@ Input
   - configID: The configuration identifier of the Senzing Engine configuration to use as the default.
 */
 func (client *G2configmgr) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(21, configID)
+		client.traceEntry(ctx, 21, configID)
 	}
 	entryTime := time.Now()
 	request := g2pb.SetDefaultConfigIDRequest{
 		ConfigID: configID,
 	}
-	_, err := client.GrpcClient.SetDefaultConfigID(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "SetDefaultConfigID", &request, client.GrpcClient.SetDefaultConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID": strconv.FormatInt(configID, 10),
-			}
-			client.notify(ctx, 8008, err, details)
-		}()
+		details := map[string]string{
+			"configID": strconv.FormatInt(configID, 10),
+		}
+		client.notify(ctx, 8008, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(22, configID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 22, configID, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetDeadlines method sets the default deadlines of calls whose context has none.
+Without it, the client uses g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts;
+use g2deadline.NoTimeout in Deadlines to opt a method out.
+
+Input
+  - deadlines: The default timeout of each method.
+*/
+func (client *G2configmgr) SetDeadlines(deadlines *g2deadline.Deadlines) {
+	client.deadlines = deadlines
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -391,27 +411,44 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2configmgr) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(23, logLevel)
+		client.traceEntry(ctx, 23, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
 	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevel": logger.LevelToTextMap[logLevel],
-			}
-			client.notify(ctx, 8011, err, details)
-		}()
+		details := map[string]string{
+			"logLevel": logger.LevelToTextMap[logLevel],
+		}
+		client.notify(ctx, 8011, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(24, logLevel, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 24, logLevel, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetObserverQueue method sets the Queue delivering the messages of the client to its observers.
+The observers already registered are registered on it.
+Without it, the client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - queue: The Queue, usually created by g2observer.NewQueue().
+*/
+func (client *G2configmgr) SetObserverQueue(queue *g2observer.Queue) {
+	if client.observers != nil {
+		ctx := context.Background()
+		for _, observer := range client.observers.GetObservers(ctx) {
+			_ = queue.RegisterObserver(ctx, observer)
+		}
+	}
+	client.observers = queue
+}
+
 /*
 The UnregisterObserver method removes the observer to the list of observers notified.
 
@@ -420,27 +457,22 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2configmgr) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(27, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 27, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// The message is queued before the observer is removed, so the observer gets it.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8012, err, details)
-	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+		err = client.observers.UnregisterObserver(ctx, observer)
 	}
 	if client.isTrace {
-		defer client.traceExit(28, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 28, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -13,6 +13,7 @@ import (
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing/g2-sdk-go-grpc/g2config"
 	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 	"github.com/senzing/g2-sdk-go/g2api"
 	g2configmgrapi "github.com/senzing/g2-sdk-go/g2configmgr"
 	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
@@ -47,7 +48,11 @@ var (
 func getGrpcConnection() *grpc.ClientConn {
 	var err error
 	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if grpctest.Enabled() {
+			grpcConnection, err = grpctest.Dial(context.TODO())
+		} else {
+			grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
 		if err != nil {
 			fmt.Printf("Did not connect: %v\n", err)
 		}
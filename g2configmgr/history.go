@@ -0,0 +1,90 @@
+/*
+ *
+ */
+
+package g2configmgr
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ConfigListEntry is a configuration stored in the Senzing repository, as listed by GetConfigList().
+type ConfigListEntry struct {
+	ConfigComments string `json:"CONFIG_COMMENTS"`
+	ConfigID       int64  `json:"CONFIG_ID"`
+	SysCreateDt    string `json:"SYS_CREATE_DT"`
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The CreatedAt method returns SysCreateDt as a time, taking the repository's timestamps to be UTC.
+*/
+func (entry ConfigListEntry) CreatedAt() (time.Time, error) {
+	return time.Parse(sysCreateDtLayout, entry.SysCreateDt)
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The DiffConfigIDs function compares two configurations stored in the Senzing repository.
+
+Input
+  - ctx: A context to control lifecycle.
+  - g2configmgr: The client used to get the configurations.
+  - oldConfigID: The configuration before the change.
+  - newConfigID: The configuration after the change.
+
+Output
+  - The added, removed and changed data sources, feature types, attributes and rules.
+    String() formats it for people; it marshals to JSON for programs.
+*/
+func DiffConfigIDs(ctx context.Context, g2configmgr g2api.G2configmgr, oldConfigID int64, newConfigID int64) (*g2config.ConfigDiff, error) {
+	oldJsonConfig, err := g2configmgr.GetConfig(ctx, oldConfigID)
+	if err != nil {
+		return nil, err
+	}
+	newJsonConfig, err := g2configmgr.GetConfig(ctx, newConfigID)
+	if err != nil {
+		return nil, err
+	}
+	return g2config.DiffConfigs(oldJsonConfig, newJsonConfig)
+}
+
+/*
+The GetConfigHistory function returns the configurations stored in the Senzing repository, oldest first.
+
+Input
+  - ctx: A context to control lifecycle.
+  - g2configmgr: The client used to list the configurations.
+*/
+func GetConfigHistory(ctx context.Context, g2configmgr g2api.G2configmgr) ([]ConfigListEntry, error) {
+	response, err := g2configmgr.GetConfigList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configList := struct {
+		Configs []ConfigListEntry `json:"CONFIGS"`
+	}{}
+	if err := json.Unmarshal([]byte(response), &configList); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(configList.Configs, func(i, j int) bool {
+		return configList.Configs[i].SysCreateDt < configList.Configs[j].SysCreateDt
+	})
+	return configList.Configs, nil
+}
@@ -0,0 +1,87 @@
+package g2configmgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGetConfigHistory(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	publisher := NewPublisher(clients.g2config, clients.g2configmgr)
+	customers, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	watchlist, err := publisher.Publish(ctx, "Add WATCHLIST", addDataSources("WATCHLIST"))
+	testError(test, ctx, nil, err)
+	history, err := GetConfigHistory(ctx, clients.g2configmgr)
+	testError(test, ctx, nil, err)
+	assert.Len(test, history, 3)
+	assert.Equal(test, customers.PreviousConfigID, history[0].ConfigID)
+	assert.Equal(test, customers.ConfigID, history[1].ConfigID)
+	assert.Equal(test, "Add CUSTOMERS", history[1].ConfigComments)
+	assert.Equal(test, watchlist.ConfigID, history[2].ConfigID)
+	assert.Equal(test, "Add WATCHLIST", history[2].ConfigComments)
+	createdAt, err := history[2].CreatedAt()
+	testError(test, ctx, nil, err)
+	assert.WithinDuration(test, time.Now(), createdAt, 24*time.Hour)
+}
+
+func TestDiffConfigIDs(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	publisher := NewPublisher(clients.g2config, clients.g2configmgr)
+	customers, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	watchlist, err := publisher.Publish(ctx, "Add WATCHLIST", addDataSources("WATCHLIST"))
+	testError(test, ctx, nil, err)
+	diff, err := DiffConfigIDs(ctx, clients.g2configmgr, customers.PreviousConfigID, watchlist.ConfigID)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, "+ data source CUSTOMERS (DSRC_ID 1001)\n+ data source WATCHLIST (DSRC_ID 1002)\n", diff.String())
+	diffJson, err := json.Marshal(diff)
+	testError(test, ctx, nil, err)
+	assert.JSONEq(test, `{"ADDED_DATA_SOURCES":[{"DSRC_CODE":"CUSTOMERS","DSRC_ID":1001},{"DSRC_CODE":"WATCHLIST","DSRC_ID":1002}]}`, string(diffJson))
+	_, err = DiffConfigIDs(ctx, clients.g2configmgr, customers.ConfigID, 123)
+	assert.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleGetConfigHistory() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2configmgr/history_test.go
+	ctx := context.TODO()
+	g2configmgr := getG2Configmgr(ctx)
+	history, err := GetConfigHistory(ctx, g2configmgr)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(len(history) > 0)
+	// Output: true
+}
+
+func ExampleDiffConfigIDs() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2configmgr/history_test.go
+	ctx := context.TODO()
+	g2configmgr := getG2Configmgr(ctx)
+	configID, err := g2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+	diff, err := DiffConfigIDs(ctx, g2configmgr, configID, configID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(diff.IsEmpty())
+	// Output: true
+}
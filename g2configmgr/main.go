@@ -1,8 +1,26 @@
 package g2configmgr
 
+import "errors"
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2configmgr package found messages having the format "senzing-6022xxxx".
 const ProductId = 6022
+
+// The number of times Publisher.Publish() applies its mutation before giving up, unless changed by SetMaxAttempts().
+const DefaultPublishAttempts = 5
+
+// The layout of SYS_CREATE_DT in GetConfigList().
+const sysCreateDtLayout = "2006-01-02 15:04:05.000"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrPublishConflict is wrapped by the error of Publisher.Publish() when other writers changed the default configuration on every attempt.
+var ErrPublishConflict = errors.New("g2configmgr: default configuration changed by another writer")
+
+// Returned by an attempt of Publisher.Publish() that set the first default configuration, but found another one after.
+var errFirstDefaultConfigReplaced = errors.New("g2configmgr: first default configuration replaced by another writer")
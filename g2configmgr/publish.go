@@ -0,0 +1,251 @@
+/*
+ *
+ */
+
+package g2configmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Publisher publishes new default configurations without losing concurrent changes.
+Each Publish() starts from the current default configuration and switches the default with
+ReplaceDefaultConfigID(), a compare-and-swap; if another writer switched it first, Publish() starts again.
+It is safe for concurrent use.
+
+The first publish, when there is no default configuration yet, cannot compare-and-swap: it sets the default
+and reads it back, starting again if another writer's configuration is the default by then.
+A writer setting the first default after that read still replaces it, so the first configuration
+should be published by one process, e.g. at installation.
+*/
+type Publisher struct {
+	G2config    g2api.G2config
+	G2configmgr g2api.G2configmgr
+	engines     []g2api.G2engine
+	lock        sync.Mutex
+	maxAttempts int
+}
+
+// PublishResult describes a configuration published by Publish().
+type PublishResult struct {
+	Attempts         int                  // The number of times the mutation was applied.
+	ConfigID         int64                // The new default configuration, or PreviousConfigID if nothing changed.
+	Diff             *g2config.ConfigDiff // What the mutation changed.
+	PreviousConfigID int64                // The default configuration the mutation was applied to.
+}
+
+/*
+Mutation changes a configuration for Publish().
+Publish() calls it again, on the newer default configuration, when another writer publishes first,
+so it must not assume what the configuration holds; e.g. add a data source only if it is missing.
+*/
+type Mutation func(ctx context.Context, session *g2config.ConfigSession) error
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewPublisher function returns a Publisher that tries each Publish() up to DefaultPublishAttempts times.
+
+Input
+  - g2config: The client used to edit configurations.
+  - g2configmgr: The client used to store configurations and switch the default.
+*/
+func NewPublisher(g2config g2api.G2config, g2configmgr g2api.G2configmgr) *Publisher {
+	return &Publisher{
+		G2config:    g2config,
+		G2configmgr: g2configmgr,
+		maxAttempts: DefaultPublishAttempts,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Report whether an error means another writer changed the default configuration.
+func isDefaultConfigConflict(err error) bool {
+	if errors.Is(err, errFirstDefaultConfigReplaced) {
+		return true
+	}
+	senzingCode := g2error.G2ErrorCode(err.Error())
+	return senzingCode == 7245 || senzingCode == 7246
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Apply the mutation to the current default configuration and try once to make the result the default.
+func (publisher *Publisher) attempt(ctx context.Context, comments string, mutation Mutation, result *PublishResult) error {
+	previousConfigID, err := publisher.G2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		return err
+	}
+	jsonConfig := ""
+	if previousConfigID != 0 {
+		jsonConfig, err = publisher.G2configmgr.GetConfig(ctx, previousConfigID)
+		if err != nil {
+			return err
+		}
+	}
+	session, err := g2config.NewConfigSession(ctx, publisher.G2config, jsonConfig)
+	if err != nil {
+		return err
+	}
+	defer session.Close(ctx)
+	if err := mutation(ctx, session); err != nil {
+		return err
+	}
+	diff, err := session.Diff(ctx)
+	if err != nil {
+		return err
+	}
+	result.Diff = diff
+	result.PreviousConfigID = previousConfigID
+	result.ConfigID = previousConfigID
+	if diff.IsEmpty() && previousConfigID != 0 {
+		return nil
+	}
+	newJsonConfig, err := session.Save(ctx)
+	if err != nil {
+		return err
+	}
+	configID, err := publisher.G2configmgr.AddConfig(ctx, newJsonConfig, comments)
+	if err != nil {
+		return err
+	}
+	if previousConfigID == 0 {
+		err = publisher.setFirstDefaultConfigID(ctx, configID)
+	} else {
+		err = publisher.G2configmgr.ReplaceDefaultConfigID(ctx, previousConfigID, configID)
+	}
+	if err != nil {
+		return err
+	}
+	result.ConfigID = configID
+	return nil
+}
+
+// Set the first default configuration, with no compare-and-swap, and check that no other writer replaced it.
+func (publisher *Publisher) setFirstDefaultConfigID(ctx context.Context, configID int64) error {
+	err := publisher.G2configmgr.SetDefaultConfigID(ctx, configID)
+	if err != nil {
+		return err
+	}
+	defaultConfigID, err := publisher.G2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		return err
+	}
+	if defaultConfigID != configID {
+		return fmt.Errorf("%w: configuration %d is the default, not %d", errFirstDefaultConfigReplaced, defaultConfigID, configID)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Publish method applies a mutation to the current default configuration and makes the result the default.
+If the mutation changes nothing, no configuration is added and the default is unchanged.
+Once the default has changed, registered engines are reinitialized with it.
+
+Input
+  - ctx: A context to control lifecycle.
+  - comments: The comments stored with the new configuration.
+  - mutation: The change to make.
+
+Output
+  - The published configuration, also returned with an error from reinitializing engines.
+  - An error wrapping ErrPublishConflict if other writers won every attempt.
+*/
+func (publisher *Publisher) Publish(ctx context.Context, comments string, mutation Mutation) (*PublishResult, error) {
+	publisher.lock.Lock()
+	maxAttempts := publisher.maxAttempts
+	engines := append([]g2api.G2engine{}, publisher.engines...)
+	publisher.lock.Unlock()
+	result := &PublishResult{}
+	var err error
+	for result.Attempts < maxAttempts {
+		result.Attempts++
+		err = publisher.attempt(ctx, comments, mutation, result)
+		if err == nil || !isDefaultConfigConflict(err) {
+			break
+		}
+	}
+	if err != nil {
+		if isDefaultConfigConflict(err) {
+			return nil, fmt.Errorf("%w after %d attempts: %w", ErrPublishConflict, result.Attempts, err)
+		}
+		return nil, err
+	}
+	if result.ConfigID == result.PreviousConfigID {
+		return result, nil
+	}
+	for _, engine := range engines {
+		if err := engine.Reinit(ctx, result.ConfigID); err != nil {
+			return result, fmt.Errorf("configuration %d is the default, but reinitializing an engine failed: %w", result.ConfigID, err)
+		}
+	}
+	return result, nil
+}
+
+/*
+The RegisterEngine method adds an engine to reinitialize with each configuration published.
+
+Input
+  - engine: A G2engine, usually the one the application loads records with.
+*/
+func (publisher *Publisher) RegisterEngine(engine g2api.G2engine) {
+	publisher.lock.Lock()
+	defer publisher.lock.Unlock()
+	publisher.engines = append(publisher.engines, engine)
+}
+
+/*
+The SetMaxAttempts method sets how many times Publish() applies the mutation before giving up.
+
+Input
+  - maxAttempts: The number of attempts; values below 1 mean 1.
+*/
+func (publisher *Publisher) SetMaxAttempts(maxAttempts int) {
+	publisher.lock.Lock()
+	defer publisher.lock.Unlock()
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	publisher.maxAttempts = maxAttempts
+}
+
+/*
+The UnregisterEngine method removes an engine added by RegisterEngine().
+
+Input
+  - engine: The G2engine to remove.
+*/
+func (publisher *Publisher) UnregisterEngine(engine g2api.G2engine) {
+	publisher.lock.Lock()
+	defer publisher.lock.Unlock()
+	engines := []g2api.G2engine{}
+	for _, registered := range publisher.engines {
+		if registered != engine {
+			engines = append(engines, registered)
+		}
+	}
+	publisher.engines = engines
+}
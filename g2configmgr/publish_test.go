@@ -0,0 +1,261 @@
+package g2configmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+)
+
+// The clients of a fake server, so publishing can be tested without a Senzing server.
+type publishClients struct {
+	g2config    g2api.G2config
+	g2configmgr g2api.G2configmgr
+	g2engine    g2api.G2engine
+}
+
+// A G2configmgr where another writer publishes a configuration, adding a data source,
+// just before each of the first races calls of ReplaceDefaultConfigID.
+type racingG2configmgr struct {
+	g2api.G2configmgr
+	g2config g2api.G2config
+	races    int
+}
+
+func (racing *racingG2configmgr) ReplaceDefaultConfigID(ctx context.Context, oldConfigID int64, newConfigID int64) error {
+	if racing.races > 0 {
+		racing.races--
+		publisher := NewPublisher(racing.g2config, racing.G2configmgr)
+		_, err := publisher.Publish(ctx, "Competing writer", addDataSources(fmt.Sprintf("RACE_%d", racing.races)))
+		if err != nil {
+			return err
+		}
+	}
+	return racing.G2configmgr.ReplaceDefaultConfigID(ctx, oldConfigID, newConfigID)
+}
+
+// A G2configmgr with no default configuration until the first SetDefaultConfigID,
+// after which another writer sets competingConfigID as the default, races times.
+type firstPublishG2configmgr struct {
+	g2api.G2configmgr
+	competingConfigID int64
+	races             int
+	set               bool
+}
+
+func (first *firstPublishG2configmgr) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	if !first.set {
+		return 0, nil
+	}
+	return first.G2configmgr.GetDefaultConfigID(ctx)
+}
+
+func (first *firstPublishG2configmgr) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	first.set = true
+	err := first.G2configmgr.SetDefaultConfigID(ctx, configID)
+	if err != nil {
+		return err
+	}
+	if first.races > 0 {
+		first.races--
+		return first.G2configmgr.SetDefaultConfigID(ctx, first.competingConfigID)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getPublishClients(ctx context.Context, test *testing.T) *publishClients {
+	server := grpctest.NewServer()
+	test.Cleanup(server.Close)
+	connection, err := server.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { connection.Close() })
+	return &publishClients{
+		g2config:    &g2config.G2config{GrpcClient: g2configpb.NewG2ConfigClient(connection)},
+		g2configmgr: &G2configmgr{GrpcClient: g2pb.NewG2ConfigMgrClient(connection)},
+		g2engine:    &g2engine.G2engine{GrpcClient: g2enginepb.NewG2EngineClient(connection)},
+	}
+}
+
+// A mutation adding the data sources that are missing.
+func addDataSources(dataSourceCodes ...string) Mutation {
+	return func(ctx context.Context, session *g2config.ConfigSession) error {
+		dataSources, err := session.ListDataSources(ctx)
+		if err != nil {
+			return err
+		}
+		existing := map[string]bool{}
+		for _, dataSource := range dataSources {
+			existing[dataSource.DsrcCode] = true
+		}
+		for _, dataSourceCode := range dataSourceCodes {
+			if existing[dataSourceCode] {
+				continue
+			}
+			if _, err := session.AddDataSource(ctx, dataSourceCode); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func getDataSourceCodes(ctx context.Context, test *testing.T, clients *publishClients, configID int64) []string {
+	jsonConfig, err := clients.g2configmgr.GetConfig(ctx, configID)
+	testError(test, ctx, nil, err)
+	session, err := g2config.NewConfigSession(ctx, clients.g2config, jsonConfig)
+	testError(test, ctx, nil, err)
+	defer session.Close(ctx)
+	dataSources, err := session.ListDataSources(ctx)
+	testError(test, ctx, nil, err)
+	result := []string{}
+	for _, dataSource := range dataSources {
+		result = append(result, dataSource.DsrcCode)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestPublisher_Publish(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	previousConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	publisher := NewPublisher(clients.g2config, clients.g2configmgr)
+	result, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	assert.Equal(test, 1, result.Attempts)
+	assert.Equal(test, previousConfigID, result.PreviousConfigID)
+	assert.NotEqual(test, previousConfigID, result.ConfigID)
+	assert.Equal(test, "+ data source CUSTOMERS (DSRC_ID 1001)\n", result.Diff.String())
+	defaultConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, result.ConfigID, defaultConfigID)
+	assert.Equal(test, []string{"TEST", "SEARCH", "CUSTOMERS"}, getDataSourceCodes(ctx, test, clients, defaultConfigID))
+	configList, err := clients.g2configmgr.GetConfigList(ctx)
+	testError(test, ctx, nil, err)
+	assert.Contains(test, configList, "Add CUSTOMERS")
+
+	unchanged, err := publisher.Publish(ctx, "Add CUSTOMERS again", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	assert.True(test, unchanged.Diff.IsEmpty())
+	assert.Equal(test, defaultConfigID, unchanged.ConfigID)
+	assert.Equal(test, defaultConfigID, unchanged.PreviousConfigID)
+}
+
+func TestPublisher_Publish_Conflict(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	racing := &racingG2configmgr{G2configmgr: clients.g2configmgr, g2config: clients.g2config, races: 2}
+	publisher := NewPublisher(clients.g2config, racing)
+	result, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	assert.Equal(test, 3, result.Attempts)
+	defaultConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, result.ConfigID, defaultConfigID)
+	assert.ElementsMatch(test, []string{"TEST", "SEARCH", "RACE_1", "RACE_0", "CUSTOMERS"}, getDataSourceCodes(ctx, test, clients, defaultConfigID))
+
+	racing.races = 10
+	publisher.SetMaxAttempts(2)
+	_, err = publisher.Publish(ctx, "Add WATCHLIST", addDataSources("WATCHLIST"))
+	assert.ErrorIs(test, err, ErrPublishConflict)
+	assert.Equal(test, 8, racing.races)
+}
+
+func TestPublisher_Publish_First(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	competingConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	first := &firstPublishG2configmgr{G2configmgr: clients.g2configmgr, competingConfigID: competingConfigID, races: 1}
+	publisher := NewPublisher(clients.g2config, first)
+	result, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+
+	// The first attempt set the default, found the competing one and started again from it.
+	assert.Equal(test, 2, result.Attempts)
+	assert.Equal(test, competingConfigID, result.PreviousConfigID)
+	defaultConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, result.ConfigID, defaultConfigID)
+	assert.ElementsMatch(test, []string{"TEST", "SEARCH", "CUSTOMERS"}, getDataSourceCodes(ctx, test, clients, defaultConfigID))
+}
+
+func TestPublisher_Publish_Error(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	previousConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	mutationErr := errors.New("mutation failed")
+	publisher := NewPublisher(clients.g2config, clients.g2configmgr)
+	_, err = publisher.Publish(ctx, "Fails", func(ctx context.Context, session *g2config.ConfigSession) error {
+		if _, err := session.AddDataSource(ctx, "CUSTOMERS"); err != nil {
+			return err
+		}
+		return mutationErr
+	})
+	assert.ErrorIs(test, err, mutationErr)
+	defaultConfigID, err := clients.g2configmgr.GetDefaultConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, previousConfigID, defaultConfigID)
+}
+
+func TestPublisher_Publish_Reinit(test *testing.T) {
+	ctx := context.TODO()
+	clients := getPublishClients(ctx, test)
+	publisher := NewPublisher(clients.g2config, clients.g2configmgr)
+	publisher.RegisterEngine(clients.g2engine)
+	result, err := publisher.Publish(ctx, "Add CUSTOMERS", addDataSources("CUSTOMERS"))
+	testError(test, ctx, nil, err)
+	activeConfigID, err := clients.g2engine.GetActiveConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, result.ConfigID, activeConfigID)
+
+	publisher.UnregisterEngine(clients.g2engine)
+	result, err = publisher.Publish(ctx, "Add WATCHLIST", addDataSources("WATCHLIST"))
+	testError(test, ctx, nil, err)
+	activeConfigID, err = clients.g2engine.GetActiveConfigID(ctx)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, result.PreviousConfigID, activeConfigID)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExamplePublisher_Publish() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2configmgr/publish_test.go
+	ctx := context.TODO()
+	publisher := NewPublisher(getG2Config(ctx), getG2Configmgr(ctx))
+	publisher.RegisterEngine(getG2Engine(ctx))
+	dataSourceCode := fmt.Sprintf("GO_TEST_%d", time.Now().Unix())
+	result, err := publisher.Publish(ctx, "Add "+dataSourceCode, func(ctx context.Context, session *g2config.ConfigSession) error {
+		_, err := session.AddDataSource(ctx, dataSourceCode)
+		return err
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(result.ConfigID != result.PreviousConfigID)
+	// Output: true
+}
@@ -0,0 +1,21 @@
+/*
+The g2deadline package applies default deadlines to Senzing SDK calls whose context has none,
+so a hung server cannot block a caller that forgot context.WithTimeout().
+
+Every method of the G2config, G2configmgr, G2diagnostic, G2engine and G2product clients makes its gRPC call
+through Call(), with the Deadlines set by the client's SetDeadlines() method.
+Clients without Deadlines use DefaultTimeout and DefaultTimeouts; NewDeadlines() starts from them too.
+NoTimeout opts a method, or with SetDefaultTimeout() every method without a timeout of its own, out.
+A deadline already on the caller's context always wins, whether it is shorter or longer than the default.
+
+	deadlines := g2deadline.NewDeadlines()
+	deadlines.SetTimeout("FindNetworkByEntityID_V2", 30*time.Second)
+	deadlines.SetTimeout("PurgeRepository", g2deadline.NoTimeout)
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithDeadlines(deadlines))
+
+When a default deadline expires, the call fails with a *g2error.G2DeadlineExceededError
+whose Method and Timeout fields tell which default expired.
+When the caller's own deadline expires, the error has the same type with a zero Timeout.
+Streaming calls, such as G2diagnostic.StreamEntityListBySize(), have no default deadline.
+*/
+package g2deadline
@@ -0,0 +1,155 @@
+/*
+ *
+ */
+
+// Package g2deadline applies default deadlines to Senzing SDK calls whose context has none.
+package g2deadline
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Deadlines holds the default timeout of each SDK method. It is safe for concurrent use.
+// A nil *Deadlines uses DefaultTimeout and DefaultTimeouts.
+type Deadlines struct {
+	defaultTimeout time.Duration
+	lock           sync.RWMutex
+	timeouts       map[string]time.Duration
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewDeadlines function returns Deadlines holding DefaultTimeout and a copy of DefaultTimeouts.
+*/
+func NewDeadlines() *Deadlines {
+	deadlines := &Deadlines{
+		defaultTimeout: DefaultTimeout,
+		timeouts:       map[string]time.Duration{},
+	}
+	for method, timeout := range DefaultTimeouts {
+		deadlines.timeouts[method] = timeout
+	}
+	return deadlines
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Context method returns the context a call should use.
+If ctx has no deadline and the method has a timeout, the returned context has a deadline that far in the future.
+
+Input
+  - ctx: The caller's context.
+  - method: The SDK method name, e.g. "PurgeRepository".
+
+Output
+  - The context for the call, and a function to call when the call returns.
+  - The timeout applied, or NoTimeout if the context was returned unchanged.
+*/
+func (deadlines *Deadlines) Context(ctx context.Context, method string) (context.Context, context.CancelFunc, time.Duration) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}, NoTimeout
+	}
+	timeout := deadlines.Timeout(method)
+	if timeout <= NoTimeout {
+		return ctx, func() {}, NoTimeout
+	}
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	return callCtx, cancel, timeout
+}
+
+/*
+The SetDefaultTimeout method sets the timeout of methods without a timeout of their own.
+
+Input
+  - timeout: The timeout, or NoTimeout for none.
+*/
+func (deadlines *Deadlines) SetDefaultTimeout(timeout time.Duration) {
+	deadlines.lock.Lock()
+	defer deadlines.lock.Unlock()
+	deadlines.defaultTimeout = timeout
+}
+
+/*
+The SetTimeout method sets the timeout of a method.
+
+Input
+  - method: The SDK method name, e.g. "FindNetworkByEntityID_V2".
+  - timeout: The timeout, or NoTimeout for none.
+*/
+func (deadlines *Deadlines) SetTimeout(method string, timeout time.Duration) {
+	deadlines.lock.Lock()
+	defer deadlines.lock.Unlock()
+	deadlines.timeouts[method] = timeout
+}
+
+/*
+The Timeout method returns the timeout of a method.
+
+Input
+  - method: The SDK method name, e.g. "PurgeRepository".
+*/
+func (deadlines *Deadlines) Timeout(method string) time.Duration {
+	if deadlines == nil {
+		if timeout, ok := DefaultTimeouts[method]; ok {
+			return timeout
+		}
+		return DefaultTimeout
+	}
+	deadlines.lock.RLock()
+	defer deadlines.lock.RUnlock()
+	if timeout, ok := deadlines.timeouts[method]; ok {
+		return timeout
+	}
+	return deadlines.defaultTimeout
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Call function makes a unary gRPC call with the default deadline of a method, and translates its error.
+
+Input
+  - ctx: The caller's context.
+  - deadlines: The client's Deadlines; nil for DefaultTimeout and DefaultTimeouts.
+  - method: The SDK method name, e.g. "PurgeRepository".
+  - request: The request message.
+  - call: The generated gRPC client method, e.g. client.GrpcClient.PurgeRepository.
+  - opts: Call options passed to call, e.g. grpc.Peer().
+
+Output
+  - The response message.
+  - An error translated by g2error.Convert(). If the default deadline expired,
+    it is a *g2error.G2DeadlineExceededError with Method and Timeout set.
+*/
+func Call[Request any, Response any](ctx context.Context, deadlines *Deadlines, method string, request Request, call func(context.Context, Request, ...grpc.CallOption) (Response, error), opts ...grpc.CallOption) (Response, error) {
+	callCtx, cancel, timeout := deadlines.Context(ctx, method)
+	defer cancel()
+	response, err := call(callCtx, request, opts...)
+	err = g2error.Convert(err)
+	if err != nil && timeout > NoTimeout && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		var deadlineError *g2error.G2DeadlineExceededError
+		if errors.As(err, &deadlineError) {
+			deadlineError.Method = method
+			deadlineError.Timeout = timeout
+		}
+	}
+	return response, err
+}
@@ -0,0 +1,207 @@
+package g2deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufferSize = 1024 * 1024
+)
+
+var (
+	connection *grpc.ClientConn
+	listener   *bufconn.Listener
+	server     *grpc.Server
+)
+
+// A G2product server where Version answers after its request's deadline, if any, has passed
+// and License reports whether its request had a deadline.
+type g2productServer struct {
+	g2pb.UnimplementedG2ProductServer
+}
+
+func (server *g2productServer) License(ctx context.Context, request *g2pb.LicenseRequest) (*g2pb.LicenseResponse, error) {
+	_, ok := ctx.Deadline()
+	return &g2pb.LicenseResponse{Result: fmt.Sprintf("%t", ok)}, nil
+}
+
+func (server *g2productServer) Version(ctx context.Context, request *g2pb.VersionRequest) (*g2pb.VersionResponse, error) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(200 * time.Millisecond):
+	}
+	return &g2pb.VersionResponse{Result: `{}`}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func bufDialer(ctx context.Context, address string) (net.Conn, error) {
+	return listener.DialContext(ctx)
+}
+
+func callLicense(ctx context.Context, deadlines *Deadlines) (string, error) {
+	response, err := Call(ctx, deadlines, "License", &g2pb.LicenseRequest{}, g2pb.NewG2ProductClient(connection).License)
+	return response.GetResult(), err
+}
+
+func callVersion(ctx context.Context, deadlines *Deadlines) (string, error) {
+	response, err := Call(ctx, deadlines, "Version", &g2pb.VersionRequest{}, g2pb.NewG2ProductClient(connection).Version)
+	return response.GetResult(), err
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2pb.RegisterG2ProductServer(server, &g2productServer{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	connection, err = grpc.DialContext(context.TODO(), "bufnet", grpc.WithContextDialer(bufDialer), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	err = connection.Close()
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestDeadlines_Timeout(test *testing.T) {
+	var nilDeadlines *Deadlines
+	assert.Equal(test, DefaultTimeout, nilDeadlines.Timeout("AddRecord"))
+	assert.Equal(test, DefaultTimeouts["PurgeRepository"], nilDeadlines.Timeout("PurgeRepository"))
+	deadlines := NewDeadlines()
+	assert.Equal(test, DefaultTimeouts["PurgeRepository"], deadlines.Timeout("PurgeRepository"))
+	deadlines.SetDefaultTimeout(time.Second)
+	deadlines.SetTimeout("PurgeRepository", NoTimeout)
+	assert.Equal(test, time.Second, deadlines.Timeout("AddRecord"))
+	assert.Equal(test, NoTimeout, deadlines.Timeout("PurgeRepository"))
+	assert.Equal(test, time.Hour, DefaultTimeouts["PurgeRepository"], "SetTimeout changed the package defaults")
+}
+
+func TestDeadlines_Context(test *testing.T) {
+	deadlines := NewDeadlines()
+	deadlines.SetTimeout("PurgeRepository", NoTimeout)
+	ctx, cancel, timeout := deadlines.Context(context.TODO(), "AddRecord")
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.True(test, ok)
+	assert.Equal(test, DefaultTimeout, timeout)
+
+	ctx, cancel, timeout = deadlines.Context(context.TODO(), "PurgeRepository")
+	defer cancel()
+	_, ok = ctx.Deadline()
+	assert.False(test, ok)
+	assert.Equal(test, NoTimeout, timeout)
+
+	callerCtx, callerCancel := context.WithTimeout(context.TODO(), time.Hour*24)
+	defer callerCancel()
+	ctx, cancel, timeout = deadlines.Context(callerCtx, "AddRecord")
+	defer cancel()
+	assert.Equal(test, callerCtx, ctx)
+	assert.Equal(test, NoTimeout, timeout)
+}
+
+func TestCall_DefaultDeadline(test *testing.T) {
+	deadlines := NewDeadlines()
+	deadlines.SetTimeout("Version", 20*time.Millisecond)
+	_, err := callVersion(context.TODO(), deadlines)
+	var deadlineError *g2error.G2DeadlineExceededError
+	if !errors.As(err, &deadlineError) {
+		assert.FailNow(test, fmt.Sprintf("expected a G2DeadlineExceededError, got %T: %v", err, err))
+	}
+	assert.Equal(test, "Version", deadlineError.Method)
+	assert.Equal(test, 20*time.Millisecond, deadlineError.Timeout)
+	assert.True(test, g2error.Is(err, g2error.G2DeadlineExceeded))
+	assert.True(test, g2error.Is(err, g2error.G2Retryable))
+	assert.Contains(test, err.Error(), "default deadline of 20ms for Version exceeded")
+}
+
+func TestCall_CallerDeadline(test *testing.T) {
+	deadlines := NewDeadlines()
+	deadlines.SetTimeout("Version", time.Hour)
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	_, err := callVersion(ctx, deadlines)
+	var deadlineError *g2error.G2DeadlineExceededError
+	if !errors.As(err, &deadlineError) {
+		assert.FailNow(test, fmt.Sprintf("expected a G2DeadlineExceededError, got %T: %v", err, err))
+	}
+	assert.Equal(test, time.Duration(0), deadlineError.Timeout)
+}
+
+func TestCall_CallerDeadlineWins(test *testing.T) {
+	deadlines := NewDeadlines()
+	deadlines.SetTimeout("Version", 20*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	result, err := callVersion(ctx, deadlines)
+	assert.NoError(test, err)
+	assert.Equal(test, `{}`, result)
+}
+
+func TestCall_NoTimeout(test *testing.T) {
+	result, err := callLicense(context.TODO(), nil)
+	assert.NoError(test, err)
+	assert.Equal(test, "true", result, "without Deadlines, the package defaults apply")
+	deadlines := NewDeadlines()
+	result, err = callLicense(context.TODO(), deadlines)
+	assert.NoError(test, err)
+	assert.Equal(test, "true", result)
+	deadlines.SetTimeout("License", NoTimeout)
+	result, err = callLicense(context.TODO(), deadlines)
+	assert.NoError(test, err)
+	assert.Equal(test, "false", result, "NoTimeout opts out")
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleDeadlines_SetTimeout() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2deadline/g2deadline_test.go
+	deadlines := NewDeadlines()
+	deadlines.SetTimeout("FindNetworkByEntityID_V2", 30*time.Second)
+	deadlines.SetTimeout("PurgeRepository", NoTimeout)
+	fmt.Println(deadlines.Timeout("FindNetworkByEntityID_V2"), deadlines.Timeout("PurgeRepository"), deadlines.Timeout("AddRecord"))
+	// Output: 30s 0s 2m0s
+}
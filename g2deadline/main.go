@@ -0,0 +1,39 @@
+package g2deadline
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	// The timeout of methods not in DefaultTimeouts.
+	DefaultTimeout = 2 * time.Minute
+
+	// A timeout that applies no default deadline.
+	NoTimeout time.Duration = 0
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Timeouts of methods that routinely take longer than DefaultTimeout, by SDK method name.
+// Methods of different clients with the same name share a timeout.
+var DefaultTimeouts = map[string]time.Duration{
+	"CheckDBPerf":              10 * time.Minute,
+	"ExportCSVEntityReport":    30 * time.Minute,
+	"ExportJSONEntityReport":   30 * time.Minute,
+	"FindNetworkByEntityID":    10 * time.Minute,
+	"FindNetworkByEntityID_V2": 10 * time.Minute,
+	"FindNetworkByRecordID":    10 * time.Minute,
+	"FindNetworkByRecordID_V2": 10 * time.Minute,
+	"GetDataSourceCounts":      30 * time.Minute,
+	"GetEntityListBySize":      30 * time.Minute,
+	"GetEntitySizeBreakdown":   30 * time.Minute,
+	"GetMappingStatistics":     30 * time.Minute,
+	"GetResolutionStatistics":  30 * time.Minute,
+	"PrimeEngine":              10 * time.Minute,
+	"PurgeRepository":          time.Hour,
+	"Reinit":                   10 * time.Minute,
+}
@@ -7,17 +7,22 @@ package g2diagnostic
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
 	g2diagnosticapi "github.com/senzing/g2-sdk-go/g2diagnostic"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2diagnostic"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
 	"github.com/senzing/go-observing/observer"
-	"github.com/senzing/go-observing/subject"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 // ----------------------------------------------------------------------------
@@ -25,16 +30,29 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2diagnostic struct {
-	GrpcClient g2pb.G2DiagnosticClient
-	isTrace    bool
-	logger     messagelogger.MessageLoggerInterface
-	observers  subject.Subject
+	GrpcClient  g2pb.G2DiagnosticClient
+	deadlines   *g2deadline.Deadlines
+	handles     *g2handle.Tracker
+	handlesOnce sync.Once
+	isTrace     bool
+	logger      messagelogger.MessageLoggerInterface
+	observers   *g2observer.Queue
 }
 
 // ----------------------------------------------------------------------------
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// Get the handle Tracker, creating one on first use.
+func (client *G2diagnostic) getHandles() *g2handle.Tracker {
+	client.handlesOnce.Do(func() {
+		if client.handles == nil {
+			client.handles = g2handle.NewTracker()
+		}
+	})
+	return client.handles
+}
+
 // Get the Logger singleton.
 func (client *G2diagnostic) getLogger() messagelogger.MessageLoggerInterface {
 	if client.logger == nil {
@@ -43,31 +61,35 @@ func (client *G2diagnostic) getLogger() messagelogger.MessageLoggerInterface {
 	return client.logger
 }
 
+// Log a handle left open, as a warning that SetLogLevel() can filter out.
+func (client *G2diagnostic) logHandleLeak(message string) {
+	client.getLogger().Log(3001, message)
+}
+
 // Notify registered observers.
 func (client *G2diagnostic) notify(ctx context.Context, messageId int, err error, details map[string]string) {
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
 	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	details["correlationID"] = g2metadata.CorrelationID(ctx)
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	err = client.observers.Notify(ctx, details)
 	if err != nil {
 		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
 	}
 }
 
 // Trace method entry.
-func (client *G2diagnostic) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2diagnostic) traceEntry(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // Trace method exit.
-func (client *G2diagnostic) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2diagnostic) traceExit(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // ----------------------------------------------------------------------------
@@ -87,22 +109,21 @@ Output
     Example: `{"numRecordsInserted":0,"insertTime":0}`
 */
 func (client *G2diagnostic) CheckDBPerf(ctx context.Context, secondsToRun int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(1, secondsToRun)
+		client.traceEntry(ctx, 1, secondsToRun)
 	}
 	entryTime := time.Now()
 	request := g2pb.CheckDBPerfRequest{
 		SecondsToRun: int32(secondsToRun),
 	}
-	response, err := client.GrpcClient.CheckDBPerf(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "CheckDBPerf", &request, client.GrpcClient.CheckDBPerf)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8001, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8001, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(2, secondsToRun, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 2, secondsToRun, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -118,22 +139,23 @@ Input
   - entityListBySizeHandle: A handle created by GetEntityListBySize().
 */
 func (client *G2diagnostic) CloseEntityListBySize(ctx context.Context, entityListBySizeHandle uintptr) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(5)
+		client.traceEntry(ctx, 5)
 	}
 	entryTime := time.Now()
 	request := g2pb.CloseEntityListBySizeRequest{
 		EntityListBySizeHandle: fmt.Sprintf("%v", entityListBySizeHandle),
 	}
-	_, err := client.GrpcClient.CloseEntityListBySize(ctx, &request)
+	callPeer := peer.Peer{}
+	_, err := g2deadline.Call(ctx, client.deadlines, "CloseEntityListBySize", &request, client.GrpcClient.CloseEntityListBySize, grpc.Peer(&callPeer))
+	err = client.getHandles().Release(g2handle.EntityListBySizeHandle, entityListBySizeHandle, "CloseEntityListBySize", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8002, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8002, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(6, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 6, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -141,25 +163,30 @@ func (client *G2diagnostic) CloseEntityListBySize(ctx context.Context, entityLis
 /*
 The Destroy method will destroy and perform cleanup for the Senzing G2Diagnostic object.
 It should be called after all other calls are complete.
+The entity list handles the client created that are still open are closed first; see OutstandingHandles().
+Handles created by other clients sharing its Tracker are left open.
 
 Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2diagnostic) Destroy(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(7)
+		client.traceEntry(ctx, 7)
 	}
 	entryTime := time.Now()
+	for _, handle := range client.getHandles().Outstanding(client, g2handle.EntityListBySizeHandle) {
+		_ = client.CloseEntityListBySize(ctx, handle.Value)
+	}
+	client.getHandles().Forget(client, g2handle.EntityListBySizeHandle)
 	request := g2pb.DestroyRequest{}
-	_, err := client.GrpcClient.Destroy(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Destroy", &request, client.GrpcClient.Destroy)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8003, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8003, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(8, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 8, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -179,22 +206,23 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) FetchNextEntityBySize(ctx context.Context, entityListBySizeHandle uintptr) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(9)
+		client.traceEntry(ctx, 9)
 	}
 	entryTime := time.Now()
 	request := g2pb.FetchNextEntityBySizeRequest{
 		EntityListBySizeHandle: fmt.Sprintf("%v", entityListBySizeHandle),
 	}
-	response, err := client.GrpcClient.FetchNextEntityBySize(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "FetchNextEntityBySize", &request, client.GrpcClient.FetchNextEntityBySize, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.EntityListBySizeHandle, entityListBySizeHandle, "FetchNextEntityBySize", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8004, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8004, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(10, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 10, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -213,26 +241,42 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) FindEntitiesByFeatureIDs(ctx context.Context, features string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(11, features)
+		client.traceEntry(ctx, 11, features)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindEntitiesByFeatureIDsRequest{
 		Features: features,
 	}
-	response, err := client.GrpcClient.FindEntitiesByFeatureIDs(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindEntitiesByFeatureIDs", &request, client.GrpcClient.FindEntitiesByFeatureIDs)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8005, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8005, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(12, features, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 12, features, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
 
+/*
+The FlushObservers method waits until the messages to observers queued before the call are delivered,
+e.g. before the process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (client *G2diagnostic) FlushObservers(ctx context.Context) error {
+	if client.observers == nil {
+		return nil
+	}
+	return client.observers.Flush(ctx)
+}
+
 /*
 The GetAvailableMemory method returns the available memory, in bytes, on the host system.
 
@@ -243,20 +287,19 @@ Output
   - Number of bytes of available memory.
 */
 func (client *G2diagnostic) GetAvailableMemory(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(13)
+		client.traceEntry(ctx, 13)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetAvailableMemoryRequest{}
-	response, err := client.GrpcClient.GetAvailableMemory(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetAvailableMemory", &request, client.GrpcClient.GetAvailableMemory)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8006, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8006, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(14, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 14, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -272,20 +315,19 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetDataSourceCounts(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(15)
+		client.traceEntry(ctx, 15)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetDataSourceCountsRequest{}
-	response, err := client.GrpcClient.GetDataSourceCounts(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetDataSourceCounts", &request, client.GrpcClient.GetDataSourceCounts)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8007, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8007, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(16, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 16, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -301,20 +343,19 @@ Output
     Example: `{"Hybrid Mode":false,"Database Details":[{"Name":"0.0.0.0","Type":"postgresql"}]}`
 */
 func (client *G2diagnostic) GetDBInfo(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(17)
+		client.traceEntry(ctx, 17)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetDBInfoRequest{}
-	response, err := client.GrpcClient.GetDBInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetDBInfo", &request, client.GrpcClient.GetDBInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8008, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8008, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(18, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 18, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -332,23 +373,22 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntityDetails(ctx context.Context, entityID int64, includeInternalFeatures int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(19, entityID, includeInternalFeatures)
+		client.traceEntry(ctx, 19, entityID, includeInternalFeatures)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityDetailsRequest{
 		EntityID:                entityID,
 		IncludeInternalFeatures: int32(includeInternalFeatures),
 	}
-	response, err := client.GrpcClient.GetEntityDetails(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityDetails", &request, client.GrpcClient.GetEntityDetails)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8009, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8009, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(20, entityID, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 20, entityID, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -367,27 +407,30 @@ Output
   - A handle to an entity list to be used with FetchNextEntityBySize() and CloseEntityListBySize().
 */
 func (client *G2diagnostic) GetEntityListBySize(ctx context.Context, entitySize int) (uintptr, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(21, entitySize)
+		client.traceEntry(ctx, 21, entitySize)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityListBySizeRequest{
 		EntitySize: int32(entitySize),
 	}
-	response, err := client.GrpcClient.GetEntityListBySize(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityListBySize", &request, client.GrpcClient.GetEntityListBySize, grpc.Peer(&callPeer))
 	if err != nil {
 		return 0, err
 	}
 	result := response.GetResult()
 	result_int, err := strconv.Atoi(result)
+	if err == nil {
+		client.getHandles().Open(g2handle.EntityListBySizeHandle, uintptr(result_int), "GetEntityListBySize", callPeer.Addr, client, client.logHandleLeak)
+	}
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8010, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8010, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(22, entitySize, (uintptr)(result_int), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 22, entitySize, (uintptr)(result_int), err, time.Since(entryTime))
 	}
 	return uintptr(result_int), err
 }
@@ -404,22 +447,21 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntityResume(ctx context.Context, entityID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(23, entityID)
+		client.traceEntry(ctx, 23, entityID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityResumeRequest{
 		EntityID: entityID,
 	}
-	response, err := client.GrpcClient.GetEntityResume(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityResume", &request, client.GrpcClient.GetEntityResume)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8011, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8011, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(24, entityID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 24, entityID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -437,23 +479,22 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetEntitySizeBreakdown(ctx context.Context, minimumEntitySize int, includeInternalFeatures int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(25, minimumEntitySize, includeInternalFeatures)
+		client.traceEntry(ctx, 25, minimumEntitySize, includeInternalFeatures)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntitySizeBreakdownRequest{
 		MinimumEntitySize:       int32(minimumEntitySize),
 		IncludeInternalFeatures: int32(includeInternalFeatures),
 	}
-	response, err := client.GrpcClient.GetEntitySizeBreakdown(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntitySizeBreakdown", &request, client.GrpcClient.GetEntitySizeBreakdown)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8012, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8012, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(26, minimumEntitySize, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 26, minimumEntitySize, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -470,22 +511,21 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetFeature(ctx context.Context, libFeatID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(27, libFeatID)
+		client.traceEntry(ctx, 27, libFeatID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetFeatureRequest{
 		LibFeatID: libFeatID,
 	}
-	response, err := client.GrpcClient.GetFeature(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetFeature", &request, client.GrpcClient.GetFeature)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8013, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8013, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(28, libFeatID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 28, libFeatID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -503,23 +543,22 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetGenericFeatures(ctx context.Context, featureType string, maximumEstimatedCount int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(29, featureType, maximumEstimatedCount)
+		client.traceEntry(ctx, 29, featureType, maximumEstimatedCount)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetGenericFeaturesRequest{
 		FeatureType:           featureType,
 		MaximumEstimatedCount: int32(maximumEstimatedCount),
 	}
-	response, err := client.GrpcClient.GetGenericFeatures(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetGenericFeatures", &request, client.GrpcClient.GetGenericFeatures)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8014, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8014, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(30, featureType, maximumEstimatedCount, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 30, featureType, maximumEstimatedCount, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -534,20 +573,19 @@ Output
   - Number of logical cores.
 */
 func (client *G2diagnostic) GetLogicalCores(ctx context.Context) (int, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(35)
+		client.traceEntry(ctx, 35)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetLogicalCoresRequest{}
-	response, err := client.GrpcClient.GetLogicalCores(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetLogicalCores", &request, client.GrpcClient.GetLogicalCores)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8015, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8015, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(36, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 36, response.GetResult(), err, time.Since(entryTime))
 	}
 	return int(response.GetResult()), err
 }
@@ -564,22 +602,21 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetMappingStatistics(ctx context.Context, includeInternalFeatures int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(37, includeInternalFeatures)
+		client.traceEntry(ctx, 37, includeInternalFeatures)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetMappingStatisticsRequest{
 		IncludeInternalFeatures: int32(includeInternalFeatures),
 	}
-	response, err := client.GrpcClient.GetMappingStatistics(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetMappingStatistics", &request, client.GrpcClient.GetMappingStatistics)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8016, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8016, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(38, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 38, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -594,20 +631,19 @@ Output
   - Number of physical cores.
 */
 func (client *G2diagnostic) GetPhysicalCores(ctx context.Context) (int, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(39)
+		client.traceEntry(ctx, 39)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetPhysicalCoresRequest{}
-	response, err := client.GrpcClient.GetPhysicalCores(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetPhysicalCores", &request, client.GrpcClient.GetPhysicalCores)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8017, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8017, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(40, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 40, response.GetResult(), err, time.Since(entryTime))
 	}
 	return int(response.GetResult()), err
 }
@@ -625,23 +661,22 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetRelationshipDetails(ctx context.Context, relationshipID int64, includeInternalFeatures int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(41, relationshipID, includeInternalFeatures)
+		client.traceEntry(ctx, 41, relationshipID, includeInternalFeatures)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetRelationshipDetailsRequest{
 		RelationshipID:          relationshipID,
 		IncludeInternalFeatures: int32(includeInternalFeatures),
 	}
-	response, err := client.GrpcClient.GetRelationshipDetails(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetRelationshipDetails", &request, client.GrpcClient.GetRelationshipDetails)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8018, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8018, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(42, relationshipID, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 42, relationshipID, includeInternalFeatures, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -657,20 +692,19 @@ Output
     See the example output.
 */
 func (client *G2diagnostic) GetResolutionStatistics(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(43)
+		client.traceEntry(ctx, 43)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetResolutionStatisticsRequest{}
-	response, err := client.GrpcClient.GetResolutionStatistics(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetResolutionStatistics", &request, client.GrpcClient.GetResolutionStatistics)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8019, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8019, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(44, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 44, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -684,19 +718,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2diagnostic) GetSdkId(ctx context.Context) string {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(59)
+		client.traceEntry(ctx, 59)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8024, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8024, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(60, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 60, err, time.Since(entryTime))
 	}
 	return "grpc"
 }
@@ -711,20 +744,19 @@ Output
   - Number of bytes of memory.
 */
 func (client *G2diagnostic) GetTotalSystemMemory(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(57)
+		client.traceEntry(ctx, 57)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetTotalSystemMemoryRequest{}
-	response, err := client.GrpcClient.GetTotalSystemMemory(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetTotalSystemMemory", &request, client.GrpcClient.GetTotalSystemMemory)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8020, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8020, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(46, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 46, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -740,8 +772,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2diagnostic) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(47, moduleName, iniParams, verboseLogging)
+		client.traceEntry(ctx, 47, moduleName, iniParams, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitRequest{
@@ -749,19 +782,17 @@ func (client *G2diagnostic) Init(ctx context.Context, moduleName string, iniPara
 		IniParams:      iniParams,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.Init(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Init", &request, client.GrpcClient.Init)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8021, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8021, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(48, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 48, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -778,8 +809,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2diagnostic) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(49, moduleName, iniParams, initConfigID, verboseLogging)
+		client.traceEntry(ctx, 49, moduleName, iniParams, initConfigID, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitWithConfigIDRequest{
@@ -788,24 +820,29 @@ func (client *G2diagnostic) InitWithConfigID(ctx context.Context, moduleName str
 		InitConfigID:   initConfigID,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.InitWithConfigID(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "InitWithConfigID", &request, client.GrpcClient.InitWithConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"initConfigID":   strconv.FormatInt(initConfigID, 10),
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8022, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"initConfigID":   strconv.FormatInt(initConfigID, 10),
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8022, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(50, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 50, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The OutstandingHandles method returns the entity list handles the client created with GetEntityListBySize() and not yet closed, oldest first.
+*/
+func (client *G2diagnostic) OutstandingHandles() []g2handle.Handle {
+	return client.getHandles().Outstanding(client, g2handle.EntityListBySizeHandle)
+}
+
 /*
 The RegisterObserver method adds the observer to the list of observers notified.
 
@@ -814,24 +851,23 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2diagnostic) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(55, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 55, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
+		client.observers = g2observer.NewQueue()
 	}
 	err := client.observers.RegisterObserver(ctx, observer)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverId(ctx),
-			}
-			client.notify(ctx, 8025, err, details)
-		}()
+		details := map[string]string{
+			"observerID": observer.GetObserverId(ctx),
+		}
+		client.notify(ctx, 8025, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(56, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 56, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -844,28 +880,52 @@ Input
   - initConfigID: The configuration ID used for the initialization.
 */
 func (client *G2diagnostic) Reinit(ctx context.Context, initConfigID int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(51, initConfigID)
+		client.traceEntry(ctx, 51, initConfigID)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReinitRequest{
 		InitConfigID: initConfigID,
 	}
-	_, err := client.GrpcClient.Reinit(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Reinit", &request, client.GrpcClient.Reinit)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"initConfigID": strconv.FormatInt(initConfigID, 10),
-			}
-			client.notify(ctx, 8023, err, details)
-		}()
+		details := map[string]string{
+			"initConfigID": strconv.FormatInt(initConfigID, 10),
+		}
+		client.notify(ctx, 8023, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(52, initConfigID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 52, initConfigID, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetDeadlines method sets the default deadlines of calls whose context has none.
+Without it, the client uses g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts;
+use g2deadline.NoTimeout in Deadlines to opt a method out.
+
+Input
+  - deadlines: The default timeout of each method.
+*/
+func (client *G2diagnostic) SetDeadlines(deadlines *g2deadline.Deadlines) {
+	client.deadlines = deadlines
+}
+
+/*
+The SetHandleTracker method sets the Tracker recording the handles the client creates.
+Without it, the client uses a Tracker of its own, created by g2handle.NewTracker().
+Call it before the client creates a handle.
+
+Input
+  - tracker: The Tracker, which may be shared with other clients.
+*/
+func (client *G2diagnostic) SetHandleTracker(tracker *g2handle.Tracker) {
+	client.handlesOnce.Do(func() {})
+	client.handles = tracker
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -874,27 +934,44 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2diagnostic) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(53, logLevel)
+		client.traceEntry(ctx, 53, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
 	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevel": logger.LevelToTextMap[logLevel],
-			}
-			client.notify(ctx, 8026, err, details)
-		}()
+		details := map[string]string{
+			"logLevel": logger.LevelToTextMap[logLevel],
+		}
+		client.notify(ctx, 8026, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(54, logLevel, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 54, logLevel, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetObserverQueue method sets the Queue delivering the messages of the client to its observers.
+The observers already registered are registered on it.
+Without it, the client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - queue: The Queue, usually created by g2observer.NewQueue().
+*/
+func (client *G2diagnostic) SetObserverQueue(queue *g2observer.Queue) {
+	if client.observers != nil {
+		ctx := context.Background()
+		for _, observer := range client.observers.GetObservers(ctx) {
+			_ = queue.RegisterObserver(ctx, observer)
+		}
+	}
+	client.observers = queue
+}
+
 /*
 The UnregisterObserver method removes the observer to the list of observers notified.
 
@@ -903,27 +980,22 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2diagnostic) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(57, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 57, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// The message is queued before the observer is removed, so the observer gets it.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8027, err, details)
-	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+		err = client.observers.UnregisterObserver(ctx, observer)
 	}
 	if client.isTrace {
-		defer client.traceExit(58, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 58, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/senzing/g2-sdk-go-grpc/g2config"
 	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
 	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 	"github.com/senzing/g2-sdk-go/g2api"
 	g2diagnosticapi "github.com/senzing/g2-sdk-go/g2diagnostic"
 	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
@@ -48,7 +49,11 @@ var (
 func getGrpcConnection() *grpc.ClientConn {
 	var err error = nil
 	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if grpctest.Enabled() {
+			grpcConnection, err = grpctest.Dial(context.TODO())
+		} else {
+			grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
 		if err != nil {
 			fmt.Printf("Did not connect: %v\n", err)
 		}
@@ -288,8 +293,10 @@ func TestG2diagnostic_EntityListBySize(test *testing.T) {
 	anEntity, err := g2diagnostic.FetchNextEntityBySize(ctx, aHandle)
 	testError(test, ctx, g2diagnostic, err)
 	printResult(test, "Entity", anEntity)
+	assert.Len(test, g2diagnostic.(*G2diagnostic).OutstandingHandles(), 1)
 	err = g2diagnostic.CloseEntityListBySize(ctx, aHandle)
 	testError(test, ctx, g2diagnostic, err)
+	assert.Empty(test, g2diagnostic.(*G2diagnostic).OutstandingHandles())
 }
 
 func TestG2diagnostic_FindEntitiesByFeatureIDs(test *testing.T) {
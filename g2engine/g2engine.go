@@ -7,17 +7,22 @@ package g2engine
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2handle"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
 	g2engineapi "github.com/senzing/g2-sdk-go/g2engine"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
 	"github.com/senzing/go-observing/observer"
-	"github.com/senzing/go-observing/subject"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 )
 
 // ----------------------------------------------------------------------------
@@ -25,16 +30,42 @@ import (
 // ----------------------------------------------------------------------------
 
 type G2engine struct {
-	GrpcClient g2pb.G2EngineClient
-	isTrace    bool
-	logger     messagelogger.MessageLoggerInterface
-	observers  subject.Subject
+	GrpcClient  g2pb.G2EngineClient
+	deadlines   *g2deadline.Deadlines
+	handles     *g2handle.Tracker
+	handlesOnce sync.Once
+	isTrace     bool
+	logger      messagelogger.MessageLoggerInterface
+	observers   *g2observer.Queue
+}
+
+// ExportResult is one line of an exported document, or the error that ended the export.
+type ExportResult struct {
+	Error      error  // Non-nil if the export failed; it is the last value sent.
+	LineNumber int64  // 1-based position of Value in the export.
+	Value      string // One exported entity, as JSON or CSV.
+}
+
+// A line fetched by fetchAhead(), or the error that stopped it.
+type fetchedLine struct {
+	err   error
+	value string
 }
 
 // ----------------------------------------------------------------------------
 // Internal methods
 // ----------------------------------------------------------------------------
 
+// Get the handle Tracker, creating one on first use.
+func (client *G2engine) getHandles() *g2handle.Tracker {
+	client.handlesOnce.Do(func() {
+		if client.handles == nil {
+			client.handles = g2handle.NewTracker()
+		}
+	})
+	return client.handles
+}
+
 // Get the Logger singleton.
 func (client *G2engine) getLogger() messagelogger.MessageLoggerInterface {
 	if client.logger == nil {
@@ -43,31 +74,149 @@ func (client *G2engine) getLogger() messagelogger.MessageLoggerInterface {
 	return client.logger
 }
 
+// Log a handle left open, as a warning that SetLogLevel() can filter out.
+func (client *G2engine) logHandleLeak(message string) {
+	client.getLogger().Log(3001, message)
+}
+
 // Notify registered observers.
 func (client *G2engine) notify(ctx context.Context, messageId int, err error, details map[string]string) {
 	now := time.Now()
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
 	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	details["correlationID"] = g2metadata.CorrelationID(ctx)
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	err = client.observers.Notify(ctx, details)
 	if err != nil {
 		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
 	}
 }
 
 // Trace method entry.
-func (client *G2engine) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2engine) traceEntry(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // Trace method exit.
-func (client *G2engine) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2engine) traceExit(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
+}
+
+// Fetch the lines of an export, one FetchNext() call after the other, ahead of the consumer, sending each line,
+// or the error that stopped the fetching, to the returned channel; it is closed when fetching stops.
+// Call stop to cancel the fetching and wait for it.
+func (client *G2engine) fetchAhead(ctx context.Context, responseHandle uintptr) (lines <-chan fetchedLine, stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	lineChannel := make(chan fetchedLine, ExportBufferSize)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(lineChannel)
+		for ctx.Err() == nil {
+			value, err := client.FetchNext(ctx, responseHandle)
+			if err == nil && len(value) == 0 {
+				return
+			}
+			select {
+			case lineChannel <- fetchedLine{err: err, value: value}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return lineChannel, func() {
+		cancel()
+		<-done
+	}
+}
+
+// Run an export in a goroutine, sending each line fetched to the returned channel.
+// The export handle is always closed, even if ctx is cancelled. All its calls share one correlation ID.
+func (client *G2engine) exportIterator(ctx context.Context, messageId int, export func(context.Context) (uintptr, error)) <-chan ExportResult {
+	ctx = g2metadata.NewContext(ctx)
+	resultChannel := make(chan ExportResult, ExportBufferSize)
+	go func() {
+		defer close(resultChannel)
+		var lineNumber int64 = 0
+
+		// Send a result, giving up if the consumer has cancelled.
+
+		send := func(result ExportResult) bool {
+			if ctx.Err() != nil {
+				return false
+			}
+			select {
+			case resultChannel <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		responseHandle, err := export(ctx)
+		if err != nil {
+			send(ExportResult{Error: err})
+			return
+		}
+		defer func() {
+			closeCtx, cancel := context.WithTimeout(g2metadata.WithCorrelationID(context.Background(), g2metadata.CorrelationID(ctx)), exportCloseTimeout)
+			defer cancel()
+			closeErr := client.CloseExport(closeCtx, responseHandle)
+			if closeErr != nil && err == nil {
+				err = closeErr
+				send(ExportResult{Error: err})
+			}
+			if client.observers != nil {
+				details := map[string]string{
+					"complete":  strconv.FormatBool(err == nil),
+					"lineCount": strconv.FormatInt(lineNumber, 10),
+				}
+				client.notify(ctx, messageId, err, details)
+			}
+		}()
+
+		// Accept a fetched line, reporting whether to go on.
+
+		accept := func(line fetchedLine) bool {
+			err = line.err
+			if err == nil {
+				err = ctx.Err()
+			}
+			if err != nil {
+				send(ExportResult{Error: err})
+				return false
+			}
+			lineNumber++
+			if !send(ExportResult{LineNumber: lineNumber, Value: line.value}) {
+				err = ctx.Err()
+				return false
+			}
+			if client.observers != nil && lineNumber%ExportProgressInterval == 0 {
+				details := map[string]string{
+					"complete":  "false",
+					"lineCount": strconv.FormatInt(lineNumber, 10),
+				}
+				client.notify(ctx, messageId, nil, details)
+			}
+			return true
+		}
+
+		lines, stop := client.fetchAhead(ctx, responseHandle)
+		defer stop()
+		for line := range lines {
+			if !accept(line) {
+				return
+			}
+		}
+		err = ctx.Err()
+	}()
+	return resultChannel
 }
 
 // ----------------------------------------------------------------------------
@@ -85,8 +234,9 @@ Input
   - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
 */
 func (client *G2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(1, dataSourceCode, recordID, jsonData, loadID)
+		client.traceEntry(ctx, 1, dataSourceCode, recordID, jsonData, loadID)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddRecordRequest{
@@ -95,19 +245,17 @@ func (client *G2engine) AddRecord(ctx context.Context, dataSourceCode string, re
 		JsonData:       jsonData,
 		LoadID:         loadID,
 	}
-	_, err := client.GrpcClient.AddRecord(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "AddRecord", &request, client.GrpcClient.AddRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8001, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8001, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(2, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 2, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -128,8 +276,9 @@ Output
     See the example output.
 */
 func (client *G2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(3, dataSourceCode, recordID, jsonData, loadID, flags)
+		client.traceEntry(ctx, 3, dataSourceCode, recordID, jsonData, loadID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddRecordWithInfoRequest{
@@ -139,19 +288,17 @@ func (client *G2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode st
 		LoadID:         loadID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.AddRecordWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "AddRecordWithInfo", &request, client.GrpcClient.AddRecordWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8002, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8002, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(4, dataSourceCode, recordID, jsonData, loadID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 4, dataSourceCode, recordID, jsonData, loadID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -173,8 +320,9 @@ Output
     Example: `2D4DABB3FAEAFBD452E9487D06FABC22DC69C846`
 */
 func (client *G2engine) AddRecordWithInfoWithReturnedRecordID(ctx context.Context, dataSourceCode string, jsonData string, loadID string, flags int64) (string, string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(5, dataSourceCode, jsonData, loadID, flags)
+		client.traceEntry(ctx, 5, dataSourceCode, jsonData, loadID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddRecordWithInfoWithReturnedRecordIDRequest{
@@ -183,19 +331,17 @@ func (client *G2engine) AddRecordWithInfoWithReturnedRecordID(ctx context.Contex
 		LoadID:         loadID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.AddRecordWithInfoWithReturnedRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "AddRecordWithInfoWithReturnedRecordID", &request, client.GrpcClient.AddRecordWithInfoWithReturnedRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       response.GetRecordID(),
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8003, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       response.GetRecordID(),
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8003, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(6, dataSourceCode, jsonData, loadID, flags, response.GetWithInfo(), response.GetRecordID(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 6, dataSourceCode, jsonData, loadID, flags, response.GetWithInfo(), response.GetRecordID(), err, time.Since(entryTime))
 	}
 	return response.GetWithInfo(), response.GetRecordID(), err
 }
@@ -214,8 +360,9 @@ Output
     Example: `2D4DABB3FAEAFBD452E9487D06FABC22DC69C846`
 */
 func (client *G2engine) AddRecordWithReturnedRecordID(ctx context.Context, dataSourceCode string, jsonData string, loadID string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(7, dataSourceCode, jsonData, loadID)
+		client.traceEntry(ctx, 7, dataSourceCode, jsonData, loadID)
 	}
 	entryTime := time.Now()
 	request := g2pb.AddRecordWithReturnedRecordIDRequest{
@@ -223,19 +370,17 @@ func (client *G2engine) AddRecordWithReturnedRecordID(ctx context.Context, dataS
 		JsonData:       jsonData,
 		LoadID:         loadID,
 	}
-	response, err := client.GrpcClient.AddRecordWithReturnedRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "AddRecordWithReturnedRecordID", &request, client.GrpcClient.AddRecordWithReturnedRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       response.GetResult(),
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8004, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       response.GetResult(),
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8004, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(8, dataSourceCode, jsonData, loadID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 8, dataSourceCode, jsonData, loadID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -254,23 +399,22 @@ Output
     See the example output.
 */
 func (client *G2engine) CheckRecord(ctx context.Context, record string, recordQueryList string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(9, record, recordQueryList)
+		client.traceEntry(ctx, 9, record, recordQueryList)
 	}
 	entryTime := time.Now()
 	request := g2pb.CheckRecordRequest{
 		Record:          record,
 		RecordQueryList: recordQueryList,
 	}
-	response, err := client.GrpcClient.CheckRecord(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "CheckRecord", &request, client.GrpcClient.CheckRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8005, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8005, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(10, record, recordQueryList, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 10, record, recordQueryList, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -285,22 +429,23 @@ Input
   - responseHandle: A handle created by ExportJSONEntityReport() or ExportCSVEntityReport().
 */
 func (client *G2engine) CloseExport(ctx context.Context, responseHandle uintptr) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(13, responseHandle)
+		client.traceEntry(ctx, 13, responseHandle)
 	}
 	entryTime := time.Now()
 	request := g2pb.CloseExportRequest{
 		ResponseHandle: int64(responseHandle),
 	}
-	_, err := client.GrpcClient.CloseExport(ctx, &request)
+	callPeer := peer.Peer{}
+	_, err := g2deadline.Call(ctx, client.deadlines, "CloseExport", &request, client.GrpcClient.CloseExport, grpc.Peer(&callPeer))
+	err = client.getHandles().Release(g2handle.ExportHandle, responseHandle, "CloseExport", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8006, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8006, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(14, responseHandle, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 14, responseHandle, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -315,20 +460,19 @@ Output
   - The number of redo records in Senzing's redo queue.
 */
 func (client *G2engine) CountRedoRecords(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(15)
+		client.traceEntry(ctx, 15)
 	}
 	entryTime := time.Now()
 	request := g2pb.CountRedoRecordsRequest{}
-	response, err := client.GrpcClient.CountRedoRecords(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "CountRedoRecords", &request, client.GrpcClient.CountRedoRecords)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8007, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8007, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(16, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 16, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -344,8 +488,9 @@ Input
     FIXME: How does the "loadID" affect what is deleted?
 */
 func (client *G2engine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, loadID string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(17, dataSourceCode, recordID, loadID)
+		client.traceEntry(ctx, 17, dataSourceCode, recordID, loadID)
 	}
 	entryTime := time.Now()
 	request := g2pb.DeleteRecordRequest{
@@ -353,19 +498,17 @@ func (client *G2engine) DeleteRecord(ctx context.Context, dataSourceCode string,
 		RecordID:       recordID,
 		LoadID:         loadID,
 	}
-	_, err := client.GrpcClient.DeleteRecord(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "DeleteRecord", &request, client.GrpcClient.DeleteRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8008, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8008, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(18, dataSourceCode, recordID, loadID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 18, dataSourceCode, recordID, loadID, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -386,8 +529,9 @@ Output
     See the example output.
 */
 func (client *G2engine) DeleteRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, loadID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(19, dataSourceCode, recordID, loadID, flags)
+		client.traceEntry(ctx, 19, dataSourceCode, recordID, loadID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.DeleteRecordWithInfoRequest{
@@ -396,19 +540,17 @@ func (client *G2engine) DeleteRecordWithInfo(ctx context.Context, dataSourceCode
 		LoadID:         loadID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.DeleteRecordWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "DeleteRecordWithInfo", &request, client.GrpcClient.DeleteRecordWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8009, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8009, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(20, dataSourceCode, recordID, loadID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 20, dataSourceCode, recordID, loadID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -416,25 +558,30 @@ func (client *G2engine) DeleteRecordWithInfo(ctx context.Context, dataSourceCode
 /*
 The Destroy method will destroy and perform cleanup for the Senzing G2 object.
 It should be called after all other calls are complete.
+The export handles the client created that are still open are closed first; see OutstandingHandles().
+Handles created by other clients sharing its Tracker are left open.
 
 Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) Destroy(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(21)
+		client.traceEntry(ctx, 21)
 	}
 	entryTime := time.Now()
+	for _, handle := range client.getHandles().Outstanding(client, g2handle.ExportHandle) {
+		_ = client.CloseExport(ctx, handle.Value)
+	}
+	client.getHandles().Forget(client, g2handle.ExportHandle)
 	request := g2pb.DestroyRequest{}
-	_, err := client.GrpcClient.Destroy(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Destroy", &request, client.GrpcClient.Destroy)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8010, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8010, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(22, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 22, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -449,20 +596,19 @@ Output
   - A JSON document containing the current Senzing Engine configuration.
 */
 func (client *G2engine) ExportConfig(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(25)
+		client.traceEntry(ctx, 25)
 	}
 	entryTime := time.Now()
 	request := g2pb.ExportConfigRequest{}
-	response, err := client.GrpcClient.ExportConfig(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ExportConfig", &request, client.GrpcClient.ExportConfig)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8011, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8011, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(26, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 26, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -478,22 +624,21 @@ Output
   - The unique identifier of the Senzing Engine configuration.
 */
 func (client *G2engine) ExportConfigAndConfigID(ctx context.Context) (string, int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(23)
+		client.traceEntry(ctx, 23)
 	}
 	entryTime := time.Now()
 	request := g2pb.ExportConfigAndConfigIDRequest{}
-	response, err := client.GrpcClient.ExportConfigAndConfigID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ExportConfigAndConfigID", &request, client.GrpcClient.ExportConfigAndConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"configID": strconv.FormatInt(response.GetConfigID(), 10),
-			}
-			client.notify(ctx, 8012, err, details)
-		}()
+		details := map[string]string{
+			"configID": strconv.FormatInt(response.GetConfigID(), 10),
+		}
+		client.notify(ctx, 8012, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(24, response.GetConfig(), int64(response.GetConfigID()), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 24, response.GetConfig(), int64(response.GetConfigID()), err, time.Since(entryTime))
 	}
 	return response.GetConfig(), response.GetConfigID(), err
 }
@@ -512,23 +657,26 @@ Output
   - A handle that identifies the document to be scrolled through using FetchNext().
 */
 func (client *G2engine) ExportCSVEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(27, csvColumnList, flags)
+		client.traceEntry(ctx, 27, csvColumnList, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ExportCSVEntityReportRequest{
 		CsvColumnList: csvColumnList,
 		Flags:         flags,
 	}
-	response, err := client.GrpcClient.ExportCSVEntityReport(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "ExportCSVEntityReport", &request, client.GrpcClient.ExportCSVEntityReport, grpc.Peer(&callPeer))
+	if err == nil {
+		client.getHandles().Open(g2handle.ExportHandle, uintptr(response.GetResult()), "ExportCSVEntityReport", callPeer.Addr, client, client.logHandleLeak)
+	}
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8013, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8013, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(28, csvColumnList, flags, (uintptr)(response.GetResult()), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 28, csvColumnList, flags, (uintptr)(response.GetResult()), err, time.Since(entryTime))
 	}
 	return uintptr(response.GetResult()), err
 }
@@ -546,22 +694,25 @@ Output
   - A handle that identifies the document to be scrolled through using FetchNext().
 */
 func (client *G2engine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(29, flags)
+		client.traceEntry(ctx, 29, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ExportJSONEntityReportRequest{
 		Flags: flags,
 	}
-	response, err := client.GrpcClient.ExportJSONEntityReport(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "ExportJSONEntityReport", &request, client.GrpcClient.ExportJSONEntityReport, grpc.Peer(&callPeer))
+	if err == nil {
+		client.getHandles().Open(g2handle.ExportHandle, uintptr(response.GetResult()), "ExportJSONEntityReport", callPeer.Addr, client, client.logHandleLeak)
+	}
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8014, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8014, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(30, flags, (uintptr)(response.GetResult()), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 30, flags, (uintptr)(response.GetResult()), err, time.Since(entryTime))
 	}
 	return uintptr(response.GetResult()), err
 }
@@ -579,22 +730,23 @@ Output
   - FIXME:
 */
 func (client *G2engine) FetchNext(ctx context.Context, responseHandle uintptr) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(31, responseHandle)
+		client.traceEntry(ctx, 31, responseHandle)
 	}
 	entryTime := time.Now()
 	request := g2pb.FetchNextRequest{
 		ResponseHandle: int64(responseHandle),
 	}
-	response, err := client.GrpcClient.FetchNext(ctx, &request)
+	callPeer := peer.Peer{}
+	response, err := g2deadline.Call(ctx, client.deadlines, "FetchNext", &request, client.GrpcClient.FetchNext, grpc.Peer(&callPeer))
+	err = client.getHandles().Use(g2handle.ExportHandle, responseHandle, "FetchNext", callPeer.Addr, err)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8015, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8015, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(32, responseHandle, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 32, responseHandle, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -612,25 +764,24 @@ Output
     See the example output.
 */
 func (client *G2engine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(33, entityID, flags)
+		client.traceEntry(ctx, 33, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindInterestingEntitiesByEntityIDRequest{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.FindInterestingEntitiesByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindInterestingEntitiesByEntityID", &request, client.GrpcClient.FindInterestingEntitiesByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8016, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8016, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(34, entityID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 34, entityID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -649,8 +800,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(35, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 35, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindInterestingEntitiesByRecordIDRequest{
@@ -658,18 +810,16 @@ func (client *G2engine) FindInterestingEntitiesByRecordID(ctx context.Context, d
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.FindInterestingEntitiesByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindInterestingEntitiesByRecordID", &request, client.GrpcClient.FindInterestingEntitiesByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8017, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8017, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(36, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 36, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -692,8 +842,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"SEAMAN","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-11-29 22:25:18.997","LAST_SEEN_DT":"2022-11-29 22:25:19.005"}],"LAST_SEEN_DT":"2022-11-29 22:25:19.005"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-11-29 22:25:19.009","LAST_SEEN_DT":"2022-11-29 22:25:19.009"}],"LAST_SEEN_DT":"2022-11-29 22:25:19.009"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindNetworkByEntityID(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(37, entityList, maxDegree, buildOutDegree, maxDegree)
+		client.traceEntry(ctx, 37, entityList, maxDegree, buildOutDegree, maxDegree)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindNetworkByEntityIDRequest{
@@ -702,17 +853,15 @@ func (client *G2engine) FindNetworkByEntityID(ctx context.Context, entityList st
 		BuildOutDegree: int32(buildOutDegree),
 		MaxEntities:    int32(maxEntities),
 	}
-	response, err := client.GrpcClient.FindNetworkByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindNetworkByEntityID", &request, client.GrpcClient.FindNetworkByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityList": entityList,
-			}
-			client.notify(ctx, 8018, err, details)
-		}()
+		details := map[string]string{
+			"entityList": entityList,
+		}
+		client.notify(ctx, 8018, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(38, entityList, maxDegree, buildOutDegree, maxDegree, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 38, entityList, maxDegree, buildOutDegree, maxDegree, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -736,8 +885,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindNetworkByEntityID_V2(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(39, entityList, maxDegree, buildOutDegree, maxDegree, flags)
+		client.traceEntry(ctx, 39, entityList, maxDegree, buildOutDegree, maxDegree, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindNetworkByEntityID_V2Request{
@@ -747,17 +897,15 @@ func (client *G2engine) FindNetworkByEntityID_V2(ctx context.Context, entityList
 		MaxEntities:    int32(maxEntities),
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.FindNetworkByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindNetworkByEntityID_V2", &request, client.GrpcClient.FindNetworkByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityList": entityList,
-			}
-			client.notify(ctx, 8019, err, details)
-		}()
+		details := map[string]string{
+			"entityList": entityList,
+		}
+		client.notify(ctx, 8019, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(40, entityList, maxDegree, buildOutDegree, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 40, entityList, maxDegree, buildOutDegree, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -780,8 +928,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:40:34.285","LAST_SEEN_DT":"2022-12-06 14:40:34.420"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.420"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:40:34.359","LAST_SEEN_DT":"2022-12-06 14:40:34.359"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.359"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":3,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:40:34.424","LAST_SEEN_DT":"2022-12-06 14:40:34.424"}],"LAST_SEEN_DT":"2022-12-06 14:40:34.424"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindNetworkByRecordID(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(41, recordList, maxDegree, buildOutDegree, maxDegree)
+		client.traceEntry(ctx, 41, recordList, maxDegree, buildOutDegree, maxDegree)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindNetworkByRecordIDRequest{
@@ -790,17 +939,15 @@ func (client *G2engine) FindNetworkByRecordID(ctx context.Context, recordList st
 		BuildOutDegree: int32(buildOutDegree),
 		MaxEntities:    int32(maxEntities),
 	}
-	response, err := client.GrpcClient.FindNetworkByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindNetworkByRecordID", &request, client.GrpcClient.FindNetworkByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordList": recordList,
-			}
-			client.notify(ctx, 8020, err, details)
-		}()
+		details := map[string]string{
+			"recordList": recordList,
+		}
+		client.notify(ctx, 8020, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(42, recordList, maxDegree, buildOutDegree, maxDegree, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 42, recordList, maxDegree, buildOutDegree, maxDegree, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -824,8 +971,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindNetworkByRecordID_V2(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(43, recordList, maxDegree, buildOutDegree, maxDegree, flags)
+		client.traceEntry(ctx, 43, recordList, maxDegree, buildOutDegree, maxDegree, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindNetworkByRecordID_V2Request{
@@ -835,17 +983,15 @@ func (client *G2engine) FindNetworkByRecordID_V2(ctx context.Context, recordList
 		MaxEntities:    int32(maxEntities),
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.FindNetworkByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindNetworkByRecordID_V2", &request, client.GrpcClient.FindNetworkByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordList": recordList,
-			}
-			client.notify(ctx, 8021, err, details)
-		}()
+		details := map[string]string{
+			"recordList": recordList,
+		}
+		client.notify(ctx, 8021, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(44, recordList, maxDegree, buildOutDegree, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 44, recordList, maxDegree, buildOutDegree, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -866,8 +1012,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:43:49.024","LAST_SEEN_DT":"2022-12-06 14:43:49.164"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.164"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:43:49.104","LAST_SEEN_DT":"2022-12-06 14:43:49.104"}],"LAST_SEEN_DT":"2022-12-06 14:43:49.104"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(45, entityID1, entityID2, maxDegree)
+		client.traceEntry(ctx, 45, entityID1, entityID2, maxDegree)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathByEntityIDRequest{
@@ -875,18 +1022,16 @@ func (client *G2engine) FindPathByEntityID(ctx context.Context, entityID1 int64,
 		EntityID2: entityID2,
 		MaxDegree: int32(maxDegree),
 	}
-	response, err := client.GrpcClient.FindPathByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathByEntityID", &request, client.GrpcClient.FindPathByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8022, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8022, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(46, entityID1, entityID2, maxDegree, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 46, entityID1, entityID2, maxDegree, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -908,8 +1053,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(47, entityID1, entityID2, maxDegree, flags)
+		client.traceEntry(ctx, 47, entityID1, entityID2, maxDegree, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathByEntityID_V2Request{
@@ -918,18 +1064,16 @@ func (client *G2engine) FindPathByEntityID_V2(ctx context.Context, entityID1 int
 		MaxDegree: int32(maxDegree),
 		Flags:     flags,
 	}
-	response, err := client.GrpcClient.FindPathByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathByEntityID_V2", &request, client.GrpcClient.FindPathByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8023, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8023, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(48, entityID1, entityID2, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 48, entityID1, entityID2, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -954,8 +1098,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:48:19.522","LAST_SEEN_DT":"2022-12-06 14:48:19.667"}],"LAST_SEEN_DT":"2022-12-06 14:48:19.667"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:48:19.593","LAST_SEEN_DT":"2022-12-06 14:48:19.593"}],"LAST_SEEN_DT":"2022-12-06 14:48:19.593"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(49, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
+		client.traceEntry(ctx, 49, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathByRecordIDRequest{
@@ -965,20 +1110,18 @@ func (client *G2engine) FindPathByRecordID(ctx context.Context, dataSourceCode1
 		RecordID2:       recordID2,
 		MaxDegree:       int32(maxDegree),
 	}
-	response, err := client.GrpcClient.FindPathByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathByRecordID", &request, client.GrpcClient.FindPathByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8024, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8024, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(50, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 50, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1003,8 +1146,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(51, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
+		client.traceEntry(ctx, 51, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathByRecordID_V2Request{
@@ -1015,20 +1159,18 @@ func (client *G2engine) FindPathByRecordID_V2(ctx context.Context, dataSourceCod
 		MaxDegree:       int32(maxDegree),
 		Flags:           flags,
 	}
-	response, err := client.GrpcClient.FindPathByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathByRecordID_V2", &request, client.GrpcClient.FindPathByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8025, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8025, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(52, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 52, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1051,8 +1193,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:50:49.222","LAST_SEEN_DT":"2022-12-06 14:50:49.356"}],"LAST_SEEN_DT":"2022-12-06 14:50:49.356"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:50:49.295","LAST_SEEN_DT":"2022-12-06 14:50:49.295"}],"LAST_SEEN_DT":"2022-12-06 14:50:49.295"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathExcludingByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(53, entityID1, entityID2, maxDegree, excludedEntities)
+		client.traceEntry(ctx, 53, entityID1, entityID2, maxDegree, excludedEntities)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathExcludingByEntityIDRequest{
@@ -1061,18 +1204,16 @@ func (client *G2engine) FindPathExcludingByEntityID(ctx context.Context, entityI
 		MaxDegree:        int32(maxDegree),
 		ExcludedEntities: excludedEntities,
 	}
-	response, err := client.GrpcClient.FindPathExcludingByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathExcludingByEntityID", &request, client.GrpcClient.FindPathExcludingByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8026, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8026, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(54, entityID1, entityID2, maxDegree, excludedEntities, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 54, entityID1, entityID2, maxDegree, excludedEntities, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1101,8 +1242,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathExcludingByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(55, entityID1, entityID2, maxDegree, excludedEntities, flags)
+		client.traceEntry(ctx, 55, entityID1, entityID2, maxDegree, excludedEntities, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathExcludingByEntityID_V2Request{
@@ -1112,18 +1254,16 @@ func (client *G2engine) FindPathExcludingByEntityID_V2(ctx context.Context, enti
 		ExcludedEntities: excludedEntities,
 		Flags:            flags,
 	}
-	response, err := client.GrpcClient.FindPathExcludingByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathExcludingByEntityID_V2", &request, client.GrpcClient.FindPathExcludingByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8027, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8027, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(56, entityID1, entityID2, maxDegree, excludedEntities, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 56, entityID1, entityID2, maxDegree, excludedEntities, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1148,8 +1288,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 14:55:02.577","LAST_SEEN_DT":"2022-12-06 14:55:02.711"}],"LAST_SEEN_DT":"2022-12-06 14:55:02.711"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 14:55:02.649","LAST_SEEN_DT":"2022-12-06 14:55:02.649"}],"LAST_SEEN_DT":"2022-12-06 14:55:02.649"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathExcludingByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(57, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
+		client.traceEntry(ctx, 57, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathExcludingByRecordIDRequest{
@@ -1159,20 +1300,18 @@ func (client *G2engine) FindPathExcludingByRecordID(ctx context.Context, dataSou
 		RecordID2:       recordID2,
 		MaxDegree:       int32(maxDegree),
 	}
-	response, err := client.GrpcClient.FindPathExcludingByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathExcludingByRecordID", &request, client.GrpcClient.FindPathExcludingByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8028, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8028, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(58, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 58, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1203,8 +1342,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathExcludingByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(59, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
+		client.traceEntry(ctx, 59, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathExcludingByRecordID_V2Request{
@@ -1215,20 +1355,18 @@ func (client *G2engine) FindPathExcludingByRecordID_V2(ctx context.Context, data
 		MaxDegree:       int32(maxDegree),
 		Flags:           flags,
 	}
-	response, err := client.GrpcClient.FindPathExcludingByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathExcludingByRecordID_V2", &request, client.GrpcClient.FindPathExcludingByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8029, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8029, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(60, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 60, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1253,8 +1391,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:00:30.268","LAST_SEEN_DT":"2022-12-06 15:00:30.429"}],"LAST_SEEN_DT":"2022-12-06 15:00:30.429"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:00:30.339","LAST_SEEN_DT":"2022-12-06 15:00:30.339"}],"LAST_SEEN_DT":"2022-12-06 15:00:30.339"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathIncludingSourceByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(61, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
+		client.traceEntry(ctx, 61, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathIncludingSourceByEntityIDRequest{
@@ -1264,18 +1403,16 @@ func (client *G2engine) FindPathIncludingSourceByEntityID(ctx context.Context, e
 		ExcludedEntities: excludedEntities,
 		RequiredDsrcs:    requiredDsrcs,
 	}
-	response, err := client.GrpcClient.FindPathIncludingSourceByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathIncludingSourceByEntityID", &request, client.GrpcClient.FindPathIncludingSourceByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8030, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8030, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(62, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 62, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1301,8 +1438,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathIncludingSourceByEntityID_V2(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, excludedEntities string, requiredDsrcs string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(63, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
+		client.traceEntry(ctx, 63, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathIncludingSourceByEntityID_V2Request{
@@ -1313,18 +1451,16 @@ func (client *G2engine) FindPathIncludingSourceByEntityID_V2(ctx context.Context
 		RequiredDsrcs:    requiredDsrcs,
 		Flags:            flags,
 	}
-	response, err := client.GrpcClient.FindPathIncludingSourceByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathIncludingSourceByEntityID_V2", &request, client.GrpcClient.FindPathIncludingSourceByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8031, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8031, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(64, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 64, entityID1, entityID2, maxDegree, excludedEntities, requiredDsrcs, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1351,8 +1487,9 @@ Output
     Example: `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[]}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:03:52.805","LAST_SEEN_DT":"2022-12-06 15:03:52.947"}],"LAST_SEEN_DT":"2022-12-06 15:03:52.947"},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:03:52.876","LAST_SEEN_DT":"2022-12-06 15:03:52.876"}],"LAST_SEEN_DT":"2022-12-06 15:03:52.876"},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0}]}]}`
 */
 func (client *G2engine) FindPathIncludingSourceByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(65, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
+		client.traceEntry(ctx, 65, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathIncludingSourceByRecordIDRequest{
@@ -1364,20 +1501,18 @@ func (client *G2engine) FindPathIncludingSourceByRecordID(ctx context.Context, d
 		ExcludedRecords: excludedRecords,
 		RequiredDsrcs:   requiredDsrcs,
 	}
-	response, err := client.GrpcClient.FindPathIncludingSourceByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathIncludingSourceByRecordID", &request, client.GrpcClient.FindPathIncludingSourceByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8032, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8032, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(66, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 66, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1405,8 +1540,9 @@ Output
     See the example output.
 */
 func (client *G2engine) FindPathIncludingSourceByRecordID_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, excludedRecords string, requiredDsrcs string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(67, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
+		client.traceEntry(ctx, 67, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.FindPathIncludingSourceByRecordID_V2Request{
@@ -1419,24 +1555,39 @@ func (client *G2engine) FindPathIncludingSourceByRecordID_V2(ctx context.Context
 		RequiredDsrcs:   requiredDsrcs,
 		Flags:           flags,
 	}
-	response, err := client.GrpcClient.FindPathIncludingSourceByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "FindPathIncludingSourceByRecordID_V2", &request, client.GrpcClient.FindPathIncludingSourceByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8033, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8033, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(68, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 68, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, excludedRecords, requiredDsrcs, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
 
+/*
+The FlushObservers method waits until the messages to observers queued before the call are delivered,
+e.g. before the process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (client *G2engine) FlushObservers(ctx context.Context) error {
+	if client.observers == nil {
+		return nil
+	}
+	return client.observers.Flush(ctx)
+}
+
 /*
 The GetActiveConfigID method returns the identifier of the loaded Senzing engine configuration.
 
@@ -1447,20 +1598,19 @@ Output
   - The identifier of the active Senzing Engine configuration.
 */
 func (client *G2engine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(69)
+		client.traceEntry(ctx, 69)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetActiveConfigIDRequest{}
-	response, err := client.GrpcClient.GetActiveConfigID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetActiveConfigID", &request, client.GrpcClient.GetActiveConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8034, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8034, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(70, int64(response.GetResult()), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 70, int64(response.GetResult()), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1479,24 +1629,23 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:09:48.577","LAST_SEEN_DT":"2022-12-06 15:09:48.705"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.705","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:09:48.577"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+EXACTLY_SAME","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:09:48.705"}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:09:48.647","LAST_SEEN_DT":"2022-12-06 15:09:48.647"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.647"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:09:48.709","LAST_SEEN_DT":"2022-12-06 15:09:48.709"}],"LAST_SEEN_DT":"2022-12-06 15:09:48.709"}]}`
 */
 func (client *G2engine) GetEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(71, entityID)
+		client.traceEntry(ctx, 71, entityID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityByEntityIDRequest{
 		EntityID: entityID,
 	}
-	response, err := client.GrpcClient.GetEntityByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityByEntityID", &request, client.GrpcClient.GetEntityByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8035, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8035, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(72, entityID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 72, entityID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1515,25 +1664,24 @@ Output
     See the example output.
 */
 func (client *G2engine) GetEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(73, entityID, flags)
+		client.traceEntry(ctx, 73, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityByEntityID_V2Request{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.GetEntityByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityByEntityID_V2", &request, client.GrpcClient.GetEntityByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8036, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8036, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(74, entityID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 74, entityID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1552,26 +1700,25 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:12:25.464","LAST_SEEN_DT":"2022-12-06 15:12:25.597"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.597","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:12:25.464"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+EXACTLY_SAME","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:12:25.597"}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:12:25.536","LAST_SEEN_DT":"2022-12-06 15:12:25.536"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.536"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:12:25.603","LAST_SEEN_DT":"2022-12-06 15:12:25.603"}],"LAST_SEEN_DT":"2022-12-06 15:12:25.603"}]}`
 */
 func (client *G2engine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(75, dataSourceCode, recordID)
+		client.traceEntry(ctx, 75, dataSourceCode, recordID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityByRecordIDRequest{
 		DataSourceCode: dataSourceCode,
 		RecordID:       recordID,
 	}
-	response, err := client.GrpcClient.GetEntityByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityByRecordID", &request, client.GrpcClient.GetEntityByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8037, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8037, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(76, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 76, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1591,8 +1738,9 @@ Output
     See the example output.
 */
 func (client *G2engine) GetEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(77, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 77, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetEntityByRecordID_V2Request{
@@ -1600,18 +1748,16 @@ func (client *G2engine) GetEntityByRecordID_V2(ctx context.Context, dataSourceCo
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.GetEntityByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetEntityByRecordID_V2", &request, client.GrpcClient.GetEntityByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8038, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8038, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(78, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 78, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1630,26 +1776,25 @@ Output
     See the example output.
 */
 func (client *G2engine) GetRecord(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(83, dataSourceCode, recordID)
+		client.traceEntry(ctx, 83, dataSourceCode, recordID)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetRecordRequest{
 		DataSourceCode: dataSourceCode,
 		RecordID:       recordID,
 	}
-	response, err := client.GrpcClient.GetRecord(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetRecord", &request, client.GrpcClient.GetRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8039, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8039, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(84, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 84, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1669,8 +1814,9 @@ Output
     See the example output.
 */
 func (client *G2engine) GetRecord_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(85, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 85, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetRecord_V2Request{
@@ -1678,18 +1824,16 @@ func (client *G2engine) GetRecord_V2(ctx context.Context, dataSourceCode string,
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.GetRecord_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetRecord_V2", &request, client.GrpcClient.GetRecord_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8040, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8040, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(86, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 86, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1706,20 +1850,19 @@ Output
   - A JSON document.
 */
 func (client *G2engine) GetRedoRecord(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(87)
+		client.traceEntry(ctx, 87)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetRedoRecordRequest{}
-	response, err := client.GrpcClient.GetRedoRecord(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetRedoRecord", &request, client.GrpcClient.GetRedoRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8041, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8041, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(88, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 88, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1735,20 +1878,19 @@ Output
   - A Unix Timestamp.
 */
 func (client *G2engine) GetRepositoryLastModifiedTime(ctx context.Context) (int64, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(89)
+		client.traceEntry(ctx, 89)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetRepositoryLastModifiedTimeRequest{}
-	response, err := client.GrpcClient.GetRepositoryLastModifiedTime(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetRepositoryLastModifiedTime", &request, client.GrpcClient.GetRepositoryLastModifiedTime)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8042, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8042, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(90, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 90, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1762,19 +1904,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) GetSdkId(ctx context.Context) string {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(161)
+		client.traceEntry(ctx, 161)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8075, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8075, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(162, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 162, err, time.Since(entryTime))
 	}
 	return "grpc"
 }
@@ -1792,24 +1933,23 @@ Output
     Example: `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":2,"FIRST_SEEN_DT":"2022-12-06 15:20:17.088","LAST_SEEN_DT":"2022-12-06 15:20:17.161"}],"LAST_SEEN_DT":"2022-12-06 15:20:17.161","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","LAST_SEEN_DT":"2022-12-06 15:20:17.088","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","LAST_SEEN_DT":"2022-12-06 15:20:17.161","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]}}`
 */
 func (client *G2engine) GetVirtualEntityByRecordID(ctx context.Context, recordList string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(91, recordList)
+		client.traceEntry(ctx, 91, recordList)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetVirtualEntityByRecordIDRequest{
 		RecordList: recordList,
 	}
-	response, err := client.GrpcClient.GetVirtualEntityByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetVirtualEntityByRecordID", &request, client.GrpcClient.GetVirtualEntityByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordList": recordList,
-			}
-			client.notify(ctx, 8043, err, details)
-		}()
+		details := map[string]string{
+			"recordList": recordList,
+		}
+		client.notify(ctx, 8043, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(92, recordList, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 92, recordList, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1829,25 +1969,24 @@ Output
     See the example output.
 */
 func (client *G2engine) GetVirtualEntityByRecordID_V2(ctx context.Context, recordList string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(93, recordList, flags)
+		client.traceEntry(ctx, 93, recordList, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.GetVirtualEntityByRecordID_V2Request{
 		RecordList: recordList,
 		Flags:      flags,
 	}
-	response, err := client.GrpcClient.GetVirtualEntityByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "GetVirtualEntityByRecordID_V2", &request, client.GrpcClient.GetVirtualEntityByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"recordList": recordList,
-			}
-			client.notify(ctx, 8044, err, details)
-		}()
+		details := map[string]string{
+			"recordList": recordList,
+		}
+		client.notify(ctx, 8044, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(94, recordList, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 94, recordList, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1865,24 +2004,23 @@ Output
     See the example output.
 */
 func (client *G2engine) HowEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(95, entityID)
+		client.traceEntry(ctx, 95, entityID)
 	}
 	entryTime := time.Now()
 	request := g2pb.HowEntityByEntityIDRequest{
 		EntityID: entityID,
 	}
-	response, err := client.GrpcClient.HowEntityByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "HowEntityByEntityID", &request, client.GrpcClient.HowEntityByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8045, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8045, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(96, entityID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 96, entityID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1901,25 +2039,24 @@ Output
     See the example output.
 */
 func (client *G2engine) HowEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(97, entityID, flags)
+		client.traceEntry(ctx, 97, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.HowEntityByEntityID_V2Request{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.HowEntityByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "HowEntityByEntityID_V2", &request, client.GrpcClient.HowEntityByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8046, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8046, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(98, entityID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 98, entityID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -1935,8 +2072,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2engine) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(99, moduleName, iniParams, verboseLogging)
+		client.traceEntry(ctx, 99, moduleName, iniParams, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitRequest{
@@ -1944,19 +2082,17 @@ func (client *G2engine) Init(ctx context.Context, moduleName string, iniParams s
 		IniParams:      iniParams,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.Init(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Init", &request, client.GrpcClient.Init)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8047, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8047, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(100, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 100, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -1973,8 +2109,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2engine) InitWithConfigID(ctx context.Context, moduleName string, iniParams string, initConfigID int64, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(101, moduleName, iniParams, initConfigID, verboseLogging)
+		client.traceEntry(ctx, 101, moduleName, iniParams, initConfigID, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitWithConfigIDRequest{
@@ -1983,24 +2120,29 @@ func (client *G2engine) InitWithConfigID(ctx context.Context, moduleName string,
 		InitConfigID:   initConfigID,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.InitWithConfigID(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "InitWithConfigID", &request, client.GrpcClient.InitWithConfigID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"initConfigID":   strconv.FormatInt(initConfigID, 10),
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8048, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"initConfigID":   strconv.FormatInt(initConfigID, 10),
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8048, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(102, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 102, moduleName, iniParams, initConfigID, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The OutstandingHandles method returns the export handles the client created with ExportCSVEntityReport() or ExportJSONEntityReport() and not yet closed, oldest first.
+*/
+func (client *G2engine) OutstandingHandles() []g2handle.Handle {
+	return client.getHandles().Outstanding(client, g2handle.ExportHandle)
+}
+
 /*
 The PrimeEngine method pre-initializes some of the heavier weight internal resources of the G2 engine.
 The G2 Engine uses "lazy initialization".
@@ -2010,20 +2152,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) PrimeEngine(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(103)
+		client.traceEntry(ctx, 103)
 	}
 	entryTime := time.Now()
 	request := g2pb.PrimeEngineRequest{}
-	_, err := client.GrpcClient.PrimeEngine(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "PrimeEngine", &request, client.GrpcClient.PrimeEngine)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8049, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8049, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(104, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 104, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2036,22 +2177,21 @@ Input
   - record: A JSON document containing the record to be added to the Senzing repository.
 */
 func (client *G2engine) Process(ctx context.Context, record string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(105, record)
+		client.traceEntry(ctx, 105, record)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessRequest{
 		Record: record,
 	}
-	_, err := client.GrpcClient.Process(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Process", &request, client.GrpcClient.Process)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8050, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8050, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(106, record, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 106, record, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2067,20 +2207,19 @@ Output
   - A JSON document.
 */
 func (client *G2engine) ProcessRedoRecord(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(107)
+		client.traceEntry(ctx, 107)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessRedoRecordRequest{}
-	response, err := client.GrpcClient.ProcessRedoRecord(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ProcessRedoRecord", &request, client.GrpcClient.ProcessRedoRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8051, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8051, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(108, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 108, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2098,22 +2237,21 @@ Output
   - A JSON document with affected entities.
 */
 func (client *G2engine) ProcessRedoRecordWithInfo(ctx context.Context, flags int64) (string, string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(109, flags)
+		client.traceEntry(ctx, 109, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessRedoRecordWithInfoRequest{
 		Flags: flags,
 	}
-	response, err := client.GrpcClient.ProcessRedoRecordWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ProcessRedoRecordWithInfo", &request, client.GrpcClient.ProcessRedoRecordWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8052, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8052, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(110, flags, response.GetResult(), response.GetWithInfo(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 110, flags, response.GetResult(), response.GetWithInfo(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), response.GetWithInfo(), err
 }
@@ -2131,23 +2269,22 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithInfo(ctx context.Context, record string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(111, record, flags)
+		client.traceEntry(ctx, 111, record, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessWithInfoRequest{
 		Record: record,
 		Flags:  flags,
 	}
-	response, err := client.GrpcClient.ProcessWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ProcessWithInfo", &request, client.GrpcClient.ProcessWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8053, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8053, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(112, record, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 112, record, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2164,22 +2301,21 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithResponse(ctx context.Context, record string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(113, record)
+		client.traceEntry(ctx, 113, record)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessWithResponseRequest{
 		Record: record,
 	}
-	response, err := client.GrpcClient.ProcessWithResponse(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ProcessWithResponse", &request, client.GrpcClient.ProcessWithResponse)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8054, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8054, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(114, record, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 114, record, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2196,22 +2332,21 @@ Output
     See the example output.
 */
 func (client *G2engine) ProcessWithResponseResize(ctx context.Context, record string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(115, record)
+		client.traceEntry(ctx, 115, record)
 	}
 	entryTime := time.Now()
 	request := g2pb.ProcessWithResponseResizeRequest{
 		Record: record,
 	}
-	response, err := client.GrpcClient.ProcessWithResponseResize(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ProcessWithResponseResize", &request, client.GrpcClient.ProcessWithResponseResize)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8055, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8055, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(116, record, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 116, record, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2227,20 +2362,19 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2engine) PurgeRepository(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(117)
+		client.traceEntry(ctx, 117)
 	}
 	entryTime := time.Now()
 	request := g2pb.PurgeRepositoryRequest{}
-	_, err := client.GrpcClient.PurgeRepository(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "PurgeRepository", &request, client.GrpcClient.PurgeRepository)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8056, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8056, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(118, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 118, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2254,25 +2388,24 @@ Input
   - flags: Flags used to control information returned.
 */
 func (client *G2engine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(119, entityID, flags)
+		client.traceEntry(ctx, 119, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReevaluateEntityRequest{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	_, err := client.GrpcClient.ReevaluateEntity(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "ReevaluateEntity", &request, client.GrpcClient.ReevaluateEntity)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8057, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8057, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(120, entityID, flags, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 120, entityID, flags, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2291,25 +2424,24 @@ Output
     See the example output.
 */
 func (client *G2engine) ReevaluateEntityWithInfo(ctx context.Context, entityID int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(121, entityID, flags)
+		client.traceEntry(ctx, 121, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReevaluateEntityWithInfoRequest{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.ReevaluateEntityWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ReevaluateEntityWithInfo", &request, client.GrpcClient.ReevaluateEntityWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8058, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8058, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(122, entityID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 122, entityID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2324,8 +2456,9 @@ Input
   - flags: Flags used to control information returned.
 */
 func (client *G2engine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(123, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 123, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReevaluateRecordRequest{
@@ -2333,18 +2466,16 @@ func (client *G2engine) ReevaluateRecord(ctx context.Context, dataSourceCode str
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	_, err := client.GrpcClient.ReevaluateRecord(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "ReevaluateRecord", &request, client.GrpcClient.ReevaluateRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8059, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8059, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(124, dataSourceCode, recordID, flags, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 124, dataSourceCode, recordID, flags, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2364,8 +2495,9 @@ Output
     See the example output.
 */
 func (client *G2engine) ReevaluateRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(125, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 125, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReevaluateRecordWithInfoRequest{
@@ -2373,18 +2505,16 @@ func (client *G2engine) ReevaluateRecordWithInfo(ctx context.Context, dataSource
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.ReevaluateRecordWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ReevaluateRecordWithInfo", &request, client.GrpcClient.ReevaluateRecordWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8060, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8060, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(126, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 126, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2397,24 +2527,23 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2engine) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(157, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 157, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
+		client.observers = g2observer.NewQueue()
 	}
 	err := client.observers.RegisterObserver(ctx, observer)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverId(ctx),
-			}
-			client.notify(ctx, 8076, err, details)
-		}()
+		details := map[string]string{
+			"observerID": observer.GetObserverId(ctx),
+		}
+		client.notify(ctx, 8076, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(158, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 158, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2427,24 +2556,23 @@ Input
   - initConfigID: The configuration ID used for the initialization.
 */
 func (client *G2engine) Reinit(ctx context.Context, initConfigID int64) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(127, initConfigID)
+		client.traceEntry(ctx, 127, initConfigID)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReinitRequest{
 		InitConfigID: initConfigID,
 	}
-	_, err := client.GrpcClient.Reinit(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Reinit", &request, client.GrpcClient.Reinit)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"initConfigID": strconv.FormatInt(initConfigID, 10),
-			}
-			client.notify(ctx, 8061, err, details)
-		}()
+		details := map[string]string{
+			"initConfigID": strconv.FormatInt(initConfigID, 10),
+		}
+		client.notify(ctx, 8061, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(128, initConfigID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 128, initConfigID, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2461,8 +2589,9 @@ Input
   - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
 */
 func (client *G2engine) ReplaceRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(129, dataSourceCode, recordID, jsonData, loadID)
+		client.traceEntry(ctx, 129, dataSourceCode, recordID, jsonData, loadID)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReplaceRecordRequest{
@@ -2471,19 +2600,17 @@ func (client *G2engine) ReplaceRecord(ctx context.Context, dataSourceCode string
 		JsonData:       jsonData,
 		LoadID:         loadID,
 	}
-	_, err := client.GrpcClient.ReplaceRecord(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "ReplaceRecord", &request, client.GrpcClient.ReplaceRecord)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8062, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8062, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(130, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 130, dataSourceCode, recordID, jsonData, loadID, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2505,8 +2632,9 @@ Output
     See the example output.
 */
 func (client *G2engine) ReplaceRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(131, dataSourceCode, recordID, jsonData, loadID, flags)
+		client.traceEntry(ctx, 131, dataSourceCode, recordID, jsonData, loadID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.ReplaceRecordWithInfoRequest{
@@ -2516,19 +2644,17 @@ func (client *G2engine) ReplaceRecordWithInfo(ctx context.Context, dataSourceCod
 		LoadID:         loadID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.ReplaceRecordWithInfo(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ReplaceRecordWithInfo", &request, client.GrpcClient.ReplaceRecordWithInfo)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-				"loadID":         loadID,
-			}
-			client.notify(ctx, 8063, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+			"loadID":         loadID,
+		}
+		client.notify(ctx, 8063, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(132, dataSourceCode, recordID, jsonData, loadID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 132, dataSourceCode, recordID, jsonData, loadID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2546,22 +2672,21 @@ Output
     Example: `{"RESOLVED_ENTITIES":[{"MATCH_INFO":{"MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+SSN","ERRULE_CODE":"SF1_PNAME_CSTAB","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"JOHNSON","CANDIDATE_FEAT":"JOHNSON","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1}],"SSN":[{"INBOUND_FEAT":"053-39-3251","CANDIDATE_FEAT":"053-39-3251","FULL_SCORE":100}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:38:06.175","LAST_SEEN_DT":"2022-12-06 15:38:06.957"}],"LAST_SEEN_DT":"2022-12-06 15:38:06.957"}}}]}`
 */
 func (client *G2engine) SearchByAttributes(ctx context.Context, jsonData string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(133, jsonData)
+		client.traceEntry(ctx, 133, jsonData)
 	}
 	entryTime := time.Now()
 	request := g2pb.SearchByAttributesRequest{
 		JsonData: jsonData,
 	}
-	response, err := client.GrpcClient.SearchByAttributes(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "SearchByAttributes", &request, client.GrpcClient.SearchByAttributes)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8064, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8064, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(134, jsonData, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 134, jsonData, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2580,27 +2705,51 @@ Output
     See the example output.
 */
 func (client *G2engine) SearchByAttributes_V2(ctx context.Context, jsonData string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(135, jsonData, flags)
+		client.traceEntry(ctx, 135, jsonData, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.SearchByAttributes_V2Request{
 		JsonData: jsonData,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.SearchByAttributes_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "SearchByAttributes_V2", &request, client.GrpcClient.SearchByAttributes_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8065, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8065, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(136, jsonData, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 136, jsonData, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
 
+/*
+The SetDeadlines method sets the default deadlines of calls whose context has none.
+Without it, the client uses g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts;
+use g2deadline.NoTimeout in Deadlines to opt a method out.
+
+Input
+  - deadlines: The default timeout of each method.
+*/
+func (client *G2engine) SetDeadlines(deadlines *g2deadline.Deadlines) {
+	client.deadlines = deadlines
+}
+
+/*
+The SetHandleTracker method sets the Tracker recording the handles the client creates.
+Without it, the client uses a Tracker of its own, created by g2handle.NewTracker().
+Call it before the client creates a handle.
+
+Input
+  - tracker: The Tracker, which may be shared with other clients.
+*/
+func (client *G2engine) SetHandleTracker(tracker *g2handle.Tracker) {
+	client.handlesOnce.Do(func() {})
+	client.handles = tracker
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -2609,27 +2758,44 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2engine) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(137, logLevel)
+		client.traceEntry(ctx, 137, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
 	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevel": logger.LevelToTextMap[logLevel],
-			}
-			client.notify(ctx, 8077, err, details)
-		}()
+		details := map[string]string{
+			"logLevel": logger.LevelToTextMap[logLevel],
+		}
+		client.notify(ctx, 8077, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(138, logLevel, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 138, logLevel, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetObserverQueue method sets the Queue delivering the messages of the client to its observers.
+The observers already registered are registered on it.
+Without it, the client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - queue: The Queue, usually created by g2observer.NewQueue().
+*/
+func (client *G2engine) SetObserverQueue(queue *g2observer.Queue) {
+	if client.observers != nil {
+		ctx := context.Background()
+		for _, observer := range client.observers.GetObservers(ctx) {
+			_ = queue.RegisterObserver(ctx, observer)
+		}
+	}
+	client.observers = queue
+}
+
 /*
 The Stats method retrieves workload statistics for the current process.
 These statistics will automatically reset after retrieval.
@@ -2642,20 +2808,19 @@ Output
     Example: `{"workload":{"loadedRecords":5,"addedRecords":2,"deletedRecords":0,"reevaluations":0,"repairedEntities":0,"duration":56,"retries":0,"candidates":19,"actualAmbiguousTest":0,"cachedAmbiguousTest":0,"libFeatCacheHit":219,"libFeatCacheMiss":73,"unresolveTest":1,"abortedUnresolve":0,"gnrScorersUsed":1,"unresolveTriggers":{"normalResolve":0,"update":0,"relLink":0,"extensiveResolve":0,"ambiguousNoResolve":1,"ambiguousMultiResolve":0},"reresolveTriggers":{"abortRetry":0,"unresolveMovement":0,"multipleResolvableCandidates":0,"resolveNewFeatures":1,"newFeatureFTypes":[{"DOB":1}]},"reresolveSkipped":0,"filteredObsFeat":0,"expressedFeatureCalls":[{"EFCALL_ID":1,"EFUNC_CODE":"PHONE_HASHER","numCalls":1},{"EFCALL_ID":2,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":3,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":5,"EFUNC_CODE":"EXPRESS_BOM","numCalls":1},{"EFCALL_ID":7,"EFUNC_CODE":"NAME_HASHER","numCalls":4},{"EFCALL_ID":9,"EFUNC_CODE":"ADDR_HASHER","numCalls":1},{"EFCALL_ID":10,"EFUNC_CODE":"EXPRESS_BOM","numCalls":1},{"EFCALL_ID":14,"EFUNC_CODE":"EXPRESS_ID","numCalls":1},{"EFCALL_ID":16,"EFUNC_CODE":"EXPRESS_ID","numCalls":4}],"expressedFeaturesCreated":[{"ADDR_KEY":2},{"ID_KEY":7},{"NAME_KEY":14},{"PHONE_KEY":1},{"SEARCH_KEY":2}],"scoredPairs":[{"ACCT_NUM":16},{"ADDRESS":16},{"DOB":25},{"GENDER":16},{"LOGIN_ID":16},{"NAME":19},{"PHONE":16},{"SSN":19}],"cacheHit":[{"ADDRESS":12},{"DOB":18},{"NAME":13},{"PHONE":15}],"cacheMiss":[{"ADDRESS":4},{"DOB":7},{"NAME":6},{"PHONE":1}],"redoTriggers":[],"latchContention":[],"highContentionFeat":[],"highContentionResEnt":[],"genericDetect":[],"candidateBuilders":[{"ACCT_NUM":7},{"ADDR_KEY":7},{"DOB":7},{"ID_KEY":9},{"LOGIN_ID":7},{"NAME_KEY":9},{"PHONE":7},{"PHONE_KEY":7},{"SEARCH_KEY":7},{"SSN":9}],"suppressedCandidateBuilders":[],"suppressedScoredFeatureType":[],"reducedScoredFeatureType":[],"suppressedDisclosedRelationshipDomainCount":0,"CorruptEntityTestDiagnosis":{},"threadState":{"active":0,"idle":4,"sqlExecuting":0,"loader":0,"resolver":0,"scoring":0,"dataLatchContention":0,"obsEntContention":0,"resEntContention":0},"systemResources":{"initResources":[{"physicalCores":16},{"logicalCores":16},{"totalMemory":"62.6GB"},{"availableMemory":"49.5GB"}],"currResources":[{"availableMemory":"47.4GB"},{"activeThreads":0},{"workerThreads":4},{"systemLoad":[{"cpuUser":13.442277},{"cpuSystem":2.635741},{"cpuIdle":82.024246},{"cpuWait":1.634159},{"cpuSoftIrq":0.263574}]}]}}}`
 */
 func (client *G2engine) Stats(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(139)
+		client.traceEntry(ctx, 139)
 	}
 	entryTime := time.Now()
 	request := g2pb.StatsRequest{}
-	response, err := client.GrpcClient.Stats(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "Stats", &request, client.GrpcClient.Stats)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8066, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8066, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(140, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 140, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2668,27 +2833,22 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2engine) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(159, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 159, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// The message is queued before the observer is removed, so the observer gets it.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8078, err, details)
-	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+		err = client.observers.UnregisterObserver(ctx, observer)
 	}
 	if client.isTrace {
-		defer client.traceExit(160, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 160, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -2710,26 +2870,25 @@ Output
     Example: `{"WHY_RESULTS":[{"ENTITY_ID":1,"ENTITY_ID_2":2,"MATCH_INFO":{"WHY_KEY":"+PHONE+ACCT_NUM-SSN","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"POSSIBLY_RELATED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}]},"DISCLOSED_RELATIONS":{},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":26,"CANDIDATE_FEAT":"772 Armstrong RD Delhi WI 53543","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":81,"SCORE_BUCKET":"LIKELY","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":79,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"FMES"},{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":86,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":28,"CANDIDATE_FEAT":"flavorh2","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":24,"CANDIDATE_FEAT":"OCEANGUY","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":33,"GNR_SN":32,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":27,"CANDIDATE_FEAT":"153-33-5185","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:58:57.129","LAST_SEEN_DT":"2022-12-06 15:58:57.906"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.906","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:58:57.906","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.400","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.404","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.407","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.410","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 15:58:57.259","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.201","LAST_SEEN_DT":"2022-12-06 15:58:57.201"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.201"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.263","LAST_SEEN_DT":"2022-12-06 15:58:57.263"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.263"}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.201","LAST_SEEN_DT":"2022-12-06 15:58:57.201"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.201","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 15:58:57.201","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 15:58:57.129","LAST_SEEN_DT":"2022-12-06 15:58:57.906"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.906"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 15:58:57.263","LAST_SEEN_DT":"2022-12-06 15:58:57.263"}],"LAST_SEEN_DT":"2022-12-06 15:58:57.263"}]}]}`
 */
 func (client *G2engine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(141, entityID1, entityID2)
+		client.traceEntry(ctx, 141, entityID1, entityID2)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntitiesRequest{
 		EntityID1: entityID1,
 		EntityID2: entityID2,
 	}
-	response, err := client.GrpcClient.WhyEntities(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntities", &request, client.GrpcClient.WhyEntities)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8067, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8067, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(142, entityID1, entityID2, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 142, entityID1, entityID2, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2752,8 +2911,9 @@ Output
     See the example output.
 */
 func (client *G2engine) WhyEntities_V2(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(143, entityID1, entityID2, flags)
+		client.traceEntry(ctx, 143, entityID1, entityID2, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntities_V2Request{
@@ -2761,18 +2921,16 @@ func (client *G2engine) WhyEntities_V2(ctx context.Context, entityID1 int64, ent
 		EntityID2: entityID2,
 		Flags:     flags,
 	}
-	response, err := client.GrpcClient.WhyEntities_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntities_V2", &request, client.GrpcClient.WhyEntities_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID1": strconv.FormatInt(entityID1, 10),
-				"entityID2": strconv.FormatInt(entityID2, 10),
-			}
-			client.notify(ctx, 8068, err, details)
-		}()
+		details := map[string]string{
+			"entityID1": strconv.FormatInt(entityID1, 10),
+			"entityID2": strconv.FormatInt(entityID2, 10),
+		}
+		client.notify(ctx, 8068, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(144, entityID1, entityID2, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 144, entityID1, entityID2, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2791,24 +2949,23 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":100001,"CANDIDATE_FEAT":"4/8/1985","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":2,"CANDIDATE_FEAT":"4/8/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:02:35.306","LAST_SEEN_DT":"2022-12-06 16:02:36.083"}],"LAST_SEEN_DT":"2022-12-06 16:02:36.083","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:02:36.083","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.572","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.575","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.579","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.582","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:02:35.432","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:02:35.373","LAST_SEEN_DT":"2022-12-06 16:02:35.373"}],"LAST_SEEN_DT":"2022-12-06 16:02:35.373"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:02:35.436","LAST_SEEN_DT":"2022-12-06 16:02:35.436"}],"LAST_SEEN_DT":"2022-12-06 16:02:35.436"}]}]}`
 */
 func (client *G2engine) WhyEntityByEntityID(ctx context.Context, entityID int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(145, entityID)
+		client.traceEntry(ctx, 145, entityID)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntityByEntityIDRequest{
 		EntityID: entityID,
 	}
-	response, err := client.GrpcClient.WhyEntityByEntityID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntityByEntityID", &request, client.GrpcClient.WhyEntityByEntityID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8069, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8069, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(146, entityID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 146, entityID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2827,25 +2984,24 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1}}]}`
 */
 func (client *G2engine) WhyEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(147, entityID, flags)
+		client.traceEntry(ctx, 147, entityID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntityByEntityID_V2Request{
 		EntityID: entityID,
 		Flags:    flags,
 	}
-	response, err := client.GrpcClient.WhyEntityByEntityID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntityByEntityID_V2", &request, client.GrpcClient.WhyEntityByEntityID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"entityID": strconv.FormatInt(entityID, 10),
-			}
-			client.notify(ctx, 8070, err, details)
-		}()
+		details := map[string]string{
+			"entityID": strconv.FormatInt(entityID, 10),
+		}
+		client.notify(ctx, 8070, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(148, entityID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 148, entityID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2864,26 +3020,25 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":2,"INBOUND_FEAT":"4/8/1983","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":100001,"CANDIDATE_FEAT":"4/8/1985","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"},{"FEAT_ID":18,"FEAT_DESC":"772|ARMSTRNK||71232"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"},{"FEAT_ID":20,"FEAT_DESC":"SSN=053-39-3251"}],"LOGIN_ID":[{"FEAT_ID":7,"FEAT_DESC":"flavorh"}],"NAME_KEY":[{"FEAT_ID":9,"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804"},{"FEAT_ID":11,"FEAT_DESC":"JNSN"},{"FEAT_ID":12,"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL"},{"FEAT_ID":13,"FEAT_DESC":"JNSN|DOB=80804"},{"FEAT_ID":14,"FEAT_DESC":"JNSN|POST=71232"},{"FEAT_ID":15,"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796"},{"FEAT_ID":16,"FEAT_DESC":"JNSN|SSN=3251"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}],"SEARCH_KEY":[{"FEAT_ID":22,"FEAT_DESC":"LOGIN_ID:FLAVORH|"},{"FEAT_ID":23,"FEAT_DESC":"SSN:3251|80804|"}],"SSN":[{"FEAT_ID":6,"FEAT_DESC":"053-39-3251"}]},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":4,"CANDIDATE_FEAT":"772 Armstrong RD Delhi LA 71232","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":2,"CANDIDATE_FEAT":"4/8/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":88,"SCORE_BUCKET":"PLAUSIBLE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":7,"CANDIDATE_FEAT":"flavorh","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":1,"CANDIDATE_FEAT":"JOHNSON","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":100,"GNR_SN":100,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":6,"CANDIDATE_FEAT":"053-39-3251","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:09:05.626","LAST_SEEN_DT":"2022-12-06 16:09:06.399"}],"LAST_SEEN_DT":"2022-12-06 16:09:06.399","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:09:06.399","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.954","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.957","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.960","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.963","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:09:05.789","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:09:05.724","LAST_SEEN_DT":"2022-12-06 16:09:05.724"}],"LAST_SEEN_DT":"2022-12-06 16:09:05.724"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:09:05.794","LAST_SEEN_DT":"2022-12-06 16:09:05.794"}],"LAST_SEEN_DT":"2022-12-06 16:09:05.794"}]}]}`
 */
 func (client *G2engine) WhyEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(149, dataSourceCode, recordID)
+		client.traceEntry(ctx, 149, dataSourceCode, recordID)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntityByRecordIDRequest{
 		DataSourceCode: dataSourceCode,
 		RecordID:       recordID,
 	}
-	response, err := client.GrpcClient.WhyEntityByRecordID(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntityByRecordID", &request, client.GrpcClient.WhyEntityByRecordID)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8071, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8071, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(150, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 150, dataSourceCode, recordID, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2903,8 +3058,9 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":1,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"444"},{"DATA_SOURCE":"TEST","RECORD_ID":"555"},{"DATA_SOURCE":"TEST","RECORD_ID":"666"},{"DATA_SOURCE":"TEST","RECORD_ID":"777"},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}},{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"MATCH_INFO":{"WHY_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","WHY_ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","MATCH_LEVEL_CODE":"RESOLVED"}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1}}]}`
 */
 func (client *G2engine) WhyEntityByRecordID_V2(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(151, dataSourceCode, recordID, flags)
+		client.traceEntry(ctx, 151, dataSourceCode, recordID, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyEntityByRecordID_V2Request{
@@ -2912,18 +3068,16 @@ func (client *G2engine) WhyEntityByRecordID_V2(ctx context.Context, dataSourceCo
 		RecordID:       recordID,
 		Flags:          flags,
 	}
-	response, err := client.GrpcClient.WhyEntityByRecordID_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyEntityByRecordID_V2", &request, client.GrpcClient.WhyEntityByRecordID_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode": dataSourceCode,
-				"recordID":       recordID,
-			}
-			client.notify(ctx, 8072, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode": dataSourceCode,
+			"recordID":       recordID,
+		}
+		client.notify(ctx, 8072, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(152, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 152, dataSourceCode, recordID, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2945,8 +3099,9 @@ Output
     Example: `{"WHY_RESULTS":[{"INTERNAL_ID":100001,"ENTITY_ID":1,"FOCUS_RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111"}],"INTERNAL_ID_2":2,"ENTITY_ID_2":2,"FOCUS_RECORDS_2":[{"DATA_SOURCE":"TEST","RECORD_ID":"222"}],"MATCH_INFO":{"WHY_KEY":"+PHONE+ACCT_NUM-DOB-SSN","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"POSSIBLY_RELATED","CANDIDATE_KEYS":{"ACCT_NUM":[{"FEAT_ID":8,"FEAT_DESC":"5534202208773608"}],"ADDR_KEY":[{"FEAT_ID":17,"FEAT_DESC":"772|ARMSTRNK||TL"}],"ID_KEY":[{"FEAT_ID":19,"FEAT_DESC":"ACCT_NUM=5534202208773608"}],"PHONE":[{"FEAT_ID":5,"FEAT_DESC":"225-671-0796"}],"PHONE_KEY":[{"FEAT_ID":21,"FEAT_DESC":"2256710796"}]},"DISCLOSED_RELATIONS":{},"FEATURE_SCORES":{"ACCT_NUM":[{"INBOUND_FEAT_ID":8,"INBOUND_FEAT":"5534202208773608","INBOUND_FEAT_USAGE_TYPE":"CC","CANDIDATE_FEAT_ID":8,"CANDIDATE_FEAT":"5534202208773608","CANDIDATE_FEAT_USAGE_TYPE":"CC","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"F1"}],"ADDRESS":[{"INBOUND_FEAT_ID":4,"INBOUND_FEAT":"772 Armstrong RD Delhi LA 71232","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":26,"CANDIDATE_FEAT":"772 Armstrong RD Delhi WI 53543","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":81,"SCORE_BUCKET":"LIKELY","SCORE_BEHAVIOR":"FF"}],"DOB":[{"INBOUND_FEAT_ID":100001,"INBOUND_FEAT":"4/8/1985","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":25,"CANDIDATE_FEAT":"6/9/1983","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":79,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"FMES"}],"GENDER":[{"INBOUND_FEAT_ID":3,"INBOUND_FEAT":"F","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":3,"CANDIDATE_FEAT":"F","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FVME"}],"LOGIN_ID":[{"INBOUND_FEAT_ID":7,"INBOUND_FEAT":"flavorh","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":28,"CANDIDATE_FEAT":"flavorh2","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1"}],"NAME":[{"INBOUND_FEAT_ID":1,"INBOUND_FEAT":"JOHNSON","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":24,"CANDIDATE_FEAT":"OCEANGUY","CANDIDATE_FEAT_USAGE_TYPE":"","GNR_FN":33,"GNR_SN":32,"GNR_GN":70,"GENERATION_MATCH":-1,"GNR_ON":-1,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"NAME"}],"PHONE":[{"INBOUND_FEAT_ID":5,"INBOUND_FEAT":"225-671-0796","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":5,"CANDIDATE_FEAT":"225-671-0796","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":100,"SCORE_BUCKET":"SAME","SCORE_BEHAVIOR":"FF"}],"SSN":[{"INBOUND_FEAT_ID":6,"INBOUND_FEAT":"053-39-3251","INBOUND_FEAT_USAGE_TYPE":"","CANDIDATE_FEAT_ID":27,"CANDIDATE_FEAT":"153-33-5185","CANDIDATE_FEAT_USAGE_TYPE":"","FULL_SCORE":0,"SCORE_BUCKET":"NO_CHANCE","SCORE_BEHAVIOR":"F1ES"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi LA 71232","LIB_FEAT_ID":4,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||71232","LIB_FEAT_ID":18,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1983","LIB_FEAT_ID":2,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"FEAT_DESC_VALUES":[{"FEAT_DESC":"4/8/1985","LIB_FEAT_ID":100001,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=053-39-3251","LIB_FEAT_ID":20,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh","LIB_FEAT_ID":7,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JOHNSON","LIB_FEAT_ID":1,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN","LIB_FEAT_ID":11,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":12,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMDD_HASH=0804","LIB_FEAT_ID":9,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0483","LIB_FEAT_ID":10,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB.MMYY_HASH=0485","LIB_FEAT_ID":100002,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|DOB=80804","LIB_FEAT_ID":13,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":15,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|POST=71232","LIB_FEAT_ID":14,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"FEAT_DESC_VALUES":[{"FEAT_DESC":"JNSN|SSN=3251","LIB_FEAT_ID":16,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH|","LIB_FEAT_ID":22,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:3251|80804|","LIB_FEAT_ID":23,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"FEAT_DESC_VALUES":[{"FEAT_DESC":"053-39-3251","LIB_FEAT_ID":6,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:13:27.135","LAST_SEEN_DT":"2022-12-06 16:13:27.916"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.916","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"111","ENTITY_TYPE":"TEST","INTERNAL_ID":100001,"ENTITY_KEY":"A6C927986DF7329D1D2CDE0E8F34328AE640FB7E","ENTITY_DESC":"JOHNSON","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:13:27.916","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23},{"LIB_FEAT_ID":100001},{"LIB_FEAT_ID":100002}]},{"DATA_SOURCE":"TEST","RECORD_ID":"444","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.405","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"555","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.408","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"666","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.411","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"777","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.418","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]},{"DATA_SOURCE":"TEST","RECORD_ID":"FCCE9793DAAD23159DBCCEB97FF2745B92CE7919","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"C6063D4396612FBA7324DB0739273BA1FE815C43","ENTITY_DESC":"JOHNSON","MATCH_KEY":"+NAME+ADDRESS+PHONE+SSN+LOGIN_ID+ACCT_NUM","MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","ERRULE_CODE":"SF1_PNAME_CFF_CSTAB","LAST_SEEN_DT":"2022-12-06 16:13:27.265","FEATURES":[{"LIB_FEAT_ID":1},{"LIB_FEAT_ID":2},{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":4},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":6},{"LIB_FEAT_ID":7},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":9},{"LIB_FEAT_ID":10},{"LIB_FEAT_ID":11},{"LIB_FEAT_ID":12},{"LIB_FEAT_ID":13},{"LIB_FEAT_ID":14},{"LIB_FEAT_ID":15},{"LIB_FEAT_ID":16},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":18},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":20},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":22},{"LIB_FEAT_ID":23}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":2,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"OCEANGUY","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.208","LAST_SEEN_DT":"2022-12-06 16:13:27.208"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.208"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.272","LAST_SEEN_DT":"2022-12-06 16:13:27.272"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.272"}]},{"RESOLVED_ENTITY":{"ENTITY_ID":2,"ENTITY_NAME":"OCEANGUY","FEATURES":{"ACCT_NUM":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USAGE_TYPE":"CC","FEAT_DESC_VALUES":[{"FEAT_DESC":"5534202208773608","LIB_FEAT_ID":8,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDRESS":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772 Armstrong RD Delhi WI 53543","LIB_FEAT_ID":26,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ADDR_KEY":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||53543","LIB_FEAT_ID":37,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"FEAT_DESC_VALUES":[{"FEAT_DESC":"772|ARMSTRNK||TL","LIB_FEAT_ID":17,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"DOB":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"FEAT_DESC_VALUES":[{"FEAT_DESC":"6/9/1983","LIB_FEAT_ID":25,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"GENDER":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"FEAT_DESC_VALUES":[{"FEAT_DESC":"F","LIB_FEAT_ID":3,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"ID_KEY":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ACCT_NUM=5534202208773608","LIB_FEAT_ID":19,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN=153-33-5185","LIB_FEAT_ID":38,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"LOGIN_ID":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"FEAT_DESC_VALUES":[{"FEAT_DESC":"flavorh2","LIB_FEAT_ID":28,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"FEAT_DESC_VALUES":[{"FEAT_DESC":"OCEANGUY","LIB_FEAT_ID":24,"USED_FOR_CAND":"N","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"NAME_KEY":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK","LIB_FEAT_ID":29,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|ADDRESS.CITY_STD=TL","LIB_FEAT_ID":34,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMDD_HASH=0906","LIB_FEAT_ID":32,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB.MMYY_HASH=0683","LIB_FEAT_ID":30,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|DOB=80906","LIB_FEAT_ID":31,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|PHONE.PHONE_LAST_5=10796","LIB_FEAT_ID":33,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|POST=53543","LIB_FEAT_ID":36,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"FEAT_DESC_VALUES":[{"FEAT_DESC":"ASNK|SSN=5185","LIB_FEAT_ID":35,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"FEAT_DESC_VALUES":[{"FEAT_DESC":"225-671-0796","LIB_FEAT_ID":5,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"PHONE_KEY":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"FEAT_DESC_VALUES":[{"FEAT_DESC":"2256710796","LIB_FEAT_ID":21,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":3,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SEARCH_KEY":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"FEAT_DESC_VALUES":[{"FEAT_DESC":"LOGIN_ID:FLAVORH2|","LIB_FEAT_ID":40,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]},{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"FEAT_DESC_VALUES":[{"FEAT_DESC":"SSN:5185|80906|","LIB_FEAT_ID":39,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"N","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}],"SSN":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"FEAT_DESC_VALUES":[{"FEAT_DESC":"153-33-5185","LIB_FEAT_ID":27,"USED_FOR_CAND":"Y","USED_FOR_SCORING":"Y","ENTITY_COUNT":1,"CANDIDATE_CAP_REACHED":"N","SCORING_CAP_REACHED":"N","SUPPRESSED":"N"}]}]},"RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.208","LAST_SEEN_DT":"2022-12-06 16:13:27.208"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.208","RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"222","ENTITY_TYPE":"TEST","INTERNAL_ID":2,"ENTITY_KEY":"740BA22D15CA88462A930AF8A7C904FF5E48226C","ENTITY_DESC":"OCEANGUY","MATCH_KEY":"","MATCH_LEVEL":0,"MATCH_LEVEL_CODE":"","ERRULE_CODE":"","LAST_SEEN_DT":"2022-12-06 16:13:27.208","FEATURES":[{"LIB_FEAT_ID":3},{"LIB_FEAT_ID":5},{"LIB_FEAT_ID":8,"USAGE_TYPE":"CC"},{"LIB_FEAT_ID":17},{"LIB_FEAT_ID":19},{"LIB_FEAT_ID":21},{"LIB_FEAT_ID":24},{"LIB_FEAT_ID":25},{"LIB_FEAT_ID":26},{"LIB_FEAT_ID":27},{"LIB_FEAT_ID":28},{"LIB_FEAT_ID":29},{"LIB_FEAT_ID":30},{"LIB_FEAT_ID":31},{"LIB_FEAT_ID":32},{"LIB_FEAT_ID":33},{"LIB_FEAT_ID":34},{"LIB_FEAT_ID":35},{"LIB_FEAT_ID":36},{"LIB_FEAT_ID":37},{"LIB_FEAT_ID":38},{"LIB_FEAT_ID":39},{"LIB_FEAT_ID":40}]}]},"RELATED_ENTITIES":[{"ENTITY_ID":1,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE+ACCT_NUM-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"JOHNSON","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":6,"FIRST_SEEN_DT":"2022-12-06 16:13:27.135","LAST_SEEN_DT":"2022-12-06 16:13:27.916"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.916"},{"ENTITY_ID":3,"MATCH_LEVEL":3,"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+ADDRESS+PHONE+ACCT_NUM-DOB-SSN","ERRULE_CODE":"SF1","IS_DISCLOSED":0,"IS_AMBIGUOUS":0,"ENTITY_NAME":"Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1,"FIRST_SEEN_DT":"2022-12-06 16:13:27.272","LAST_SEEN_DT":"2022-12-06 16:13:27.272"}],"LAST_SEEN_DT":"2022-12-06 16:13:27.272"}]}]}`
 */
 func (client *G2engine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(153, dataSourceCode1, recordID1, dataSourceCode2, recordID2)
+		client.traceEntry(ctx, 153, dataSourceCode1, recordID1, dataSourceCode2, recordID2)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyRecordsRequest{
@@ -2955,20 +3110,18 @@ func (client *G2engine) WhyRecords(ctx context.Context, dataSourceCode1 string,
 		DataSourceCode2: dataSourceCode2,
 		RecordID2:       recordID2,
 	}
-	response, err := client.GrpcClient.WhyRecords(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyRecords", &request, client.GrpcClient.WhyRecords)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8073, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8073, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(154, dataSourceCode1, recordID1, dataSourceCode2, recordID2, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 154, dataSourceCode1, recordID1, dataSourceCode2, recordID2, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -2990,8 +3143,9 @@ Output
     See the example output.
 */
 func (client *G2engine) WhyRecords_V2(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(155, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+		client.traceEntry(ctx, 155, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
 	}
 	entryTime := time.Now()
 	request := g2pb.WhyRecords_V2Request{
@@ -3001,20 +3155,73 @@ func (client *G2engine) WhyRecords_V2(ctx context.Context, dataSourceCode1 strin
 		RecordID2:       recordID2,
 		Flags:           flags,
 	}
-	response, err := client.GrpcClient.WhyRecords_V2(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "WhyRecords_V2", &request, client.GrpcClient.WhyRecords_V2)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"dataSourceCode1": dataSourceCode1,
-				"recordID1":       recordID1,
-				"dataSourceCode2": dataSourceCode2,
-				"recordID2":       recordID2,
-			}
-			client.notify(ctx, 8074, err, details)
-		}()
+		details := map[string]string{
+			"dataSourceCode1": dataSourceCode1,
+			"recordID1":       recordID1,
+			"dataSourceCode2": dataSourceCode2,
+			"recordID2":       recordID2,
+		}
+		client.notify(ctx, 8074, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(156, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 156, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
+
+// ----------------------------------------------------------------------------
+// Additional methods
+// ----------------------------------------------------------------------------
+
+/*
+The ExportCSVEntityReportIterator method exports entities as CSV lines sent over a channel.
+It wraps the ExportCSVEntityReport(), FetchNext(), CloseExport() lifecycle:
+lines are fetched ahead of the consumer (up to ExportBufferSize) and the export handle
+is closed when the export completes, fails, or ctx is cancelled.
+Lines are fetched by one goroutine, one FetchNext() call after the other, so they keep the server's order.
+Observers receive progress every ExportProgressInterval lines and at the end of the export.
+
+The G2Engine gRPC definitions used by this package have no server-streaming export
+or batched FetchNext(), so each line still takes one call.
+
+Input
+  - ctx: A context to control lifecycle. Cancel it to stop the export early.
+  - csvColumnList: A comma-separated list of column names for the CSV export.
+  - flags: Flags used to control information returned.
+
+Output
+  - A channel of ExportResult that is closed when the export ends.
+    If the export fails, the last ExportResult holds the error.
+*/
+func (client *G2engine) ExportCSVEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) <-chan ExportResult {
+	return client.exportIterator(ctx, 8079, func(ctx context.Context) (uintptr, error) {
+		return client.ExportCSVEntityReport(ctx, csvColumnList, flags)
+	})
+}
+
+/*
+The ExportJSONEntityReportIterator method exports entities as JSON lines sent over a channel.
+It wraps the ExportJSONEntityReport(), FetchNext(), CloseExport() lifecycle:
+lines are fetched ahead of the consumer (up to ExportBufferSize) and the export handle
+is closed when the export completes, fails, or ctx is cancelled.
+Lines are fetched by one goroutine, one FetchNext() call after the other, so they keep the server's order.
+Observers receive progress every ExportProgressInterval lines and at the end of the export.
+
+The G2Engine gRPC definitions used by this package have no server-streaming export
+or batched FetchNext(), so each line still takes one call.
+
+Input
+  - ctx: A context to control lifecycle. Cancel it to stop the export early.
+  - flags: Flags used to control information returned.
+
+Output
+  - A channel of ExportResult that is closed when the export ends.
+    If the export fails, the last ExportResult holds the error.
+*/
+func (client *G2engine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) <-chan ExportResult {
+	return client.exportIterator(ctx, 8080, func(ctx context.Context) (uintptr, error) {
+		return client.ExportJSONEntityReport(ctx, flags)
+	})
+}
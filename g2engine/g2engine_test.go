@@ -7,12 +7,15 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	truncator "github.com/aquilax/truncate"
 	"github.com/senzing/g2-sdk-go-grpc/g2config"
 	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 	"github.com/senzing/g2-sdk-go/g2api"
 	g2engineapi "github.com/senzing/g2-sdk-go/g2engine"
 	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
@@ -25,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -33,12 +37,6 @@ const (
 	printResults      = false
 )
 
-type GetEntityByRecordIDResponse struct {
-	ResolvedEntity struct {
-		EntityId int64 `json:"ENTITY_ID"`
-	} `json:"RESOLVED_ENTITY"`
-}
-
 var (
 	g2configSingleton    g2api.G2config
 	g2configmgrSingleton g2api.G2configmgr
@@ -48,6 +46,38 @@ var (
 	localLogger          messagelogger.MessageLoggerInterface
 )
 
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// An observer collecting the correlation ID of each message.
+type correlationObserver struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (observer *correlationObserver) GetObserverId(ctx context.Context) string {
+	return "correlationObserver"
+}
+
+func (observer *correlationObserver) UpdateObserver(ctx context.Context, message string) {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, details["correlationID"])
+}
+
+func (observer *correlationObserver) correlationIDs() []string {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	return append([]string{}, observer.messages...)
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -55,7 +85,11 @@ var (
 func getGrpcConnection() *grpc.ClientConn {
 	var err error
 	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if grpctest.Enabled() {
+			grpcConnection, err = grpctest.Dial(context.TODO())
+		} else {
+			grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
 		if err != nil {
 			fmt.Printf("Did not connect: %v\n", err)
 		}
@@ -112,12 +146,12 @@ func getEntityIdForRecord(datasource string, id string) int64 {
 	if err != nil {
 		return result
 	}
-	getEntityByRecordIDResponse := &GetEntityByRecordIDResponse{}
-	err = json.Unmarshal([]byte(response), &getEntityByRecordIDResponse)
+	entityResponse := &EntityResponse{}
+	err = json.Unmarshal([]byte(response), entityResponse)
 	if err != nil {
 		return result
 	}
-	return getEntityByRecordIDResponse.ResolvedEntity.EntityId
+	return entityResponse.ResolvedEntity.EntityID
 }
 
 func getEntityIdStringForRecord(datasource string, id string) string {
@@ -375,6 +409,34 @@ func TestG2engine_ExportConfig(test *testing.T) {
 //	test.Log("Actual:", actual)
 //}
 
+func TestG2engine_ExportJSONEntityReportIterator(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test).(*G2engine)
+	flags := int64(0)
+	var lineCount int64 = 0
+	for result := range g2engine.ExportJSONEntityReportIterator(ctx, flags) {
+		testError(test, ctx, g2engine, result.Error)
+		lineCount++
+		assert.Equal(test, lineCount, result.LineNumber)
+		printResult(test, "Entity", result.Value)
+	}
+	assert.Empty(test, g2engine.OutstandingHandles())
+}
+
+func TestG2engine_ExportCSVEntityReportIterator(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	g2engine := getTestObject(ctx, test).(*G2engine)
+	csvColumnList := ""
+	flags := int64(0)
+	for result := range g2engine.ExportCSVEntityReportIterator(ctx, csvColumnList, flags) {
+		testError(test, ctx, g2engine, result.Error)
+		printResult(test, "Entity", result.Value)
+		cancel() // Stopping early still closes the export handle.
+	}
+	assert.Empty(test, g2engine.OutstandingHandles())
+}
+
 func TestG2engine_FindInterestingEntitiesByEntityID(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
@@ -1013,11 +1075,138 @@ func TestG2engine_DeleteRecordWithInfo(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2engine_ExportIterator_FetchAhead(test *testing.T) {
+	ctx := context.TODO()
+	var lock sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	slowFetch := func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod != "/g2engine.G2Engine/FetchNext" {
+			return handler(ctx, request)
+		}
+		lock.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		lock.Lock()
+		inFlight--
+		lock.Unlock()
+		return handler(ctx, request)
+	}
+	fakeServer := grpctest.NewServer(grpc.ChainUnaryInterceptor(slowFetch))
+	defer fakeServer.Close()
+	grpcConnection, err := fakeServer.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer grpcConnection.Close()
+	g2engine := &G2engine{GrpcClient: g2pb.NewG2EngineClient(grpcConnection)}
+	for i := 1; i <= 20; i++ {
+		recordID := strconv.Itoa(i)
+		err := g2engine.AddRecord(ctx, "TEST", recordID, `{"DATA_SOURCE": "TEST", "RECORD_ID": "`+recordID+`", "NAME_FULL": "Person `+recordID+`"}`, loadId)
+		testError(test, ctx, g2engine, err)
+	}
+
+	// The lines come in the order the server exports them, fetched one at a time.
+	csvColumnList := "RESOLVED_ENTITY_ID,RECORD_ID"
+	exportHandle, err := g2engine.ExportCSVEntityReport(ctx, csvColumnList, 0)
+	testError(test, ctx, g2engine, err)
+	expected := []string{}
+	for {
+		line, err := g2engine.FetchNext(ctx, exportHandle)
+		testError(test, ctx, g2engine, err)
+		if line == "" {
+			break
+		}
+		expected = append(expected, line)
+	}
+	testError(test, ctx, g2engine, g2engine.CloseExport(ctx, exportHandle))
+	values := []string{}
+	for result := range g2engine.ExportCSVEntityReportIterator(ctx, csvColumnList, 0) {
+		testError(test, ctx, g2engine, result.Error)
+		assert.Equal(test, int64(len(values)+1), result.LineNumber)
+		values = append(values, result.Value)
+	}
+	assert.Len(test, values, 21)
+	assert.Equal(test, expected, values)
+	assert.Equal(test, 1, maxInFlight)
+	assert.Empty(test, g2engine.OutstandingHandles())
+}
+
+func TestG2engine_CorrelationID(test *testing.T) {
+	ctx := context.TODO()
+	var lock sync.Mutex
+	sent := []string{}
+	record := func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		incoming, _ := metadata.FromIncomingContext(ctx)
+		lock.Lock()
+		sent = append(sent, incoming.Get(g2metadata.CorrelationIDHeader)...)
+		lock.Unlock()
+		return handler(ctx, request)
+	}
+	fakeServer := grpctest.NewServer(grpc.ChainUnaryInterceptor(record))
+	defer fakeServer.Close()
+	grpcConnection, err := fakeServer.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer grpcConnection.Close()
+	g2engine := &G2engine{GrpcClient: g2pb.NewG2EngineClient(grpcConnection)}
+	observer := &correlationObserver{}
+	assert.Nil(test, g2engine.RegisterObserver(ctx, observer))
+
+	// Each call without an ID gets a new one; a given ID is kept.
+	_, err = g2engine.CountRedoRecords(ctx)
+	assert.Nil(test, err)
+	_, err = g2engine.CountRedoRecords(g2metadata.WithCorrelationID(ctx, "request-1"))
+	assert.Nil(test, err)
+	lock.Lock()
+	assert.Len(test, sent, 2)
+	assert.NotEmpty(test, sent[0])
+	assert.Equal(test, "request-1", sent[1])
+	lock.Unlock()
+
+	// The export, its fetches and its close share one ID.
+	for result := range g2engine.ExportJSONEntityReportIterator(ctx, 0) {
+		assert.Nil(test, result.Error)
+	}
+	lock.Lock()
+	assert.GreaterOrEqual(test, len(sent), 5)
+	for _, correlationID := range sent[3:] {
+		assert.Equal(test, sent[2], correlationID)
+	}
+	lock.Unlock()
+
+	// Observers get the IDs sent.
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Eventually(test, func() bool {
+		received := map[string]bool{}
+		for _, correlationID := range observer.correlationIDs() {
+			received[correlationID] = true
+		}
+		for _, correlationID := range sent {
+			if !received[correlationID] {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond)
+}
+
 func TestG2engine_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2engine := getTestObject(ctx, test)
-	err := g2engine.Destroy(ctx)
+	responseHandle, err := g2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, ctx, g2engine, err)
+	handles := g2engine.(*G2engine).OutstandingHandles()
+	assert.Len(test, handles, 1)
+	assert.Equal(test, responseHandle, handles[0].Value)
+	err = g2engine.Destroy(ctx)
 	expectError(test, ctx, g2engine, err, "senzing-60144001")
+	assert.Empty(test, g2engine.(*G2engine).OutstandingHandles())
 	g2engineSingleton = nil
 }
 
@@ -1193,6 +1382,24 @@ func ExampleG2engine_ExportJSONEntityReport() {
 	// Output: true
 }
 
+func ExampleG2engine_ExportJSONEntityReportIterator() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2engine/g2engine_test.go
+	ctx := context.TODO()
+	g2engine := &G2engine{
+		GrpcClient: g2pb.NewG2EngineClient(getGrpcConnection()),
+	}
+	flags := int64(0)
+	for result := range g2engine.ExportJSONEntityReportIterator(ctx, flags) {
+		if result.Error != nil {
+			fmt.Println(result.Error)
+			break
+		}
+		fmt.Println(len(result.Value) > 0) // Dummy output.
+		break
+	}
+	// Output: true
+}
+
 func ExampleG2engine_FetchNext() {
 	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2engine/g2engine_test.go
 	ctx := context.TODO()
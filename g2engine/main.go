@@ -1,8 +1,19 @@
 package g2engine
 
+import "time"
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 // Identfier of the g2engine package found messages having the format "senzing-6024xxxx".
 const ProductId = 6024
+
+// Number of exported lines the export iterators fetch ahead of the consumer.
+const ExportBufferSize = 100
+
+// Number of exported lines between progress notifications sent by the export iterators.
+const ExportProgressInterval = 10000
+
+// Maximum time allowed to close an export handle after the iterator's context is cancelled.
+const exportCloseTimeout = 30 * time.Second
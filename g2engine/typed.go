@@ -0,0 +1,643 @@
+/*
+ *
+ */
+
+package g2engine
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Typed wraps a G2engine, decoding the JSON documents it returns into Go structs.
+// Fields not requested by the flags passed to a method are left at their zero value.
+type Typed struct {
+	G2engine g2api.G2engine
+}
+
+// RecordKey identifies a record.
+type RecordKey struct {
+	DataSource string `json:"DATA_SOURCE,omitempty"`
+	RecordID   string `json:"RECORD_ID,omitempty"`
+}
+
+// FeatureValue is one of the values that were merged into an EntityFeature.
+// The usage statistics ("Y" or "N") are present only when requested by flags.
+type FeatureValue struct {
+	CandidateCapReached string `json:"CANDIDATE_CAP_REACHED,omitempty"`
+	EntityCount         int64  `json:"ENTITY_COUNT,omitempty"`
+	FeatDesc            string `json:"FEAT_DESC,omitempty"`
+	LibFeatID           int64  `json:"LIB_FEAT_ID,omitempty"`
+	ScoringCapReached   string `json:"SCORING_CAP_REACHED,omitempty"`
+	Suppressed          string `json:"SUPPRESSED,omitempty"`
+	UsedForCand         string `json:"USED_FOR_CAND,omitempty"`
+	UsedForScoring      string `json:"USED_FOR_SCORING,omitempty"`
+}
+
+// EntityFeature is a feature of a resolved entity, e.g. a NAME or an ADDRESS.
+type EntityFeature struct {
+	FeatDesc       string         `json:"FEAT_DESC,omitempty"`
+	FeatDescValues []FeatureValue `json:"FEAT_DESC_VALUES,omitempty"`
+	LibFeatID      int64          `json:"LIB_FEAT_ID,omitempty"`
+	UsageType      string         `json:"USAGE_TYPE,omitempty"`
+}
+
+// RecordFeature references a feature of a record.
+type RecordFeature struct {
+	LibFeatID int64  `json:"LIB_FEAT_ID,omitempty"`
+	UsageType string `json:"USAGE_TYPE,omitempty"`
+}
+
+// RecordSummary counts the records of one data source in an entity.
+type RecordSummary struct {
+	DataSource  string `json:"DATA_SOURCE,omitempty"`
+	FirstSeenDt string `json:"FIRST_SEEN_DT,omitempty"`
+	LastSeenDt  string `json:"LAST_SEEN_DT,omitempty"`
+	RecordCount int64  `json:"RECORD_COUNT,omitempty"`
+}
+
+// EntityRecord is a record that is a member of a resolved entity.
+type EntityRecord struct {
+	DataSource     string          `json:"DATA_SOURCE,omitempty"`
+	EntityDesc     string          `json:"ENTITY_DESC,omitempty"`
+	EntityKey      string          `json:"ENTITY_KEY,omitempty"`
+	EntityType     string          `json:"ENTITY_TYPE,omitempty"`
+	ErruleCode     string          `json:"ERRULE_CODE,omitempty"`
+	Features       []RecordFeature `json:"FEATURES,omitempty"`
+	InternalID     int64           `json:"INTERNAL_ID,omitempty"`
+	LastSeenDt     string          `json:"LAST_SEEN_DT,omitempty"`
+	MatchKey       string          `json:"MATCH_KEY,omitempty"`
+	MatchLevel     int             `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode string          `json:"MATCH_LEVEL_CODE,omitempty"`
+	RecordID       string          `json:"RECORD_ID,omitempty"`
+}
+
+// ResolvedEntity is an entity and, depending on flags, its features and records.
+type ResolvedEntity struct {
+	EntityID      int64                      `json:"ENTITY_ID,omitempty"`
+	EntityName    string                     `json:"ENTITY_NAME,omitempty"`
+	Features      map[string][]EntityFeature `json:"FEATURES,omitempty"`
+	LastSeenDt    string                     `json:"LAST_SEEN_DT,omitempty"`
+	RecordSummary []RecordSummary            `json:"RECORD_SUMMARY,omitempty"`
+	Records       []EntityRecord             `json:"RECORDS,omitempty"`
+}
+
+// RelatedEntity is an entity related to a resolved entity, and how it is related.
+type RelatedEntity struct {
+	EntityID       int64           `json:"ENTITY_ID,omitempty"`
+	EntityName     string          `json:"ENTITY_NAME,omitempty"`
+	ErruleCode     string          `json:"ERRULE_CODE,omitempty"`
+	IsAmbiguous    int             `json:"IS_AMBIGUOUS,omitempty"`
+	IsDisclosed    int             `json:"IS_DISCLOSED,omitempty"`
+	LastSeenDt     string          `json:"LAST_SEEN_DT,omitempty"`
+	MatchKey       string          `json:"MATCH_KEY,omitempty"`
+	MatchLevel     int             `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode string          `json:"MATCH_LEVEL_CODE,omitempty"`
+	RecordSummary  []RecordSummary `json:"RECORD_SUMMARY,omitempty"`
+}
+
+// EntityResponse is returned by GetEntityByEntityID() and GetEntityByRecordID(),
+// and is the form of each entity in paths, networks, searches and why results.
+type EntityResponse struct {
+	RelatedEntities []RelatedEntity `json:"RELATED_ENTITIES,omitempty"`
+	ResolvedEntity  ResolvedEntity  `json:"RESOLVED_ENTITY"`
+}
+
+// RecordResponse is returned by GetRecord().
+type RecordResponse struct {
+	DataSource string          `json:"DATA_SOURCE,omitempty"`
+	JsonData   json.RawMessage `json:"JSON_DATA,omitempty"`
+	RecordID   string          `json:"RECORD_ID,omitempty"`
+}
+
+// EntityPath is a path between two entities.
+type EntityPath struct {
+	EndEntityID   int64   `json:"END_ENTITY_ID,omitempty"`
+	Entities      []int64 `json:"ENTITIES,omitempty"`
+	StartEntityID int64   `json:"START_ENTITY_ID,omitempty"`
+}
+
+// PathResponse is returned by FindPathByEntityID() and FindPathByRecordID().
+type PathResponse struct {
+	Entities    []EntityResponse `json:"ENTITIES,omitempty"`
+	EntityPaths []EntityPath     `json:"ENTITY_PATHS,omitempty"`
+}
+
+// EntityNetworkLink is a relationship between two entities of a network.
+type EntityNetworkLink struct {
+	ErruleCode     string `json:"ERRULE_CODE,omitempty"`
+	IsAmbiguous    int    `json:"IS_AMBIGUOUS,omitempty"`
+	IsDisclosed    int    `json:"IS_DISCLOSED,omitempty"`
+	MatchKey       string `json:"MATCH_KEY,omitempty"`
+	MatchLevel     int    `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE,omitempty"`
+	MaxEntityID    int64  `json:"MAX_ENTITY_ID,omitempty"`
+	MinEntityID    int64  `json:"MIN_ENTITY_ID,omitempty"`
+}
+
+// NetworkResponse is returned by FindNetworkByEntityID() and FindNetworkByRecordID().
+type NetworkResponse struct {
+	Entities           []EntityResponse    `json:"ENTITIES,omitempty"`
+	EntityNetworkLinks []EntityNetworkLink `json:"ENTITY_NETWORK_LINKS,omitempty"`
+	EntityPaths        []EntityPath        `json:"ENTITY_PATHS,omitempty"`
+}
+
+// FeatureScore compares an inbound feature with a candidate feature.
+// Name features are scored with the GNR_ fields rather than FullScore.
+type FeatureScore struct {
+	CandidateFeat          string `json:"CANDIDATE_FEAT,omitempty"`
+	CandidateFeatID        int64  `json:"CANDIDATE_FEAT_ID,omitempty"`
+	CandidateFeatUsageType string `json:"CANDIDATE_FEAT_USAGE_TYPE,omitempty"`
+	FullScore              int    `json:"FULL_SCORE,omitempty"`
+	GenerationMatch        int    `json:"GENERATION_MATCH,omitempty"`
+	GnrFn                  int    `json:"GNR_FN,omitempty"`
+	GnrGn                  int    `json:"GNR_GN,omitempty"`
+	GnrOn                  int    `json:"GNR_ON,omitempty"`
+	GnrSn                  int    `json:"GNR_SN,omitempty"`
+	InboundFeat            string `json:"INBOUND_FEAT,omitempty"`
+	InboundFeatID          int64  `json:"INBOUND_FEAT_ID,omitempty"`
+	InboundFeatUsageType   string `json:"INBOUND_FEAT_USAGE_TYPE,omitempty"`
+	ScoreBehavior          string `json:"SCORE_BEHAVIOR,omitempty"`
+	ScoreBucket            string `json:"SCORE_BUCKET,omitempty"`
+}
+
+// SearchMatchInfo describes how a search result matched the search attributes.
+type SearchMatchInfo struct {
+	ErruleCode     string                    `json:"ERRULE_CODE,omitempty"`
+	FeatureScores  map[string][]FeatureScore `json:"FEATURE_SCORES,omitempty"`
+	MatchKey       string                    `json:"MATCH_KEY,omitempty"`
+	MatchLevel     int                       `json:"MATCH_LEVEL,omitempty"`
+	MatchLevelCode string                    `json:"MATCH_LEVEL_CODE,omitempty"`
+}
+
+// SearchResult is one entity found by SearchByAttributes().
+type SearchResult struct {
+	Entity    EntityResponse  `json:"ENTITY"`
+	MatchInfo SearchMatchInfo `json:"MATCH_INFO"`
+}
+
+// SearchResponse is returned by SearchByAttributes().
+type SearchResponse struct {
+	ResolvedEntities []SearchResult `json:"RESOLVED_ENTITIES,omitempty"`
+}
+
+// CandidateKey is a feature that made two entities, or records, candidates for matching.
+type CandidateKey struct {
+	FeatDesc string `json:"FEAT_DESC,omitempty"`
+	FeatID   int64  `json:"FEAT_ID,omitempty"`
+}
+
+// WhyMatchInfo explains why two entities, or records, did or did not resolve.
+type WhyMatchInfo struct {
+	CandidateKeys      map[string][]CandidateKey `json:"CANDIDATE_KEYS,omitempty"`
+	DisclosedRelations json.RawMessage           `json:"DISCLOSED_RELATIONS,omitempty"`
+	FeatureScores      map[string][]FeatureScore `json:"FEATURE_SCORES,omitempty"`
+	MatchLevelCode     string                    `json:"MATCH_LEVEL_CODE,omitempty"`
+	WhyErruleCode      string                    `json:"WHY_ERRULE_CODE,omitempty"`
+	WhyKey             string                    `json:"WHY_KEY,omitempty"`
+}
+
+// WhyResult is one comparison of a why result.
+// The _2 fields are set when two entities, or records, are compared.
+type WhyResult struct {
+	EntityID      int64        `json:"ENTITY_ID,omitempty"`
+	EntityID2     int64        `json:"ENTITY_ID_2,omitempty"`
+	FocusRecords  []RecordKey  `json:"FOCUS_RECORDS,omitempty"`
+	FocusRecords2 []RecordKey  `json:"FOCUS_RECORDS_2,omitempty"`
+	InternalID    int64        `json:"INTERNAL_ID,omitempty"`
+	InternalID2   int64        `json:"INTERNAL_ID_2,omitempty"`
+	MatchInfo     WhyMatchInfo `json:"MATCH_INFO"`
+}
+
+// WhyResponse is returned by WhyEntities(), WhyEntityByEntityID(), WhyEntityByRecordID() and WhyRecords().
+type WhyResponse struct {
+	Entities   []EntityResponse `json:"ENTITIES,omitempty"`
+	WhyResults []WhyResult      `json:"WHY_RESULTS,omitempty"`
+}
+
+// MemberRecord is an observed entity within a virtual entity.
+type MemberRecord struct {
+	InternalID int64       `json:"INTERNAL_ID,omitempty"`
+	Records    []RecordKey `json:"RECORDS,omitempty"`
+}
+
+// VirtualEntity is an intermediate entity of a how result.
+type VirtualEntity struct {
+	MemberRecords   []MemberRecord `json:"MEMBER_RECORDS,omitempty"`
+	VirtualEntityID string         `json:"VIRTUAL_ENTITY_ID,omitempty"`
+}
+
+// HowMatchInfo describes why the two virtual entities of a resolution step were merged.
+type HowMatchInfo struct {
+	ErruleCode    string                    `json:"ERRULE_CODE,omitempty"`
+	FeatureScores map[string][]FeatureScore `json:"FEATURE_SCORES,omitempty"`
+	MatchKey      string                    `json:"MATCH_KEY,omitempty"`
+}
+
+// HowStep is one merge in the resolution of an entity.
+type HowStep struct {
+	InboundVirtualEntityID string        `json:"INBOUND_VIRTUAL_ENTITY_ID,omitempty"`
+	MatchInfo              HowMatchInfo  `json:"MATCH_INFO"`
+	ResultVirtualEntityID  string        `json:"RESULT_VIRTUAL_ENTITY_ID,omitempty"`
+	Step                   int           `json:"STEP,omitempty"`
+	VirtualEntity1         VirtualEntity `json:"VIRTUAL_ENTITY_1"`
+	VirtualEntity2         VirtualEntity `json:"VIRTUAL_ENTITY_2"`
+}
+
+// HowFinalState is the outcome of the resolution steps.
+type HowFinalState struct {
+	NeedReevaluation int             `json:"NEED_REEVALUATION,omitempty"`
+	VirtualEntities  []VirtualEntity `json:"VIRTUAL_ENTITIES,omitempty"`
+}
+
+// HowResults lists the steps by which an entity was resolved.
+type HowResults struct {
+	FinalState      HowFinalState `json:"FINAL_STATE"`
+	ResolutionSteps []HowStep     `json:"RESOLUTION_STEPS,omitempty"`
+}
+
+// HowResponse is returned by HowEntityByEntityID().
+type HowResponse struct {
+	HowResults HowResults `json:"HOW_RESULTS"`
+}
+
+// AffectedEntity is an entity changed by a call.
+type AffectedEntity struct {
+	EntityID int64 `json:"ENTITY_ID,omitempty"`
+}
+
+// InterestingEntity is an entity flagged as interesting near the affected entities.
+type InterestingEntity struct {
+	Degrees       int                 `json:"DEGREES,omitempty"`
+	EntityID      int64               `json:"ENTITY_ID,omitempty"`
+	Flags         []string            `json:"FLAGS,omitempty"`
+	SampleRecords []InterestingRecord `json:"SAMPLE_RECORDS,omitempty"`
+}
+
+// InterestingRecord is a sample record of an InterestingEntity.
+type InterestingRecord struct {
+	DataSource string   `json:"DATA_SOURCE,omitempty"`
+	Flags      []string `json:"FLAGS,omitempty"`
+	RecordID   string   `json:"RECORD_ID,omitempty"`
+}
+
+// Notice is a message accompanying the interesting entities.
+type Notice struct {
+	Code        string `json:"CODE,omitempty"`
+	Description string `json:"DESCRIPTION,omitempty"`
+}
+
+// InterestingEntities lists the interesting entities of a WithInfo response.
+type InterestingEntities struct {
+	Entities []InterestingEntity `json:"ENTITIES,omitempty"`
+	Notices  []Notice            `json:"NOTICES,omitempty"`
+}
+
+// WithInfo is returned by the ...WithInfo() methods.
+type WithInfo struct {
+	AffectedEntities    []AffectedEntity    `json:"AFFECTED_ENTITIES,omitempty"`
+	DataSource          string              `json:"DATA_SOURCE,omitempty"`
+	InterestingEntities InterestingEntities `json:"INTERESTING_ENTITIES"`
+	RecordID            string              `json:"RECORD_ID,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Decode the JSON document returned by a G2engine method, unless the method failed.
+func decode(response string, err error, result interface{}) error {
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(response), result)
+}
+
+// Build the entityList parameter of FindNetworkByEntityID().
+func buildEntityList(entityIDs []int64) (string, error) {
+	type entity struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	}
+	list := struct {
+		Entities []entity `json:"ENTITIES"`
+	}{
+		Entities: []entity{},
+	}
+	for _, entityID := range entityIDs {
+		list.Entities = append(list.Entities, entity{EntityID: entityID})
+	}
+	result, err := json.Marshal(list)
+	return string(result), err
+}
+
+// Build the recordList parameter of FindNetworkByRecordID().
+func buildRecordList(recordKeys []RecordKey) (string, error) {
+	list := struct {
+		Records []RecordKey `json:"RECORDS"`
+	}{
+		Records: recordKeys,
+	}
+	if list.Records == nil {
+		list.Records = []RecordKey{}
+	}
+	result, err := json.Marshal(list)
+	return string(result), err
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecordWithInfo method is AddRecordWithInfo() returning a WithInfo.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - jsonData: A JSON document containing the record to be added to the Senzing repository.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (*WithInfo, error) {
+	result := &WithInfo{}
+	response, err := typed.G2engine.AddRecordWithInfo(ctx, dataSourceCode, recordID, jsonData, loadID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The DeleteRecordWithInfo method is DeleteRecordWithInfo() returning a WithInfo.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) DeleteRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, loadID string, flags int64) (*WithInfo, error) {
+	result := &WithInfo{}
+	response, err := typed.G2engine.DeleteRecordWithInfo(ctx, dataSourceCode, recordID, loadID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The FindNetworkByEntityID method is FindNetworkByEntityID_V2() taking entity IDs and returning a NetworkResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityIDs: The entities at the center of the network.
+  - maxDegree: The maximum number of degrees in paths between search entities.
+  - buildOutDegree: The number of degrees of relationships to show around each search entity.
+  - maxEntities: The maximum number of entities to return in the discovered network.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) FindNetworkByEntityID(ctx context.Context, entityIDs []int64, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (*NetworkResponse, error) {
+	result := &NetworkResponse{}
+	entityList, err := buildEntityList(entityIDs)
+	if err != nil {
+		return result, err
+	}
+	response, err := typed.G2engine.FindNetworkByEntityID_V2(ctx, entityList, maxDegree, buildOutDegree, maxEntities, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The FindNetworkByRecordID method is FindNetworkByRecordID_V2() taking record keys and returning a NetworkResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordKeys: The records whose entities are at the center of the network.
+  - maxDegree: The maximum number of degrees in paths between search entities.
+  - buildOutDegree: The number of degrees of relationships to show around each search entity.
+  - maxEntities: The maximum number of entities to return in the discovered network.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) FindNetworkByRecordID(ctx context.Context, recordKeys []RecordKey, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (*NetworkResponse, error) {
+	result := &NetworkResponse{}
+	recordList, err := buildRecordList(recordKeys)
+	if err != nil {
+		return result, err
+	}
+	response, err := typed.G2engine.FindNetworkByRecordID_V2(ctx, recordList, maxDegree, buildOutDegree, maxEntities, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The FindPathByEntityID method is FindPathByEntityID_V2() returning a PathResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID1: The entity ID for the starting entity of the search path.
+  - entityID2: The entity ID for the ending entity of the search path.
+  - maxDegree: The maximum number of degrees in paths between search entities.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) FindPathByEntityID(ctx context.Context, entityID1 int64, entityID2 int64, maxDegree int, flags int64) (*PathResponse, error) {
+	result := &PathResponse{}
+	response, err := typed.G2engine.FindPathByEntityID_V2(ctx, entityID1, entityID2, maxDegree, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The FindPathByRecordID method is FindPathByRecordID_V2() returning a PathResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode1: Identifies the provenance of the record for the starting entity of the search path.
+  - recordID1: The unique identifier within the records of the same data source for the starting entity of the search path.
+  - dataSourceCode2: Identifies the provenance of the record for the ending entity of the search path.
+  - recordID2: The unique identifier within the records of the same data source for the ending entity of the search path.
+  - maxDegree: The maximum number of degrees in paths between search entities.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) FindPathByRecordID(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, maxDegree int, flags int64) (*PathResponse, error) {
+	result := &PathResponse{}
+	response, err := typed.G2engine.FindPathByRecordID_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, maxDegree, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The GetEntityByEntityID method is GetEntityByEntityID_V2() returning an EntityResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (*EntityResponse, error) {
+	result := &EntityResponse{}
+	response, err := typed.G2engine.GetEntityByEntityID_V2(ctx, entityID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The GetEntityByRecordID method is GetEntityByRecordID_V2() returning an EntityResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*EntityResponse, error) {
+	result := &EntityResponse{}
+	response, err := typed.G2engine.GetEntityByRecordID_V2(ctx, dataSourceCode, recordID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The GetRecord method is GetRecord_V2() returning a RecordResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*RecordResponse, error) {
+	result := &RecordResponse{}
+	response, err := typed.G2engine.GetRecord_V2(ctx, dataSourceCode, recordID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The HowEntityByEntityID method is HowEntityByEntityID_V2() returning a HowResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (*HowResponse, error) {
+	result := &HowResponse{}
+	response, err := typed.G2engine.HowEntityByEntityID_V2(ctx, entityID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The ReevaluateEntityWithInfo method is ReevaluateEntityWithInfo() returning a WithInfo.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) ReevaluateEntityWithInfo(ctx context.Context, entityID int64, flags int64) (*WithInfo, error) {
+	result := &WithInfo{}
+	response, err := typed.G2engine.ReevaluateEntityWithInfo(ctx, entityID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The ReevaluateRecordWithInfo method is ReevaluateRecordWithInfo() returning a WithInfo.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) ReevaluateRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*WithInfo, error) {
+	result := &WithInfo{}
+	response, err := typed.G2engine.ReevaluateRecordWithInfo(ctx, dataSourceCode, recordID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The ReplaceRecordWithInfo method is ReplaceRecordWithInfo() returning a WithInfo.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - jsonData: A JSON document containing the record to be added to the Senzing repository.
+  - loadID: An identifier used to distinguish different load batches/sessions. An empty string is acceptable.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) ReplaceRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (*WithInfo, error) {
+	result := &WithInfo{}
+	response, err := typed.G2engine.ReplaceRecordWithInfo(ctx, dataSourceCode, recordID, jsonData, loadID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The SearchByAttributes method is SearchByAttributes_V2() returning a SearchResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - jsonData: A JSON document containing the attributes to search for.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) SearchByAttributes(ctx context.Context, jsonData string, flags int64) (*SearchResponse, error) {
+	result := &SearchResponse{}
+	response, err := typed.G2engine.SearchByAttributes_V2(ctx, jsonData, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The WhyEntities method is WhyEntities_V2() returning a WhyResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID1: The entity ID for the starting entity of the search path.
+  - entityID2: The entity ID for the ending entity of the search path.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (*WhyResponse, error) {
+	result := &WhyResponse{}
+	response, err := typed.G2engine.WhyEntities_V2(ctx, entityID1, entityID2, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The WhyEntityByEntityID method is WhyEntityByEntityID_V2() returning a WhyResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) WhyEntityByEntityID(ctx context.Context, entityID int64, flags int64) (*WhyResponse, error) {
+	result := &WhyResponse{}
+	response, err := typed.G2engine.WhyEntityByEntityID_V2(ctx, entityID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The WhyEntityByRecordID method is WhyEntityByRecordID_V2() returning a WhyResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) WhyEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (*WhyResponse, error) {
+	result := &WhyResponse{}
+	response, err := typed.G2engine.WhyEntityByRecordID_V2(ctx, dataSourceCode, recordID, flags)
+	return result, decode(response, err, result)
+}
+
+/*
+The WhyRecords method is WhyRecords_V2() returning a WhyResponse.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode1: Identifies the provenance of the data.
+  - recordID1: The unique identifier within the records of the same data source.
+  - dataSourceCode2: Identifies the provenance of the data.
+  - recordID2: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+*/
+func (typed *Typed) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (*WhyResponse, error) {
+	result := &WhyResponse{}
+	response, err := typed.G2engine.WhyRecords_V2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	return result, decode(response, err, result)
+}
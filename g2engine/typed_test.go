@@ -0,0 +1,258 @@
+package g2engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-common/truthset"
+	"github.com/stretchr/testify/assert"
+)
+
+// The typed response each G2engine method's JSON document decodes into.
+var typedResponses = map[string]func() interface{}{
+	"AddRecordWithInfo":                     func() interface{} { return &WithInfo{} },
+	"AddRecordWithInfoWithReturnedRecordID": func() interface{} { return &WithInfo{} },
+	"DeleteRecordWithInfo":                  func() interface{} { return &WithInfo{} },
+	"FindNetworkByEntityID":                 func() interface{} { return &NetworkResponse{} },
+	"FindNetworkByEntityID_V2":              func() interface{} { return &NetworkResponse{} },
+	"FindNetworkByRecordID":                 func() interface{} { return &NetworkResponse{} },
+	"FindNetworkByRecordID_V2":              func() interface{} { return &NetworkResponse{} },
+	"FindPathByEntityID":                    func() interface{} { return &PathResponse{} },
+	"FindPathByEntityID_V2":                 func() interface{} { return &PathResponse{} },
+	"FindPathByRecordID":                    func() interface{} { return &PathResponse{} },
+	"FindPathByRecordID_V2":                 func() interface{} { return &PathResponse{} },
+	"FindPathExcludingByEntityID":           func() interface{} { return &PathResponse{} },
+	"FindPathExcludingByEntityID_V2":        func() interface{} { return &PathResponse{} },
+	"FindPathExcludingByRecordID":           func() interface{} { return &PathResponse{} },
+	"FindPathExcludingByRecordID_V2":        func() interface{} { return &PathResponse{} },
+	"FindPathIncludingSourceByEntityID":     func() interface{} { return &PathResponse{} },
+	"FindPathIncludingSourceByEntityID_V2":  func() interface{} { return &PathResponse{} },
+	"FindPathIncludingSourceByRecordID":     func() interface{} { return &PathResponse{} },
+	"FindPathIncludingSourceByRecordID_V2":  func() interface{} { return &PathResponse{} },
+	"GetEntityByEntityID":                   func() interface{} { return &EntityResponse{} },
+	"GetEntityByEntityID_V2":                func() interface{} { return &EntityResponse{} },
+	"GetEntityByRecordID":                   func() interface{} { return &EntityResponse{} },
+	"GetEntityByRecordID_V2":                func() interface{} { return &EntityResponse{} },
+	"GetRecord":                             func() interface{} { return &RecordResponse{} },
+	"GetRecord_V2":                          func() interface{} { return &RecordResponse{} },
+	"GetVirtualEntityByRecordID":            func() interface{} { return &EntityResponse{} },
+	"GetVirtualEntityByRecordID_V2":         func() interface{} { return &EntityResponse{} },
+	"HowEntityByEntityID":                   func() interface{} { return &HowResponse{} },
+	"HowEntityByEntityID_V2":                func() interface{} { return &HowResponse{} },
+	"ReevaluateEntityWithInfo":              func() interface{} { return &WithInfo{} },
+	"ReevaluateRecordWithInfo":              func() interface{} { return &WithInfo{} },
+	"ReplaceRecordWithInfo":                 func() interface{} { return &WithInfo{} },
+	"SearchByAttributes":                    func() interface{} { return &SearchResponse{} },
+	"SearchByAttributes_V2":                 func() interface{} { return &SearchResponse{} },
+	"WhyEntities":                           func() interface{} { return &WhyResponse{} },
+	"WhyEntities_V2":                        func() interface{} { return &WhyResponse{} },
+	"WhyEntityByEntityID":                   func() interface{} { return &WhyResponse{} },
+	"WhyEntityByEntityID_V2":                func() interface{} { return &WhyResponse{} },
+	"WhyEntityByRecordID":                   func() interface{} { return &WhyResponse{} },
+	"WhyEntityByRecordID_V2":                func() interface{} { return &WhyResponse{} },
+	"WhyRecords":                            func() interface{} { return &WhyResponse{} },
+	"WhyRecords_V2":                         func() interface{} { return &WhyResponse{} },
+}
+
+// A G2engine returning canned JSON documents.
+// Methods not overridden panic, because the embedded interface is nil.
+type cannedG2engine struct {
+	g2api.G2engine
+	parameters []interface{}
+	response   string
+}
+
+func (engine *cannedG2engine) FindNetworkByEntityID_V2(ctx context.Context, entityList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	engine.parameters = []interface{}{entityList, maxDegree, buildOutDegree, maxEntities, flags}
+	return engine.response, nil
+}
+
+func (engine *cannedG2engine) FindNetworkByRecordID_V2(ctx context.Context, recordList string, maxDegree int, buildOutDegree int, maxEntities int, flags int64) (string, error) {
+	engine.parameters = []interface{}{recordList, maxDegree, buildOutDegree, maxEntities, flags}
+	return engine.response, nil
+}
+
+func (engine *cannedG2engine) GetEntityByEntityID_V2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	engine.parameters = []interface{}{entityID, flags}
+	return engine.response, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Sample JSON documents, by method name, from the "Example:" of each method's doc comment
+// and from the "// Output:" of each example. Truncated samples are skipped.
+func getSampleResponses(test *testing.T) map[string][]string {
+	result := map[string][]string{}
+	source, err := os.ReadFile("g2engine.go")
+	testError(test, context.TODO(), nil, err)
+	docComments := regexp.MustCompile(`(?s)/\*\n(.*?)\*/\nfunc \(client \*G2engine\) (\w+)\(`)
+	examples := regexp.MustCompile("Example: `(.*?)`")
+	for _, match := range docComments.FindAllStringSubmatch(string(source), -1) {
+		sections := strings.SplitN(match[1], "\nOutput", 2)
+		if len(sections) < 2 {
+			continue
+		}
+		for _, example := range examples.FindAllStringSubmatch(sections[1], -1) {
+			result[match[2]] = append(result[match[2]], example[1])
+		}
+	}
+	source, err = os.ReadFile("g2engine_test.go")
+	testError(test, context.TODO(), nil, err)
+	outputs := regexp.MustCompile(`(?s)func ExampleG2engine_(\w+)\(\) \{\n.*?// Output: (.*?)\n}`)
+	for _, match := range outputs.FindAllStringSubmatch(string(source), -1) {
+		result[match[1]] = append(result[match[1]], match[2])
+	}
+	for method, samples := range result {
+		complete := []string{}
+		for _, sample := range samples {
+			if json.Valid([]byte(sample)) {
+				complete = append(complete, sample)
+			}
+		}
+		result[method] = complete
+	}
+	return result
+}
+
+// Remove empty strings, zeros, nulls, and empty objects and arrays, recursively.
+// Typed responses omit zero values, so they are not expected to survive a round trip.
+func withoutZeroValues(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for key, element := range typedValue {
+			if element = withoutZeroValues(element); element != nil {
+				result[key] = element
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []interface{}:
+		result := []interface{}{}
+		for _, element := range typedValue {
+			if element = withoutZeroValues(element); element != nil {
+				result = append(result, element)
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case string:
+		if typedValue == "" {
+			return nil
+		}
+	case float64:
+		if typedValue == 0 {
+			return nil
+		}
+	}
+	return value
+}
+
+func normalizeJson(test *testing.T, document []byte) interface{} {
+	var result interface{}
+	err := json.Unmarshal(document, &result)
+	testError(test, context.TODO(), nil, err)
+	return withoutZeroValues(result)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestTyped_RoundTrip(test *testing.T) {
+	sampleCount := 0
+	for method, samples := range getSampleResponses(test) {
+		newResponse, ok := typedResponses[method]
+		if !ok {
+			continue
+		}
+		for _, sample := range samples {
+			typedResponse := newResponse()
+			decoder := json.NewDecoder(strings.NewReader(sample))
+			decoder.DisallowUnknownFields()
+			err := decoder.Decode(typedResponse)
+			assert.Nil(test, err, method)
+			roundTrip, err := json.Marshal(typedResponse)
+			testError(test, context.TODO(), nil, err)
+			assert.Equal(test, normalizeJson(test, []byte(sample)), normalizeJson(test, roundTrip), method)
+			sampleCount++
+		}
+	}
+	assert.Greater(test, sampleCount, 20)
+}
+
+func TestTyped_FindNetworkByEntityID_Parameters(test *testing.T) {
+	ctx := context.TODO()
+	engine := &cannedG2engine{response: `{"ENTITY_PATHS":[],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1}}]}`}
+	typed := &Typed{G2engine: engine}
+	actual, err := typed.FindNetworkByEntityID(ctx, []int64{1, 2}, 3, 4, 5, 6)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, []interface{}{`{"ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":2}]}`, 3, 4, 5, int64(6)}, engine.parameters)
+	assert.Equal(test, int64(1), actual.Entities[0].ResolvedEntity.EntityID)
+}
+
+func TestTyped_FindNetworkByRecordID_Parameters(test *testing.T) {
+	ctx := context.TODO()
+	engine := &cannedG2engine{response: `{}`}
+	typed := &Typed{G2engine: engine}
+	_, err := typed.FindNetworkByRecordID(ctx, []RecordKey{{DataSource: "CUSTOMERS", RecordID: "1001"}}, 1, 0, 10, 0)
+	testError(test, ctx, nil, err)
+	assert.Equal(test, `{"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"}]}`, engine.parameters[0])
+}
+
+func TestTyped_GetEntityByEntityID_BadResponse(test *testing.T) {
+	ctx := context.TODO()
+	typed := &Typed{G2engine: &cannedG2engine{response: `not JSON`}}
+	_, err := typed.GetEntityByEntityID(ctx, 1, 0)
+	assert.NotNil(test, err)
+}
+
+func TestTyped_GetEntityByRecordID(test *testing.T) {
+	ctx := context.TODO()
+	typed := &Typed{G2engine: getTestObject(ctx, test)}
+	record := truthset.CustomerRecords["1001"]
+	actual, err := typed.GetEntityByRecordID(ctx, record.DataSource, record.Id, -1)
+	testError(test, ctx, typed.G2engine, err)
+	assert.Equal(test, getEntityIdForRecord(record.DataSource, record.Id), actual.ResolvedEntity.EntityID)
+	printActual(test, actual)
+}
+
+func TestTyped_WhyRecords(test *testing.T) {
+	ctx := context.TODO()
+	typed := &Typed{G2engine: getTestObject(ctx, test)}
+	record1 := truthset.CustomerRecords["1001"]
+	record2 := truthset.CustomerRecords["1002"]
+	actual, err := typed.WhyRecords(ctx, record1.DataSource, record1.Id, record2.DataSource, record2.Id, -1)
+	testError(test, ctx, typed.G2engine, err)
+	assert.NotEmpty(test, actual.WhyResults)
+	printActual(test, actual)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleTyped_GetEntityByRecordID() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2engine/typed_test.go
+	ctx := context.TODO()
+	typed := &Typed{G2engine: getG2Engine(ctx)}
+	var flags int64 = 0
+	result, err := typed.GetEntityByRecordID(ctx, "CUSTOMERS", "1001", flags)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(result.ResolvedEntity.EntityID)
+	// Output: 1
+}
@@ -0,0 +1,7 @@
+/*
+The g2error package translates errors returned by a Senzing gRPC server into typed errors.
+The Senzing error code embedded in the gRPC status message (e.g. "0033E|Unknown record")
+is used to classify the error the same way the native Senzing Go SDK does,
+so callers can write code like g2error.Is(err, g2error.G2Retryable) regardless of SDK implementation.
+*/
+package g2error
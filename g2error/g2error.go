@@ -0,0 +1,245 @@
+/*
+ *
+ */
+
+// Package g2error translates gRPC errors into typed Senzing errors.
+package g2error
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// G2BaseError holds what is common to every typed Senzing error.
+type G2BaseError struct {
+	ErrorTypeIds  []G2ErrorTypeIds // Classifications, most specific first.
+	GrpcCode      codes.Code       // The gRPC status code returned by the server.
+	Message       string           // The gRPC status message; usually a Senzing JSON message.
+	SenzingCode   int              // The Senzing error code, or 0 if none was found.
+	originalError error
+}
+
+type G2BadUserInputError struct{ G2BaseError }
+type G2ConfigurationError struct{ G2BaseError }
+type G2DatabaseConnectionLostError struct{ G2BaseError }
+type G2DatabaseError struct{ G2BaseError }
+
+// G2DeadlineExceededError is returned when the deadline of a call expires before the server answers.
+type G2DeadlineExceededError struct {
+	G2BaseError
+	Method  string        // The SDK method, if the deadline was a client default; see g2deadline.
+	Timeout time.Duration // The client default timeout that expired, or 0 if the deadline was the caller's.
+}
+
+type G2LicenseError struct{ G2BaseError }
+type G2MissingDataSourceError struct{ G2BaseError }
+type G2NotFoundError struct{ G2BaseError }
+type G2NotInitializedError struct{ G2BaseError }
+type G2RetryableError struct{ G2BaseError }
+type G2UnhandledError struct{ G2BaseError }
+type G2UnrecoverableError struct{ G2BaseError }
+
+// Implemented by every typed Senzing error.
+type g2Error interface {
+	error
+	base() *G2BaseError
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Classification of gRPC status codes for errors that carry no Senzing error code.
+var grpcCodeTypes = map[codes.Code][]G2ErrorTypeIds{
+	codes.Aborted:            {G2Retryable},
+	codes.DeadlineExceeded:   {G2DeadlineExceeded, G2Retryable},
+	codes.FailedPrecondition: {G2BadUserInput},
+	codes.InvalidArgument:    {G2BadUserInput},
+	codes.NotFound:           {G2NotFound, G2BadUserInput},
+	codes.OutOfRange:         {G2BadUserInput},
+	codes.PermissionDenied:   {G2Unrecoverable},
+	codes.ResourceExhausted:  {G2Retryable},
+	codes.Unauthenticated:    {G2Unrecoverable},
+	codes.Unavailable:        {G2Retryable},
+}
+
+// Matches the "NNNNE|" prefix Senzing puts on error messages.
+var senzingCodePattern = regexp.MustCompile(`(\d{4,5})E\|`)
+
+// ----------------------------------------------------------------------------
+// G2BaseError methods
+// ----------------------------------------------------------------------------
+
+func (err *G2BaseError) base() *G2BaseError {
+	return err
+}
+
+// Error returns the message sent by the Senzing gRPC server.
+func (err *G2BaseError) Error() string {
+	return err.Message
+}
+
+// GRPCStatus allows status.FromError() and status.Code() to see the original gRPC status.
+func (err *G2BaseError) GRPCStatus() *status.Status {
+	return status.New(err.GrpcCode, err.Message)
+}
+
+// Unwrap returns the original gRPC error.
+func (err *G2BaseError) Unwrap() error {
+	return err.originalError
+}
+
+// ----------------------------------------------------------------------------
+// G2DeadlineExceededError methods
+// ----------------------------------------------------------------------------
+
+// Error tells a client default deadline apart from a deadline set by the caller.
+func (err *G2DeadlineExceededError) Error() string {
+	if err.Timeout > 0 {
+		return fmt.Sprintf("default deadline of %s for %s exceeded: %s", err.Timeout, err.Method, err.Message)
+	}
+	return err.Message
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newTypedError(baseError G2BaseError) error {
+	switch baseError.ErrorTypeIds[0] {
+	case G2BadUserInput:
+		return &G2BadUserInputError{baseError}
+	case G2Configuration:
+		return &G2ConfigurationError{baseError}
+	case G2Database:
+		return &G2DatabaseError{baseError}
+	case G2DatabaseConnectionLost:
+		return &G2DatabaseConnectionLostError{baseError}
+	case G2DeadlineExceeded:
+		return &G2DeadlineExceededError{G2BaseError: baseError}
+	case G2License:
+		return &G2LicenseError{baseError}
+	case G2MissingDataSource:
+		return &G2MissingDataSourceError{baseError}
+	case G2NotFound:
+		return &G2NotFoundError{baseError}
+	case G2NotInitialized:
+		return &G2NotInitializedError{baseError}
+	case G2Retryable:
+		return &G2RetryableError{baseError}
+	case G2Unrecoverable:
+		return &G2UnrecoverableError{baseError}
+	default:
+		return &G2UnhandledError{baseError}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Convert function translates an error returned by a gRPC call into a typed Senzing error.
+Errors that are nil, already translated, or not gRPC status errors are returned unchanged.
+
+Input
+  - err: The error returned by a generated gRPC client method.
+
+Output
+  - One of the G2...Error types, or err itself.
+*/
+func Convert(err error) error {
+	if err == nil {
+		return nil
+	}
+	var alreadyConverted g2Error
+	if errors.As(err, &alreadyConverted) {
+		return err
+	}
+	grpcStatus, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	baseError := G2BaseError{
+		GrpcCode:      grpcStatus.Code(),
+		Message:       grpcStatus.Message(),
+		SenzingCode:   G2ErrorCode(grpcStatus.Message()),
+		originalError: err,
+	}
+	if baseError.SenzingCode > 0 {
+		baseError.ErrorTypeIds = G2ErrorTypes[baseError.SenzingCode]
+	} else {
+		baseError.ErrorTypeIds = grpcCodeTypes[baseError.GrpcCode]
+	}
+	if len(baseError.ErrorTypeIds) == 0 {
+		baseError.ErrorTypeIds = []G2ErrorTypeIds{G2Unhandled}
+	}
+	return newTypedError(baseError)
+}
+
+/*
+The G2ErrorCode function extracts the Senzing error code from a message.
+
+Input
+  - message: A string containing text like "0033E|Unknown record".
+
+Output
+  - The Senzing error code (e.g. 33), or 0 if the message contains none.
+*/
+func G2ErrorCode(message string) int {
+	match := senzingCodePattern.FindStringSubmatch(message)
+	if match == nil {
+		return 0
+	}
+	result, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return result
+}
+
+/*
+The Is function reports whether err, or an error it wraps, is a typed Senzing error with the given classification.
+
+Input
+  - err: The error to inspect.
+  - errorType: The classification, e.g. G2Retryable.
+*/
+func Is(err error, errorType G2ErrorTypeIds) bool {
+	var typedError g2Error
+	if !errors.As(err, &typedError) {
+		return false
+	}
+	for _, errorTypeId := range typedError.base().ErrorTypeIds {
+		if errorTypeId == errorType {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+The IsInList function reports whether err has any of the given classifications.
+
+Input
+  - err: The error to inspect.
+  - errorTypes: The classifications to look for.
+*/
+func IsInList(err error, errorTypes []G2ErrorTypeIds) bool {
+	for _, errorType := range errorTypes {
+		if Is(err, errorType) {
+			return true
+		}
+	}
+	return false
+}
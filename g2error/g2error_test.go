@@ -0,0 +1,121 @@
+package g2error
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	badInputMessage = `{"id":"senzing-60044001","status":"ERROR","errors":[{"text":"0023E|Conflicting DATA_SOURCE values 'CUSTOMERS' and 'BOB'"}]}`
+	licenseMessage  = `{"id":"senzing-60164002","status":"ERROR","errors":[{"text":"0999E|License has expired"}]}`
+	notFoundMessage = `{"id":"senzing-60044032","status":"ERROR","errors":[{"text":"0033E|Unknown record: dsrc[CUSTOMERS], record[1001]"}]}`
+	unknownMessage  = `{"id":"senzing-60044032","status":"ERROR","errors":[{"text":"4242E|Something new"}]}`
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestG2error_Convert_Nil(test *testing.T) {
+	assert.Nil(test, Convert(nil))
+}
+
+func TestG2error_Convert_NotGrpc(test *testing.T) {
+	originalError := errors.New("not a gRPC error")
+	assert.Equal(test, originalError, Convert(originalError))
+}
+
+func TestG2error_Convert_BadUserInput(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, badInputMessage))
+	var typedError *G2BadUserInputError
+	assert.True(test, errors.As(err, &typedError))
+	assert.Equal(test, 23, typedError.SenzingCode)
+	assert.Equal(test, badInputMessage, err.Error())
+	assert.True(test, Is(err, G2BadUserInput))
+	assert.False(test, Is(err, G2Retryable))
+}
+
+func TestG2error_Convert_NotFound(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, notFoundMessage))
+	var typedError *G2NotFoundError
+	assert.True(test, errors.As(err, &typedError))
+	assert.True(test, Is(err, G2NotFound))
+	assert.True(test, Is(err, G2BadUserInput))
+}
+
+func TestG2error_Convert_License(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, licenseMessage))
+	var typedError *G2LicenseError
+	assert.True(test, errors.As(err, &typedError))
+	assert.True(test, Is(err, G2Unrecoverable))
+}
+
+func TestG2error_Convert_UnknownSenzingCode(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, unknownMessage))
+	var typedError *G2UnhandledError
+	assert.True(test, errors.As(err, &typedError))
+	assert.Equal(test, 4242, typedError.SenzingCode)
+}
+
+func TestG2error_Convert_Transport(test *testing.T) {
+	err := Convert(status.Error(codes.Unavailable, "connection refused"))
+	var typedError *G2RetryableError
+	assert.True(test, errors.As(err, &typedError))
+	assert.Equal(test, 0, typedError.SenzingCode)
+	assert.Equal(test, codes.Unavailable, status.Code(err))
+}
+
+func TestG2error_Convert_DeadlineExceeded(test *testing.T) {
+	err := Convert(status.Error(codes.DeadlineExceeded, "context deadline exceeded"))
+	var typedError *G2DeadlineExceededError
+	assert.True(test, errors.As(err, &typedError))
+	assert.True(test, Is(err, G2Retryable))
+	assert.Equal(test, "context deadline exceeded", err.Error())
+	typedError.Method = "PurgeRepository"
+	typedError.Timeout = time.Hour
+	assert.Equal(test, "default deadline of 1h0m0s for PurgeRepository exceeded: context deadline exceeded", err.Error())
+}
+
+func TestG2error_Convert_Idempotent(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, badInputMessage))
+	assert.Equal(test, err, Convert(err))
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	assert.Equal(test, wrapped, Convert(wrapped))
+	assert.True(test, Is(wrapped, G2BadUserInput))
+}
+
+func TestG2error_Unwrap(test *testing.T) {
+	originalError := status.Error(codes.Unknown, badInputMessage)
+	err := Convert(originalError)
+	assert.True(test, errors.Is(err, originalError))
+}
+
+func TestG2error_G2ErrorCode(test *testing.T) {
+	assert.Equal(test, 33, G2ErrorCode(notFoundMessage))
+	assert.Equal(test, 30121, G2ErrorCode("30121E|Malformed JSON"))
+	assert.Equal(test, 0, G2ErrorCode("no code here"))
+}
+
+func TestG2error_IsInList(test *testing.T) {
+	err := Convert(status.Error(codes.Unknown, notFoundMessage))
+	assert.True(test, IsInList(err, []G2ErrorTypeIds{G2Retryable, G2NotFound}))
+	assert.False(test, IsInList(err, []G2ErrorTypeIds{G2Retryable, G2License}))
+	assert.False(test, IsInList(errors.New("plain"), []G2ErrorTypeIds{G2Unhandled}))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleIs() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2error/g2error_test.go
+	err := Convert(status.Error(codes.Unknown, notFoundMessage))
+	fmt.Println(Is(err, G2NotFound))
+	// Output: true
+}
@@ -0,0 +1,77 @@
+package g2error
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Identifies a classification of Senzing error.
+type G2ErrorTypeIds int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Error classifications.
+const (
+	G2Base G2ErrorTypeIds = iota
+	G2BadUserInput
+	G2Configuration
+	G2Database
+	G2DatabaseConnectionLost
+	G2DeadlineExceeded
+	G2License
+	G2MissingDataSource
+	G2NotFound
+	G2NotInitialized
+	G2Retryable
+	G2Unhandled
+	G2Unrecoverable
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Classification of Senzing error codes (the "NNNN" in "NNNNE|message").
+// The first classification is the one used to choose the concrete error type.
+var G2ErrorTypes = map[int][]G2ErrorTypeIds{
+	2:     {G2BadUserInput},                        // Invalid value for XML/JSON
+	7:     {G2BadUserInput},                        // Unknown DSRC_ACTION
+	10:    {G2Retryable},                           // Retry timeout exceeded
+	14:    {G2Configuration, G2Unrecoverable},      // Invalid configuration
+	22:    {G2BadUserInput},                        // Feature value exceeds maximum length
+	23:    {G2BadUserInput},                        // Conflicting DATA_SOURCE values
+	24:    {G2BadUserInput},                        // Conflicting RECORD_ID values
+	27:    {G2NotFound, G2BadUserInput},            // Unknown feature ID
+	30:    {G2BadUserInput},                        // Invalid ENTITY_TYPE
+	33:    {G2NotFound, G2BadUserInput},            // Unknown record
+	37:    {G2NotFound, G2BadUserInput},            // Unknown resolved entity
+	48:    {G2NotInitialized, G2Unrecoverable},     // Module is not initialized
+	53:    {G2NotInitialized, G2Unrecoverable},     // Module is already initialized
+	54:    {G2Retryable},                           // Resolved entity could not be locked
+	63:    {G2Unrecoverable},                       // Unable to verify/initialize the engine
+	87:    {G2BadUserInput},                        // Invalid value for column
+	88:    {G2BadUserInput},                        // Invalid redo record
+	999:   {G2License, G2Unrecoverable},            // License has expired or is invalid
+	1001:  {G2Database, G2Unrecoverable},           // Database error
+	1007:  {G2DatabaseConnectionLost, G2Retryable}, // Database connection lost
+	1008:  {G2Database, G2Retryable},               // Database deadlock
+	2134:  {G2Retryable},                           // Failed to find resolved entity; retry
+	7209:  {G2Configuration, G2Unrecoverable},      // Configuration is missing
+	7211:  {G2Configuration, G2Unrecoverable},      // Configuration could not be loaded
+	7213:  {G2BadUserInput},                        // Record exceeds maximum size
+	7220:  {G2Configuration, G2Unrecoverable},      // No default configuration
+	7221:  {G2Configuration, G2Unrecoverable},      // Configuration ID not found
+	7223:  {G2Configuration, G2Retryable},          // Default configuration changed
+	7224:  {G2Configuration, G2Retryable},          // Configuration not current
+	7226:  {G2Configuration, G2Unrecoverable},      // Invalid configuration
+	7232:  {G2Configuration, G2Unrecoverable},      // Configuration comparison failed
+	7234:  {G2Configuration, G2Unrecoverable},      // Configuration data source missing
+	7245:  {G2Configuration, G2Retryable},          // Default configuration ID changed
+	7246:  {G2Configuration, G2Retryable},          // Compare-and-swap of default configuration ID failed
+	9000:  {G2License, G2Unrecoverable},            // Record limit of license exceeded
+	30020: {G2MissingDataSource, G2BadUserInput},   // Unknown data source
+	30121: {G2BadUserInput},                        // Malformed JSON
+	30122: {G2BadUserInput},                        // Malformed JSON
+	30123: {G2BadUserInput},                        // Malformed JSON
+}
@@ -0,0 +1,14 @@
+/*
+The g2flags package builds the int64 flags parameter taken by G2engine methods.
+
+Each Senzing flag is a named Flags constant, and presets combine the flags recommended for common calls.
+Flags compose with "|" or With() and Without(), and String() decodes a value into the names of its flags:
+
+	flags := g2flags.EntityDefault.Without(g2flags.EntityIncludeAllRelations).With(g2flags.EntityIncludeRecordJsonData)
+	fmt.Println(flags)
+	result, err := g2engine.GetEntityByEntityID_V2(ctx, entityID, flags.Int64())
+
+Validate() reports flags that have no effect on a method, and UnaryClientInterceptor() applies Validate()
+to every call made over a gRPC connection, failing the call before it is sent.
+*/
+package g2flags
@@ -0,0 +1,198 @@
+/*
+ *
+ */
+
+// Package g2flags names the flags taken by G2engine methods.
+package g2flags
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns the combination of the given flags.
+
+Input
+  - flags: Any number of single-bit flags, combinations or presets.
+*/
+func New(flags ...Flags) Flags {
+	var result Flags
+	for _, flag := range flags {
+		result |= flag
+	}
+	return result
+}
+
+/*
+The Parse function is the inverse of String().
+It accepts Senzing flag and preset names, with or without the "G2_" prefix, and decimal or hexadecimal
+numbers, separated by "|".
+
+Input
+  - value: A string such as "G2_ENTITY_INCLUDE_ENTITY_NAME | G2_ENTITY_INCLUDE_RECORD_DATA".
+
+Output
+  - The combination of the named flags.
+*/
+func Parse(value string) (Flags, error) {
+	var result Flags
+	for _, token := range strings.Split(value, "|") {
+		token = strings.ToUpper(strings.TrimSpace(token))
+		if len(token) == 0 {
+			return 0, fmt.Errorf("empty flag name in %q", value)
+		}
+		if number, err := strconv.ParseInt(token, 0, 64); err == nil {
+			result |= Flags(number)
+			continue
+		}
+		if !strings.HasPrefix(token, "G2_") {
+			token = "G2_" + token
+		}
+		flag, ok := lookup(token)
+		if !ok {
+			return 0, fmt.Errorf("unknown flag %q", token)
+		}
+		result |= flag
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func lookup(name string) (Flags, bool) {
+	for bit, flagName := range flagNames {
+		if flagName == name {
+			return Flags(1) << bit, true
+		}
+	}
+	flag, ok := presetNames[name]
+	return flag, ok
+}
+
+// The G2engine method name, without the gRPC service or the "_V2" suffix.
+func methodName(method string) string {
+	method = method[strings.LastIndex(method, "/")+1:]
+	return strings.TrimSuffix(method, "_V2")
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Has method reports whether all of the given flags are set.
+
+Input
+  - other: The flags to test.
+*/
+func (flags Flags) Has(other Flags) bool {
+	return flags&other == other
+}
+
+/*
+The Int64 method returns the value to pass as the flags parameter of a G2engine method.
+*/
+func (flags Flags) Int64() int64 {
+	return int64(flags)
+}
+
+/*
+The String method decodes flags into the Senzing names of the single-bit flags it contains, in bit order,
+separated by " | ". Bits without a name are shown in hexadecimal; no flags are shown as "0".
+*/
+func (flags Flags) String() string {
+	if flags == 0 {
+		return "0"
+	}
+	names := []string{}
+	remaining := uint64(flags)
+	for remaining != 0 {
+		bit := bits.TrailingZeros64(remaining)
+		if bit >= len(flagNames) {
+			names = append(names, fmt.Sprintf("0x%X", remaining))
+			break
+		}
+		names = append(names, flagNames[bit])
+		remaining &^= 1 << bit
+	}
+	return strings.Join(names, " | ")
+}
+
+/*
+The Validate method reports flags that have no effect on a G2engine method.
+Methods without a flags parameter are not checked.
+
+Input
+  - method: A G2engine method name, e.g. "GetEntityByEntityID_V2", or a full gRPC method name,
+    e.g. "/g2engine.G2Engine/GetEntityByEntityID_V2".
+
+Output
+  - nil if every flag applies to the method, otherwise an error naming the flags that do not.
+*/
+func (flags Flags) Validate(method string) error {
+	allowed, ok := methodFlags[methodName(method)]
+	if !ok {
+		return nil
+	}
+	irrelevant := flags &^ allowed
+	if irrelevant == 0 {
+		return nil
+	}
+	if allowed == noFlags {
+		return fmt.Errorf("%s takes no flags; got %s", method, irrelevant)
+	}
+	return fmt.Errorf("flags do not apply to %s: %s", method, irrelevant)
+}
+
+/*
+The With method returns flags with the other flags added.
+
+Input
+  - other: The flags to set.
+*/
+func (flags Flags) With(other ...Flags) Flags {
+	return flags | New(other...)
+}
+
+/*
+The Without method returns flags with the other flags removed.
+
+Input
+  - other: The flags to clear.
+*/
+func (flags Flags) Without(other ...Flags) Flags {
+	return flags &^ New(other...)
+}
+
+// ----------------------------------------------------------------------------
+// Interceptors
+// ----------------------------------------------------------------------------
+
+/*
+The UnaryClientInterceptor function returns a gRPC interceptor that applies Validate() to the flags of each
+G2engine request. Calls with irrelevant flags fail with codes.InvalidArgument without being sent.
+*/
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if flagged, ok := request.(interface{ GetFlags() int64 }); ok {
+			if err := Flags(flagged.GetFlags()).Validate(method); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		return invoker(ctx, method, request, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,205 @@
+package g2flags
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufferSize = 1024 * 1024
+)
+
+var (
+	listener *bufconn.Listener
+	server   *grpc.Server
+)
+
+// A G2engine server recording the flags it receives.
+type g2engineServer struct {
+	g2pb.UnimplementedG2EngineServer
+	flags []int64
+}
+
+func (server *g2engineServer) GetEntityByEntityID_V2(ctx context.Context, request *g2pb.GetEntityByEntityID_V2Request) (*g2pb.GetEntityByEntityID_V2Response, error) {
+	server.flags = append(server.flags, request.GetFlags())
+	return &g2pb.GetEntityByEntityID_V2Response{Result: `{}`}, nil
+}
+
+var engineServer = &g2engineServer{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func bufDialer(ctx context.Context, address string) (net.Conn, error) {
+	return listener.DialContext(ctx)
+}
+
+func getTestObject(ctx context.Context, test *testing.T) *g2engine.G2engine {
+	connection, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+	)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { connection.Close() })
+	return &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2pb.RegisterG2EngineServer(server, engineServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestFlags_MatchG2api(test *testing.T) {
+	// g2api defines G2_EXPORT_INCLUDE_RESOLVED as 0; Senzing defines it as bit 0.
+	assert.Equal(test, Flags(1), ExportIncludeResolved)
+	assert.Equal(test, int64(g2api.G2_EXPORT_INCLUDE_POSSIBLY_SAME), ExportIncludePossiblySame.Int64())
+	assert.Equal(test, int64(g2api.G2_ENTITY_INCLUDE_RECORD_JSON_DATA), EntityIncludeRecordJsonData.Int64())
+	assert.Equal(test, int64(g2api.G2_SEARCH_INCLUDE_STATS), SearchIncludeStats.Int64())
+	assert.Equal(test, int64(g2api.G2_ENTITY_DEFAULT_FLAGS), EntityDefault.Int64())
+	assert.Equal(test, int64(g2api.G2_ENTITY_BRIEF_DEFAULT_FLAGS), EntityBrief.Int64())
+	assert.Equal(test, int64(g2api.G2_FIND_PATH_DEFAULT_FLAGS), FindPathDefault.Int64())
+	assert.Equal(test, int64(g2api.G2_HOW_ENTITY_DEFAULT_FLAGS), HowEntityDefault.Int64())
+	assert.Equal(test, int64(g2api.G2_WHY_ENTITY_DEFAULT_FLAGS), WhyEntityDefault.Int64())
+	assert.Equal(test, int64(g2api.G2_RECORD_DEFAULT_FLAGS), RecordDefault.Int64())
+	assert.Equal(test, int64(g2api.G2_SEARCH_BY_ATTRIBUTES_STRONG|1), SearchByAttributesStrong.Int64())
+	assert.Equal(test, int64(g2api.G2_SEARCH_BY_ATTRIBUTES_MINIMAL_STRONG|1), SearchByAttributesMinimalStrong.Int64())
+	assert.Equal(test, len(flagNames), 28)
+}
+
+func TestFlags_String(test *testing.T) {
+	assert.Equal(test, "0", EntityMinimal.String())
+	assert.Equal(test, "G2_ENTITY_INCLUDE_ENTITY_NAME", EntityIncludeEntityName.String())
+	assert.Equal(test, "G2_EXPORT_INCLUDE_RESOLVED | G2_EXPORT_INCLUDE_SINGLETONS", ExportIncludeAllEntities.String())
+	assert.Equal(test, "G2_SEARCH_INCLUDE_STATS | 0x10000000", New(SearchIncludeStats, 1<<28).String())
+}
+
+func TestFlags_Parse(test *testing.T) {
+	for _, flags := range []Flags{EntityMinimal, EntityDefault, ExportDefault, HowEntityDefault, SearchByAttributesAll, New(SearchIncludeStats, 1<<40)} {
+		actual, err := Parse(flags.String())
+		assert.Nil(test, err)
+		assert.Equal(test, flags, actual)
+	}
+	actual, err := Parse("entity_include_entity_name|G2_RECORD_DEFAULT_FLAGS | 0x1")
+	assert.Nil(test, err)
+	assert.Equal(test, New(EntityIncludeEntityName, RecordDefault, ExportIncludeResolved), actual)
+	_, err = Parse("G2_NO_SUCH_FLAG")
+	assert.NotNil(test, err)
+	_, err = Parse("G2_ENTITY_INCLUDE_ENTITY_NAME ||")
+	assert.NotNil(test, err)
+}
+
+func TestFlags_WithWithout(test *testing.T) {
+	flags := EntityDefault.Without(EntityIncludeAllRelations).With(EntityIncludeRecordJsonData)
+	assert.False(test, flags.Has(EntityIncludePossiblySameRelations))
+	assert.True(test, flags.Has(EntityIncludeRecordJsonData|EntityIncludeEntityName))
+	assert.False(test, flags.Has(EntityIncludeRecordJsonData|EntityIncludeAllRelations))
+	assert.Equal(test, EntityDefault, flags.With(EntityIncludeAllRelations).Without(EntityIncludeRecordJsonData))
+}
+
+func TestFlags_Validate(test *testing.T) {
+	testCases := []struct {
+		flags  Flags
+		method string
+		valid  bool
+	}{
+		{EntityDefault, "GetEntityByEntityID_V2", true},
+		{EntityDefault | SearchIncludeStats, "GetEntityByEntityID_V2", false},
+		{ExportIncludeResolved, "/g2engine.G2Engine/GetEntityByRecordID_V2", false},
+		{ExportDefault, "ExportJSONEntityReport", true},
+		{RecordDefault | EntityIncludeRecordFormattedData, "GetRecord_V2", true},
+		{EntityIncludeEntityName, "GetRecord_V2", false},
+		{FindPathFull | FindPathPreferExclude, "FindPathExcludingByEntityID_V2", true},
+		{FindPathDefault | FindPathPreferExclude, "FindPathByEntityID_V2", false},
+		{HowEntityDefault, "HowEntityByEntityID_V2", true},
+		{WhyEntityDefault, "/g2engine.G2Engine/WhyRecords_V2", true},
+		{SearchByAttributesAll | SearchIncludeStats, "SearchByAttributes_V2", true},
+		{0, "AddRecordWithInfo", true},
+		{EntityDefault, "AddRecordWithInfo", false},
+		{-1, "GetEntityByEntityID_V2", false},
+		{-1, "NotAMethod", true},
+	}
+	for _, testCase := range testCases {
+		err := testCase.flags.Validate(testCase.method)
+		assert.Equal(test, testCase.valid, err == nil, "%s(%s): %v", testCase.method, testCase.flags, err)
+	}
+}
+
+func TestFlags_UnaryClientInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test)
+	engineServer.flags = nil
+	_, err := g2engine.GetEntityByEntityID_V2(ctx, 1, EntityDefault.Int64())
+	assert.Nil(test, err)
+	_, err = g2engine.GetEntityByEntityID_V2(ctx, 1, New(EntityDefault, SearchIncludeStats).Int64())
+	assert.True(test, g2error.Is(err, g2error.G2BadUserInput), err)
+	assert.Contains(test, err.Error(), "G2_SEARCH_INCLUDE_STATS")
+	assert.Equal(test, []int64{EntityDefault.Int64()}, engineServer.flags)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleFlags_String() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2flags/g2flags_test.go
+	flags := EntityBrief.Without(EntityIncludeAllRelations)
+	fmt.Println(flags)
+	// Output: G2_ENTITY_INCLUDE_RECORD_MATCHING_INFO | G2_ENTITY_INCLUDE_RELATED_MATCHING_INFO
+}
+
+func ExampleFlags_Validate() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2flags/g2flags_test.go
+	err := New(EntityDefault, SearchIncludeStats).Validate("GetEntityByEntityID_V2")
+	fmt.Println(err)
+	// Output: flags do not apply to GetEntityByEntityID_V2: G2_SEARCH_INCLUDE_STATS
+}
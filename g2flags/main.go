@@ -0,0 +1,180 @@
+package g2flags
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Flags is a combination of Senzing flags.
+type Flags int64
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Single-bit flags, in the order of their bit position.
+// Note: g2api.G2_EXPORT_INCLUDE_RESOLVED is 0; Senzing defines it as bit 0, as here.
+const (
+	ExportIncludeResolved                 Flags = 1 << iota // Include entities with "resolved" relationships.
+	ExportIncludePossiblySame                               // Include entities with "possibly same" relationships.
+	ExportIncludePossiblyRelated                            // Include entities with "possibly related" relationships.
+	ExportIncludeNameOnly                                   // Include entities with "name only" relationships.
+	ExportIncludeDisclosed                                  // Include entities with "disclosed" relationships.
+	ExportIncludeSingletons                                 // Include singleton entities.
+	EntityIncludePossiblySameRelations                      // Include "possibly same" relationships on entities.
+	EntityIncludePossiblyRelatedRelations                   // Include "possibly related" relationships on entities.
+	EntityIncludeNameOnlyRelations                          // Include "name only" relationships on entities.
+	EntityIncludeDisclosedRelations                         // Include "disclosed" relationships on entities.
+	EntityIncludeAllFeatures                                // Include all features of entities.
+	EntityIncludeRepresentativeFeatures                     // Include only representative features of entities.
+	EntityIncludeEntityName                                 // Include the name of the entity.
+	EntityIncludeRecordSummary                              // Include the record summary of the entity.
+	EntityIncludeRecordData                                 // Include the basic record data of the entity.
+	EntityIncludeRecordMatchingInfo                         // Include the record matching info of the entity.
+	EntityIncludeRecordJsonData                             // Include the record JSON data of the entity.
+	EntityIncludeRecordFormattedData                        // Include the record formatted data of the entity.
+	EntityIncludeRecordFeatureIds                           // Include the feature identifiers of the records.
+	EntityIncludeRelatedEntityName                          // Include the name of the related entities.
+	EntityIncludeRelatedMatchingInfo                        // Include the record matching info of the related entities.
+	EntityIncludeRelatedRecordSummary                       // Include the record summary of the related entities.
+	EntityIncludeRelatedRecordData                          // Include the basic record data of the related entities.
+	EntityOptionIncludeInternalFeatures                     // Include internal features.
+	EntityOptionIncludeFeatureStats                         // Include statistics on features.
+	FindPathPreferExclude                                   // Excluded entities are allowed in paths, but not preferred.
+	IncludeFeatureScores                                    // Include feature scores.
+	SearchIncludeStats                                      // Include statistics of search results.
+)
+
+// Combinations of single-bit flags.
+const (
+	EntityIncludeAllRelations     = EntityIncludePossiblySameRelations | EntityIncludePossiblyRelatedRelations | EntityIncludeNameOnlyRelations | EntityIncludeDisclosedRelations
+	ExportIncludeAllEntities      = ExportIncludeResolved | ExportIncludeSingletons
+	ExportIncludeAllRelationships = ExportIncludePossiblySame | ExportIncludePossiblyRelated | ExportIncludeNameOnly | ExportIncludeDisclosed
+	SearchIncludeAllEntities      = SearchIncludeResolved | SearchIncludePossiblySame | SearchIncludePossiblyRelated | SearchIncludeNameOnly
+	SearchIncludeFeatureScores    = IncludeFeatureScores
+	SearchIncludeNameOnly         = ExportIncludeNameOnly
+	SearchIncludePossiblyRelated  = ExportIncludePossiblyRelated
+	SearchIncludePossiblySame     = ExportIncludePossiblySame
+	SearchIncludeResolved         = ExportIncludeResolved
+)
+
+// Presets recommended for common calls.
+const (
+	EntityBrief                           = EntityIncludeRecordMatchingInfo | EntityIncludeAllRelations | EntityIncludeRelatedMatchingInfo
+	EntityDefault                         = EntityIncludeAllRelations | EntityIncludeRepresentativeFeatures | EntityIncludeEntityName | EntityIncludeRecordSummary | EntityIncludeRecordData | EntityIncludeRecordMatchingInfo | EntityIncludeRelatedEntityName | EntityIncludeRelatedRecordSummary | EntityIncludeRelatedMatchingInfo
+	EntityMinimal                   Flags = 0 // Entity identifiers only.
+	ExportDefault                         = ExportIncludeAllEntities | ExportIncludeAllRelationships | EntityIncludeAllRelations | EntityIncludeRepresentativeFeatures | EntityIncludeEntityName | EntityIncludeRecordData | EntityIncludeRecordMatchingInfo | EntityIncludeRelatedMatchingInfo
+	FindNetworkDefault                    = FindPathDefault
+	FindPathDefault                       = EntityIncludeAllRelations | EntityIncludeEntityName | EntityIncludeRecordSummary | EntityIncludeRelatedMatchingInfo
+	FindPathFull                          = FindPathDefault | EntityIncludeRepresentativeFeatures | EntityIncludeRecordData | EntityIncludeRecordMatchingInfo | EntityIncludeRelatedEntityName | EntityIncludeRelatedRecordSummary
+	HowEntityDefault                      = EntityDefault | EntityIncludeRecordFeatureIds | EntityOptionIncludeInternalFeatures | EntityOptionIncludeFeatureStats | IncludeFeatureScores
+	RecordDefault                         = EntityIncludeRecordJsonData
+	SearchByAttributesAll                 = SearchIncludeAllEntities | EntityIncludeRepresentativeFeatures | EntityIncludeEntityName | EntityIncludeRecordSummary | SearchIncludeFeatureScores
+	SearchByAttributesDefault             = SearchByAttributesAll
+	SearchByAttributesMinimalAll          = SearchIncludeAllEntities
+	SearchByAttributesMinimalStrong       = SearchIncludeResolved | SearchIncludePossiblySame
+	SearchByAttributesStrong              = SearchIncludeResolved | SearchIncludePossiblySame | EntityIncludeRepresentativeFeatures | EntityIncludeEntityName | EntityIncludeRecordSummary | SearchIncludeFeatureScores
+	WhyEntityDefault                      = HowEntityDefault
+)
+
+// Flags of each kind, used to decide which flags apply to a method.
+const (
+	entityFlags  = EntityIncludeAllRelations | EntityIncludeAllFeatures | EntityIncludeRepresentativeFeatures | EntityIncludeEntityName | EntityIncludeRecordSummary | recordFlags | EntityIncludeRecordMatchingInfo | EntityIncludeRelatedEntityName | EntityIncludeRelatedMatchingInfo | EntityIncludeRelatedRecordSummary | EntityIncludeRelatedRecordData | EntityOptionIncludeInternalFeatures | EntityOptionIncludeFeatureStats
+	exportFlags  = ExportIncludeAllEntities | ExportIncludeAllRelationships
+	noFlags      = Flags(0)
+	recordFlags  = EntityIncludeRecordData | EntityIncludeRecordJsonData | EntityIncludeRecordFormattedData | EntityIncludeRecordFeatureIds
+	searchFlags  = SearchIncludeAllEntities | SearchIncludeFeatureScores | SearchIncludeStats
+	unknownFlags = Flags(-1)
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Senzing names of the single-bit flags, in the order of their bit position.
+var flagNames = []string{
+	"G2_EXPORT_INCLUDE_RESOLVED",
+	"G2_EXPORT_INCLUDE_POSSIBLY_SAME",
+	"G2_EXPORT_INCLUDE_POSSIBLY_RELATED",
+	"G2_EXPORT_INCLUDE_NAME_ONLY",
+	"G2_EXPORT_INCLUDE_DISCLOSED",
+	"G2_EXPORT_INCLUDE_SINGLETONS",
+	"G2_ENTITY_INCLUDE_POSSIBLY_SAME_RELATIONS",
+	"G2_ENTITY_INCLUDE_POSSIBLY_RELATED_RELATIONS",
+	"G2_ENTITY_INCLUDE_NAME_ONLY_RELATIONS",
+	"G2_ENTITY_INCLUDE_DISCLOSED_RELATIONS",
+	"G2_ENTITY_INCLUDE_ALL_FEATURES",
+	"G2_ENTITY_INCLUDE_REPRESENTATIVE_FEATURES",
+	"G2_ENTITY_INCLUDE_ENTITY_NAME",
+	"G2_ENTITY_INCLUDE_RECORD_SUMMARY",
+	"G2_ENTITY_INCLUDE_RECORD_DATA",
+	"G2_ENTITY_INCLUDE_RECORD_MATCHING_INFO",
+	"G2_ENTITY_INCLUDE_RECORD_JSON_DATA",
+	"G2_ENTITY_INCLUDE_RECORD_FORMATTED_DATA",
+	"G2_ENTITY_INCLUDE_RECORD_FEATURE_IDS",
+	"G2_ENTITY_INCLUDE_RELATED_ENTITY_NAME",
+	"G2_ENTITY_INCLUDE_RELATED_MATCHING_INFO",
+	"G2_ENTITY_INCLUDE_RELATED_RECORD_SUMMARY",
+	"G2_ENTITY_INCLUDE_RELATED_RECORD_DATA",
+	"G2_ENTITY_OPTION_INCLUDE_INTERNAL_FEATURES",
+	"G2_ENTITY_OPTION_INCLUDE_FEATURE_STATS",
+	"G2_FIND_PATH_PREFER_EXCLUDE",
+	"G2_INCLUDE_FEATURE_SCORES",
+	"G2_SEARCH_INCLUDE_STATS",
+}
+
+// Preset names accepted by Parse().
+var presetNames = map[string]Flags{
+	"G2_ENTITY_BRIEF_DEFAULT_FLAGS":          EntityBrief,
+	"G2_ENTITY_DEFAULT_FLAGS":                EntityDefault,
+	"G2_ENTITY_INCLUDE_ALL_RELATIONS":        EntityIncludeAllRelations,
+	"G2_EXPORT_DEFAULT_FLAGS":                ExportDefault,
+	"G2_EXPORT_INCLUDE_ALL_ENTITIES":         ExportIncludeAllEntities,
+	"G2_EXPORT_INCLUDE_ALL_RELATIONSHIPS":    ExportIncludeAllRelationships,
+	"G2_FIND_PATH_DEFAULT_FLAGS":             FindPathDefault,
+	"G2_HOW_ENTITY_DEFAULT_FLAGS":            HowEntityDefault,
+	"G2_RECORD_DEFAULT_FLAGS":                RecordDefault,
+	"G2_SEARCH_BY_ATTRIBUTES_ALL":            SearchByAttributesAll,
+	"G2_SEARCH_BY_ATTRIBUTES_DEFAULT_FLAGS":  SearchByAttributesDefault,
+	"G2_SEARCH_BY_ATTRIBUTES_MINIMAL_ALL":    SearchByAttributesMinimalAll,
+	"G2_SEARCH_BY_ATTRIBUTES_MINIMAL_STRONG": SearchByAttributesMinimalStrong,
+	"G2_SEARCH_BY_ATTRIBUTES_STRONG":         SearchByAttributesStrong,
+	"G2_SEARCH_INCLUDE_ALL_ENTITIES":         SearchIncludeAllEntities,
+	"G2_WHY_ENTITY_DEFAULT_FLAGS":            WhyEntityDefault,
+}
+
+// The flags that have an effect on each G2engine method taking flags, by method name without the "_V2" suffix.
+// Methods whose flags are reserved by Senzing accept only 0.
+var methodFlags = map[string]Flags{
+	"AddRecordWithInfo":                     noFlags,
+	"AddRecordWithInfoWithReturnedRecordID": noFlags,
+	"DeleteRecordWithInfo":                  noFlags,
+	"ExportCSVEntityReport":                 exportFlags | entityFlags,
+	"ExportJSONEntityReport":                exportFlags | entityFlags,
+	"FindInterestingEntitiesByEntityID":     noFlags,
+	"FindInterestingEntitiesByRecordID":     noFlags,
+	"FindNetworkByEntityID":                 entityFlags,
+	"FindNetworkByRecordID":                 entityFlags,
+	"FindPathByEntityID":                    entityFlags,
+	"FindPathByRecordID":                    entityFlags,
+	"FindPathExcludingByEntityID":           entityFlags | FindPathPreferExclude,
+	"FindPathExcludingByRecordID":           entityFlags | FindPathPreferExclude,
+	"FindPathIncludingSourceByEntityID":     entityFlags | FindPathPreferExclude,
+	"FindPathIncludingSourceByRecordID":     entityFlags | FindPathPreferExclude,
+	"GetEntityByEntityID":                   entityFlags,
+	"GetEntityByRecordID":                   entityFlags,
+	"GetRecord":                             recordFlags,
+	"GetVirtualEntityByRecordID":            entityFlags,
+	"HowEntityByEntityID":                   entityFlags | IncludeFeatureScores,
+	"ProcessRedoRecordWithInfo":             noFlags,
+	"ProcessWithInfo":                       noFlags,
+	"ReevaluateEntity":                      noFlags,
+	"ReevaluateEntityWithInfo":              noFlags,
+	"ReevaluateRecord":                      noFlags,
+	"ReevaluateRecordWithInfo":              noFlags,
+	"ReplaceRecordWithInfo":                 noFlags,
+	"SearchByAttributes":                    searchFlags | entityFlags,
+	"WhyEntities":                           entityFlags | IncludeFeatureScores,
+	"WhyEntityByEntityID":                   entityFlags | IncludeFeatureScores,
+	"WhyEntityByRecordID":                   entityFlags | IncludeFeatureScores,
+	"WhyRecords":                            entityFlags | IncludeFeatureScores,
+}
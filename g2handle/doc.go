@@ -0,0 +1,32 @@
+/*
+The g2handle package tracks the handles that Senzing SDK calls create on the server.
+
+G2config.Create(), G2engine.ExportJSONEntityReport(), G2engine.ExportCSVEntityReport() and
+G2diagnostic.GetEntityListBySize() return a uintptr naming an object held by the server that created it.
+A handle that is never closed leaks on the server, and a handle used through a connection that has
+reconnected to a different server is, at best, rejected as invalid.
+
+The G2config, G2diagnostic and G2engine clients record each handle they create in a Tracker,
+with the server it was created on, and forget it when it is closed:
+
+  - OutstandingHandles() lists the handles still open, where they were created and when they were last used.
+  - A call given a handle that reaches a different server than the one that created it
+    returns an *AffinityError, even if the call succeeded.
+  - A handle open longer than the LeakThreshold is reported, once: the client that created it logs a warning,
+    which its SetLogLevel() can filter out, unless the Tracker has a LeakHandler.
+  - Destroy() closes the handles the client created that are still open before destroying the Senzing object.
+
+Each client has a Tracker of its own, created by NewTracker(). To change the threshold, or to
+share one Tracker between clients, give them one with SetHandleTracker() or g2client.WithHandleTracker().
+The Tracker records which client opened each handle, so OutstandingHandles() and Destroy() of a client
+only see its own handles:
+
+	tracker := g2handle.NewTracker()
+	tracker.LeakThreshold = 5 * time.Minute
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithHandleTracker(tracker))
+
+The server a call reached is known from its peer address. Servers behind a proxy or load balancer
+share its address, so a Tracker cannot tell them apart; give each server an address of its own,
+e.g. with g2client.WithEndpoints(), to keep handles on the server that created them.
+*/
+package g2handle
@@ -0,0 +1,326 @@
+/*
+ *
+ */
+
+// Package g2handle tracks the handles that Senzing SDK calls create on the server.
+package g2handle
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Tracker records the server-side handles that are open.
+Set its exported fields before the first handle is opened. It is safe for concurrent use.
+*/
+type Tracker struct {
+	LeakHandler   func(message string) // If set, called once for each handle open longer than LeakThreshold, instead of the leak handler given to Open().
+	LeakThreshold time.Duration        // How long a handle may stay open; 0 never reports leaks.
+	handles       map[handleKey]*entry
+	lock          sync.Mutex
+}
+
+// AffinityError is returned by a call given a handle that reached a different server than the one that created it.
+type AffinityError struct {
+	Address string // The server the call reached.
+	Err     error  // The error of the call, or nil if it succeeded on the wrong server.
+	Handle  Handle // The handle, as created.
+	Method  string // The method called with the handle, e.g. "FetchNext".
+}
+
+// Handle describes an open server-side handle, as reported by Outstanding().
+type Handle struct {
+	Address    string    // The server that created the handle, or "" if unknown.
+	Caller     string    // The file and line of the call that created the handle.
+	CreatedAt  time.Time // When the handle was created.
+	Kind       Kind      // What the handle names.
+	LastUsedAt time.Time // When the handle was last given to a call; CreatedAt if never.
+	Method     string    // The method that created the handle, e.g. "ExportJSONEntityReport".
+	Value      uintptr   // The handle returned by Method.
+}
+
+// Kind is what a handle names: ConfigHandle, EntityListBySizeHandle or ExportHandle.
+type Kind string
+
+// An open handle, the client that opened it and the timer reporting it as leaked.
+type entry struct {
+	handle      Handle
+	leakHandler func(message string)
+	owner       interface{}
+	timer       *time.Timer
+}
+
+// Handles of different kinds may have the same value.
+type handleKey struct {
+	kind  Kind
+	value uintptr
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewTracker function returns a Tracker reporting handles open longer than DefaultLeakThreshold
+to the leak handler given to Open().
+*/
+func NewTracker() *Tracker {
+	return &Tracker{
+		LeakThreshold: DefaultLeakThreshold,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The address of a peer, or "" if the call did not reach a server, e.g. when it was replayed.
+func addressOf(address net.Addr) string {
+	if address == nil {
+		return ""
+	}
+	return address.String()
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Whether a handle of that kind, opened by that owner, is selected; nil selects every owner and no kinds every kind.
+func (opened *entry) isSelected(owner interface{}, kinds []Kind) bool {
+	if owner != nil && opened.owner != owner {
+		return false
+	}
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, kind := range kinds {
+		if opened.handle.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Report a handle that is still open once LeakThreshold has passed.
+func (tracker *Tracker) reportLeak(key handleKey, opened *entry) {
+	tracker.lock.Lock()
+	if tracker.handles[key] != opened {
+		tracker.lock.Unlock()
+		return
+	}
+	handle := opened.handle
+	tracker.lock.Unlock()
+	leakHandler := tracker.LeakHandler
+	if leakHandler == nil {
+		leakHandler = opened.leakHandler
+	}
+	if leakHandler == nil {
+		return
+	}
+	leakHandler(fmt.Sprintf("g2handle: %s has been open for more than %s; close it, or it leaks on the server", handle, tracker.LeakThreshold))
+}
+
+// Check that a call given a handle reached the server that created it, and note its use.
+func (tracker *Tracker) use(kind Kind, value uintptr, method string, address net.Addr, err error) (*entry, error) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	opened, ok := tracker.handles[handleKey{kind: kind, value: value}]
+	if !ok {
+		return nil, err
+	}
+	calledAddress := addressOf(address)
+	if opened.handle.Address != "" && calledAddress != "" && opened.handle.Address != calledAddress {
+		return opened, &AffinityError{
+			Address: calledAddress,
+			Err:     err,
+			Handle:  opened.handle,
+			Method:  method,
+		}
+	}
+	opened.handle.LastUsedAt = time.Now()
+	return opened, err
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Error method describes the call that reached the wrong server.
+*/
+func (err *AffinityError) Error() string {
+	result := fmt.Sprintf("g2handle: %s called with %s %d created on %s, but the call reached %s", err.Method, err.Handle.Kind, err.Handle.Value, err.Handle.Address, err.Address)
+	if err.Err != nil {
+		result = fmt.Sprintf("%s: %v", result, err.Err)
+	}
+	return result
+}
+
+/*
+The Unwrap method returns the error of the call, if any.
+*/
+func (err *AffinityError) Unwrap() error {
+	return err.Err
+}
+
+/*
+The String method describes a handle and where it was created.
+*/
+func (handle Handle) String() string {
+	result := fmt.Sprintf("%s %d created by %s at %s", handle.Kind, handle.Value, handle.Method, handle.Caller)
+	if handle.Address != "" {
+		result = fmt.Sprintf("%s on %s", result, handle.Address)
+	}
+	return result
+}
+
+/*
+The Forget method forgets the handles of some kinds without closing them,
+e.g. once Destroy() has released them on the server.
+
+Input
+  - owner: The client whose handles to forget, as given to Open(); nil forgets the handles of every client.
+  - kinds: The kinds of handle to forget; none forgets every kind.
+*/
+func (tracker *Tracker) Forget(owner interface{}, kinds ...Kind) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	for key, opened := range tracker.handles {
+		if opened.isSelected(owner, kinds) {
+			if opened.timer != nil {
+				opened.timer.Stop()
+			}
+			delete(tracker.handles, key)
+		}
+	}
+}
+
+/*
+The Open method records a handle created by a call.
+
+Input
+  - kind: What the handle names.
+  - value: The handle.
+  - method: The method that created the handle, e.g. "Create".
+  - address: The peer address of the server the call reached, or nil if unknown.
+  - owner: The client that made the call, so a Tracker shared between clients can tell their handles apart.
+  - leakHandler: Called if the handle is open longer than LeakThreshold, unless the Tracker has a LeakHandler;
+    the clients log a warning. If nil, the leak is not reported.
+*/
+func (tracker *Tracker) Open(kind Kind, value uintptr, method string, address net.Addr, owner interface{}, leakHandler func(message string)) {
+	now := time.Now()
+	opened := &entry{
+		handle: Handle{
+			Address:    addressOf(address),
+			Caller:     "unknown location",
+			CreatedAt:  now,
+			Kind:       kind,
+			LastUsedAt: now,
+			Method:     method,
+			Value:      value,
+		},
+		leakHandler: leakHandler,
+		owner:       owner,
+	}
+	// Skip this method and the client method calling it.
+	if _, file, line, ok := runtime.Caller(2); ok {
+		opened.handle.Caller = fmt.Sprintf("%s:%d", file, line)
+	}
+	key := handleKey{kind: kind, value: value}
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	if tracker.handles == nil {
+		tracker.handles = map[handleKey]*entry{}
+	}
+	if previous, ok := tracker.handles[key]; ok && previous.timer != nil {
+		previous.timer.Stop()
+	}
+	if tracker.LeakThreshold > 0 {
+		opened.timer = time.AfterFunc(tracker.LeakThreshold, func() { tracker.reportLeak(key, opened) })
+	}
+	tracker.handles[key] = opened
+}
+
+/*
+The Outstanding method returns the handles that are open, oldest first.
+
+Input
+  - owner: The client whose handles to return, as given to Open(); nil returns the handles of every client.
+  - kinds: The kinds of handle to return; none returns every kind.
+*/
+func (tracker *Tracker) Outstanding(owner interface{}, kinds ...Kind) []Handle {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	result := []Handle{}
+	for _, opened := range tracker.handles {
+		if opened.isSelected(owner, kinds) {
+			result = append(result, opened.handle)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+/*
+The Release method checks a call that closed a handle, and forgets the handle if it succeeded on the server that created it.
+A handle whose close failed stays open, so Destroy() can close it again.
+
+Input
+  - kind: What the handle names.
+  - value: The handle.
+  - method: The method called, e.g. "CloseExport".
+  - address: The peer address of the server the call reached, or nil if unknown.
+  - err: The error of the call.
+
+Output
+  - err, or an *AffinityError wrapping it if the call reached a different server.
+*/
+func (tracker *Tracker) Release(kind Kind, value uintptr, method string, address net.Addr, err error) error {
+	opened, err := tracker.use(kind, value, method, address, err)
+	if opened == nil || err != nil {
+		return err
+	}
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	key := handleKey{kind: kind, value: value}
+	if tracker.handles[key] == opened {
+		if opened.timer != nil {
+			opened.timer.Stop()
+		}
+		delete(tracker.handles, key)
+	}
+	return nil
+}
+
+/*
+The Use method checks a call given a handle and notes when the handle was last used.
+
+Input
+  - kind: What the handle names.
+  - value: The handle.
+  - method: The method called, e.g. "FetchNext".
+  - address: The peer address of the server the call reached, or nil if unknown.
+  - err: The error of the call.
+
+Output
+  - err, or an *AffinityError wrapping it if the call reached a different server.
+*/
+func (tracker *Tracker) Use(kind Kind, value uintptr, method string, address net.Addr, err error) error {
+	_, err = tracker.use(kind, value, method, address, err)
+	return err
+}
@@ -0,0 +1,159 @@
+package g2handle
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	serverA = &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 8258}
+	serverB = &net.TCPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 8258}
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A Tracker that never reports leaks.
+func getTestObject() *Tracker {
+	tracker := NewTracker()
+	tracker.LeakThreshold = 0
+	return tracker
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestTracker_Open(test *testing.T) {
+	tracker := getTestObject()
+	tracker.Open(ExportHandle, 7, "ExportJSONEntityReport", serverA, nil, nil)
+	tracker.Open(ConfigHandle, 8, "Create", nil, nil, nil)
+	handles := tracker.Outstanding(nil)
+	assert.Len(test, handles, 2)
+	assert.Equal(test, ExportHandle, handles[0].Kind)
+	assert.Equal(test, uintptr(7), handles[0].Value)
+	assert.Equal(test, "ExportJSONEntityReport", handles[0].Method)
+	assert.Equal(test, "10.0.0.1:8258", handles[0].Address)
+	assert.Equal(test, handles[0].CreatedAt, handles[0].LastUsedAt)
+	assert.Equal(test, "", handles[1].Address)
+	assert.Len(test, tracker.Outstanding(nil, ConfigHandle), 1)
+	assert.Empty(test, tracker.Outstanding(nil, EntityListBySizeHandle))
+}
+
+func TestTracker_Release(test *testing.T) {
+	tracker := getTestObject()
+	tracker.Open(ConfigHandle, 1, "Create", serverA, nil, nil)
+	callErr := errors.New("call failed")
+	assert.Equal(test, callErr, tracker.Release(ConfigHandle, 1, "Close", serverA, callErr))
+	assert.Len(test, tracker.Outstanding(nil), 1, "a handle whose close failed stays open")
+	assert.NoError(test, tracker.Release(ConfigHandle, 1, "Close", serverA, nil))
+	assert.Empty(test, tracker.Outstanding(nil))
+	assert.NoError(test, tracker.Release(ConfigHandle, 1, "Close", serverA, nil), "unknown handles are not checked")
+}
+
+func TestTracker_Use(test *testing.T) {
+	tracker := getTestObject()
+	tracker.Open(ExportHandle, 1, "ExportJSONEntityReport", serverA, nil, nil)
+	created := tracker.Outstanding(nil)[0]
+	time.Sleep(time.Millisecond)
+	assert.NoError(test, tracker.Use(ExportHandle, 1, "FetchNext", serverA, nil))
+	assert.True(test, tracker.Outstanding(nil)[0].LastUsedAt.After(created.LastUsedAt))
+	assert.NoError(test, tracker.Use(ExportHandle, 1, "FetchNext", nil, nil), "a call whose server is unknown is not checked")
+	assert.NoError(test, tracker.Use(ExportHandle, 2, "FetchNext", serverB, nil))
+}
+
+func TestTracker_Use_Affinity(test *testing.T) {
+	tracker := getTestObject()
+	tracker.Open(ExportHandle, 1, "ExportJSONEntityReport", serverA, nil, nil)
+	callErr := errors.New("invalid export handle")
+	err := tracker.Use(ExportHandle, 1, "FetchNext", serverB, callErr)
+	var affinityError *AffinityError
+	assert.ErrorAs(test, err, &affinityError)
+	assert.ErrorIs(test, err, callErr)
+	assert.Equal(test, "10.0.0.2:8258", affinityError.Address)
+	assert.Equal(test, "10.0.0.1:8258", affinityError.Handle.Address)
+	assert.Equal(test, "FetchNext", affinityError.Method)
+	assert.Contains(test, err.Error(), "FetchNext called with exportHandle 1 created on 10.0.0.1:8258, but the call reached 10.0.0.2:8258")
+
+	// A call reaching the wrong server fails even if it succeeded, and does not close the handle.
+	err = tracker.Release(ExportHandle, 1, "CloseExport", serverB, nil)
+	assert.ErrorAs(test, err, &affinityError)
+	assert.Nil(test, affinityError.Err)
+	assert.Len(test, tracker.Outstanding(nil), 1)
+}
+
+func TestTracker_Forget(test *testing.T) {
+	tracker := getTestObject()
+	tracker.Open(ConfigHandle, 1, "Create", serverA, nil, nil)
+	tracker.Open(ExportHandle, 2, "ExportJSONEntityReport", serverA, nil, nil)
+	tracker.Forget(nil, ConfigHandle)
+	handles := tracker.Outstanding(nil)
+	assert.Len(test, handles, 1)
+	assert.Equal(test, ExportHandle, handles[0].Kind)
+}
+
+func TestTracker_Owner(test *testing.T) {
+	tracker := getTestObject()
+	first := &struct{ name string }{"first"}
+	second := &struct{ name string }{"second"}
+	tracker.Open(ExportHandle, 1, "ExportJSONEntityReport", serverA, first, nil)
+	tracker.Open(ExportHandle, 2, "ExportJSONEntityReport", serverA, second, nil)
+
+	// Each client sees, and forgets, only the handles it opened.
+	handles := tracker.Outstanding(first, ExportHandle)
+	assert.Len(test, handles, 1)
+	assert.Equal(test, uintptr(1), handles[0].Value)
+	tracker.Forget(first, ExportHandle)
+	assert.Empty(test, tracker.Outstanding(first))
+	handles = tracker.Outstanding(nil)
+	assert.Len(test, handles, 1)
+	assert.Equal(test, uintptr(2), handles[0].Value)
+}
+
+func TestTracker_LeakHandler(test *testing.T) {
+	lock := sync.Mutex{}
+	messages := []string{}
+	tracker := NewTracker()
+	tracker.LeakThreshold = 10 * time.Millisecond
+	tracker.LeakHandler = func(message string) {
+		lock.Lock()
+		defer lock.Unlock()
+		messages = append(messages, message)
+	}
+	tracker.Open(ConfigHandle, 1, "Create", serverA, nil, nil)
+	tracker.Open(ConfigHandle, 2, "Create", serverA, nil, nil)
+	assert.NoError(test, tracker.Release(ConfigHandle, 2, "Close", serverA, nil))
+	time.Sleep(50 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Len(test, messages, 1, "only the handle still open is reported, once")
+	assert.Contains(test, messages[0], "configHandle 1 created by Create at ")
+	assert.Contains(test, messages[0], "on 10.0.0.1:8258")
+}
+
+func TestTracker_Open_LeakHandler(test *testing.T) {
+	first := make(chan string, 1)
+	second := make(chan string, 1)
+	tracker := NewTracker()
+	tracker.LeakThreshold = 10 * time.Millisecond
+	tracker.Open(ConfigHandle, 1, "Create", serverA, nil, func(message string) { first <- message })
+	tracker.Open(ExportHandle, 2, "ExportJSONEntityReport", serverB, nil, func(message string) { second <- message })
+	tracker.Open(ExportHandle, 3, "ExportJSONEntityReport", serverB, nil, nil)
+
+	// Each handle is reported to the leak handler it was opened with; one opened without is not reported.
+	assert.Contains(test, <-first, "configHandle 1 created by Create at ")
+	assert.Contains(test, <-second, "exportHandle 2 created by ExportJSONEntityReport at ")
+	select {
+	case message := <-first:
+		assert.Fail(test, "unexpected report: "+message)
+	case message := <-second:
+		assert.Fail(test, "unexpected report: "+message)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,17 @@
+package g2handle
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The kinds of server-side handle.
+const (
+	ConfigHandle           Kind = "configHandle"           // Created by G2config.Create().
+	EntityListBySizeHandle Kind = "entityListBySizeHandle" // Created by G2diagnostic.GetEntityListBySize().
+	ExportHandle           Kind = "exportHandle"           // Created by G2engine.ExportCSVEntityReport() or ExportJSONEntityReport().
+)
+
+// How long a handle may stay open before NewTracker() trackers report it as leaked.
+const DefaultLeakThreshold = 30 * time.Minute
@@ -0,0 +1,21 @@
+/*
+The g2health package reports whether a Senzing gRPC server is reachable and ready for calls.
+
+A Checker runs these checks, in order:
+  - "connection": the gRPC connection becomes ready;
+  - "grpc-health": the server's standard gRPC health service, grpc.health.v1.Health, reports SERVING;
+    servers without the service skip this check;
+  - "configuration": the engine's active configuration is the default configuration, so the server
+    has picked up the latest configuration change;
+  - "license": the Senzing license has not expired; it warns during the last LicenseWarningPeriod.
+
+When the connection is not ready, the other checks are skipped rather than left to time out.
+Report.Status is the worst status of the checks. Handler() serves the report as JSON, with status 503
+when it fails, for use as a Kubernetes readiness probe:
+
+	checker := g2health.NewChecker(clientSet.Connection(), clientSet.G2engine, clientSet.G2configmgr, clientSet.G2product)
+	http.Handle("/readyz", checker.Handler())
+
+g2client.ClientSet.Health() runs a Checker on the clients of a ClientSet.
+*/
+package g2health
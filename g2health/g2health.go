@@ -0,0 +1,268 @@
+/*
+ *
+ */
+
+// Package g2health reports whether a Senzing gRPC server is reachable and ready for calls.
+package g2health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Checker checks a Senzing gRPC server through one connection and the clients built on it.
+A nil client skips its checks. It is safe for concurrent use once configured.
+*/
+type Checker struct {
+	Connection           *grpc.ClientConn
+	G2configmgr          g2api.G2configmgr
+	G2engine             g2api.G2engine
+	G2product            g2api.G2product
+	LicenseWarningPeriod time.Duration    // How long before the license expires to warn; DefaultLicenseWarningPeriod by default.
+	now                  func() time.Time // The clock; tests replace it.
+}
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Duration time.Duration `json:"duration"` // In nanoseconds, as JSON.
+	Message  string        `json:"message,omitempty"`
+	Name     string        `json:"name"`
+	Status   Status        `json:"status"`
+}
+
+// Report is the outcome of all checks.
+type Report struct {
+	CheckedAt time.Time     `json:"checkedAt"`
+	Checks    []CheckResult `json:"checks"`
+	Status    Status        `json:"status"` // The worst status of Checks.
+}
+
+// Status is the outcome of a check: StatusPass, StatusWarn, StatusFail or StatusSkip.
+type Status string
+
+// A check: its status and a message saying why.
+type checkFunc func(ctx context.Context) (Status, string)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// How bad each status is; skipped checks count as passed.
+var severity = map[Status]int{StatusSkip: 0, StatusPass: 0, StatusWarn: 1, StatusFail: 2}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewChecker function returns a Checker warning DefaultLicenseWarningPeriod before the license expires.
+
+Input
+  - connection: The connection whose state is checked and whose server's gRPC health service is called.
+  - g2engine: The client whose active configuration is compared with the default configuration.
+  - g2configmgr: The client that reads the default configuration.
+  - g2product: The client that reads the license.
+*/
+func NewChecker(connection *grpc.ClientConn, g2engine g2api.G2engine, g2configmgr g2api.G2configmgr, g2product g2api.G2product) *Checker {
+	return &Checker{
+		Connection:           connection,
+		G2configmgr:          g2configmgr,
+		G2engine:             g2engine,
+		G2product:            g2product,
+		LicenseWarningPeriod: DefaultLicenseWarningPeriod,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The worse of two statuses.
+func worse(status Status, other Status) Status {
+	if severity[other] > severity[status] {
+		return other
+	}
+	return status
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (checker *Checker) clock() time.Time {
+	if checker.now != nil {
+		return checker.now()
+	}
+	return time.Now()
+}
+
+// Wait for the connection to be ready, connecting it if it is idle.
+func (checker *Checker) checkConnection(ctx context.Context) (Status, string) {
+	state := checker.Connection.GetState()
+	if state == connectivity.Idle {
+		checker.Connection.Connect()
+	}
+	for state != connectivity.Ready {
+		switch state {
+		case connectivity.Shutdown:
+			return StatusFail, "connection is closed"
+		case connectivity.TransientFailure:
+			return StatusFail, fmt.Sprintf("cannot connect to %s", checker.Connection.Target())
+		}
+		if !checker.Connection.WaitForStateChange(ctx, state) {
+			return StatusFail, fmt.Sprintf("connection to %s is %s: %v", checker.Connection.Target(), state, ctx.Err())
+		}
+		state = checker.Connection.GetState()
+	}
+	return StatusPass, ""
+}
+
+func (checker *Checker) checkConfiguration(ctx context.Context) (Status, string) {
+	activeConfigID, err := checker.G2engine.GetActiveConfigID(ctx)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	defaultConfigID, err := checker.G2configmgr.GetDefaultConfigID(ctx)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	if activeConfigID != defaultConfigID {
+		return StatusFail, fmt.Sprintf("active configuration %d is not the default configuration %d", activeConfigID, defaultConfigID)
+	}
+	return StatusPass, fmt.Sprintf("configuration %d", activeConfigID)
+}
+
+func (checker *Checker) checkGrpcHealth(ctx context.Context) (Status, string) {
+	response, err := healthpb.NewHealthClient(checker.Connection).Check(ctx, &healthpb.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return StatusSkip, "server has no gRPC health service"
+	}
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return StatusFail, response.GetStatus().String()
+	}
+	return StatusPass, ""
+}
+
+func (checker *Checker) checkLicense(ctx context.Context) (Status, string) {
+	response, err := checker.G2product.License(ctx)
+	if err != nil {
+		return StatusFail, err.Error()
+	}
+	license := struct {
+		ExpireDate string `json:"expireDate"`
+	}{}
+	if err := json.Unmarshal([]byte(response), &license); err != nil {
+		return StatusFail, fmt.Sprintf("invalid license: %v", err)
+	}
+	expireDate, err := time.Parse(licenseDateLayout, license.ExpireDate)
+	if err != nil {
+		return StatusFail, fmt.Sprintf("invalid license expireDate %q", license.ExpireDate)
+	}
+	// The license is valid through its expireDate.
+	expiresAt := expireDate.AddDate(0, 0, 1)
+	now := checker.clock()
+	switch {
+	case !now.Before(expiresAt):
+		return StatusFail, fmt.Sprintf("license expired on %s", license.ExpireDate)
+	case expiresAt.Sub(now) <= checker.LicenseWarningPeriod:
+		return StatusWarn, fmt.Sprintf("license expires on %s", license.ExpireDate)
+	default:
+		return StatusPass, fmt.Sprintf("license expires on %s", license.ExpireDate)
+	}
+}
+
+// Run a check, or skip it with a reason.
+func (checker *Checker) run(ctx context.Context, report *Report, name string, check checkFunc, skipReason string) {
+	result := CheckResult{Name: name}
+	if skipReason != "" {
+		result.Status = StatusSkip
+		result.Message = skipReason
+	} else {
+		start := time.Now()
+		result.Status, result.Message = check(ctx)
+		result.Duration = time.Since(start)
+	}
+	report.Checks = append(report.Checks, result)
+	report.Status = worse(report.Status, result.Status)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Handler method returns an HTTP handler that runs Health() and writes the report as JSON.
+The response status is 200 unless the report fails, when it is 503.
+Each request is bounded by its own context; set a probe timeout to bound it further.
+*/
+func (checker *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		report := checker.Health(request.Context())
+		writer.Header().Set("Content-Type", "application/json")
+		if report.Status == StatusFail {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(writer).Encode(report)
+	})
+}
+
+/*
+The Health method runs the checks and reports their outcome.
+Failures are reported in the Report, not returned as errors.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds waiting for the connection.
+*/
+func (checker *Checker) Health(ctx context.Context) *Report {
+	report := &Report{
+		CheckedAt: checker.clock(),
+		Checks:    []CheckResult{},
+		Status:    StatusPass,
+	}
+	connectionSkipReason := ""
+	if checker.Connection == nil {
+		connectionSkipReason = "no connection"
+	}
+	checker.run(ctx, report, CheckConnection, checker.checkConnection, connectionSkipReason)
+	checker.run(ctx, report, CheckGrpcHealth, checker.checkGrpcHealth, connectionSkipReason)
+	skipReason := ""
+	if report.Status == StatusFail {
+		skipReason = "connection is not ready"
+	}
+	configurationSkipReason := skipReason
+	if configurationSkipReason == "" && (checker.G2engine == nil || checker.G2configmgr == nil) {
+		configurationSkipReason = "no G2engine or G2configmgr client"
+	}
+	checker.run(ctx, report, CheckConfiguration, checker.checkConfiguration, configurationSkipReason)
+	licenseSkipReason := skipReason
+	if licenseSkipReason == "" && checker.G2product == nil {
+		licenseSkipReason = "no G2product client"
+	}
+	checker.run(ctx, report, CheckLicense, checker.checkLicense, licenseSkipReason)
+	return report
+}
+
+/*
+The Ready method reports whether no check failed; warnings and skipped checks are ready.
+*/
+func (report *Report) Ready() bool {
+	return report.Status != StatusFail
+}
@@ -0,0 +1,159 @@
+package g2health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2product"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
+	g2configmgrpb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testServer *grpctest.Server
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A Checker of the test server, on the day before its license expires.
+func getTestObject(ctx context.Context, test *testing.T) *Checker {
+	testServer.Reset()
+	grpcConnection, err := testServer.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { grpcConnection.Close() })
+	checker := NewChecker(
+		grpcConnection,
+		&g2engine.G2engine{GrpcClient: g2enginepb.NewG2EngineClient(grpcConnection)},
+		&g2configmgr.G2configmgr{GrpcClient: g2configmgrpb.NewG2ConfigMgrClient(grpcConnection)},
+		&g2product.G2product{GrpcClient: g2productpb.NewG2ProductClient(grpcConnection)},
+	)
+	checker.LicenseWarningPeriod = 0
+	checker.now = func() time.Time { return time.Date(2023, 11, 28, 12, 0, 0, 0, time.UTC) }
+	return checker
+}
+
+func statusOf(report *Report, name string) Status {
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check.Status
+		}
+	}
+	return ""
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	testServer = grpctest.NewServer()
+	code := m.Run()
+	testServer.Close()
+	os.Exit(code)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestChecker_Health(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	report := checker.Health(ctx)
+	assert.Equal(test, StatusPass, report.Status, report.Checks)
+	assert.True(test, report.Ready())
+	names := []string{}
+	for _, check := range report.Checks {
+		names = append(names, check.Name)
+	}
+	assert.Equal(test, []string{CheckConnection, CheckGrpcHealth, CheckConfiguration, CheckLicense}, names)
+}
+
+func TestChecker_Health_NotServing(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	testServer.SetServing(false)
+	defer testServer.SetServing(true)
+	report := checker.Health(ctx)
+	assert.Equal(test, StatusFail, statusOf(report, CheckGrpcHealth))
+	assert.False(test, report.Ready())
+}
+
+func TestChecker_Health_StaleConfiguration(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	session, err := g2config.NewConfigSession(ctx, &g2config.G2config{GrpcClient: g2configpb.NewG2ConfigClient(checker.Connection)}, "")
+	testError(test, err)
+	defer session.Close(ctx)
+	_, err = session.AddDataSource(ctx, "CUSTOMERS")
+	testError(test, err)
+	jsonConfig, err := session.Save(ctx)
+	testError(test, err)
+	newConfigID, err := checker.G2configmgr.AddConfig(ctx, jsonConfig, "Not yet active")
+	testError(test, err)
+	testError(test, checker.G2configmgr.SetDefaultConfigID(ctx, newConfigID))
+	report := checker.Health(ctx)
+	assert.Equal(test, StatusFail, statusOf(report, CheckConfiguration))
+	testError(test, checker.G2engine.Reinit(ctx, newConfigID))
+	report = checker.Health(ctx)
+	assert.Equal(test, StatusPass, statusOf(report, CheckConfiguration))
+}
+
+func TestChecker_Health_License(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	checker.LicenseWarningPeriod = 7 * 24 * time.Hour
+	assert.Equal(test, StatusWarn, statusOf(checker.Health(ctx), CheckLicense))
+	checker.now = func() time.Time { return time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC) }
+	report := checker.Health(ctx)
+	assert.Equal(test, StatusFail, statusOf(report, CheckLicense))
+	assert.Equal(test, StatusFail, report.Status)
+}
+
+func TestChecker_Health_ClosedConnection(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	testError(test, checker.Connection.Close())
+	report := checker.Health(ctx)
+	assert.Equal(test, StatusFail, statusOf(report, CheckConnection))
+	assert.Equal(test, StatusSkip, statusOf(report, CheckConfiguration))
+	assert.Equal(test, StatusSkip, statusOf(report, CheckLicense))
+}
+
+func TestChecker_Handler(test *testing.T) {
+	ctx := context.TODO()
+	checker := getTestObject(ctx, test)
+	recorder := httptest.NewRecorder()
+	checker.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(test, http.StatusOK, recorder.Code)
+	report := &Report{}
+	testError(test, json.Unmarshal(recorder.Body.Bytes(), report))
+	assert.Equal(test, StatusPass, report.Status)
+
+	checker.now = time.Now
+	recorder = httptest.NewRecorder()
+	checker.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(test, http.StatusServiceUnavailable, recorder.Code, "the license of the test server has expired")
+}
@@ -0,0 +1,31 @@
+package g2health
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The status of a check and of a report, as in the IETF health check response format.
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip" // Not run; does not affect the status of the report.
+)
+
+// Names of the checks, in the order they run.
+const (
+	CheckConnection    = "connection"
+	CheckGrpcHealth    = "grpc-health"
+	CheckConfiguration = "configuration"
+	CheckLicense       = "license"
+)
+
+const (
+	// How long before the license expires the license check warns.
+	DefaultLicenseWarningPeriod = 30 * 24 * time.Hour
+
+	// The layout of "expireDate" in the document of G2product.License().
+	licenseDateLayout = "2006-01-02"
+)
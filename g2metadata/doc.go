@@ -0,0 +1,18 @@
+/*
+The g2metadata package sends a correlation ID, and optionally the caller, tenant and load ID,
+as metadata of Senzing SDK calls, so that a call can be followed through proxies, server logs and observers.
+
+Every method of the G2config, G2configmgr, G2diagnostic, G2engine and G2product clients passes its context
+to NewContext(), which gives the call the correlation ID of the context, or a new one if it has none.
+The same ID is sent as CorrelationIDHeader, added as "correlationID" to the messages sent to observers
+and logged with the method's trace entry and exit. Calls made with the same context share one ID:
+
+	ctx = g2metadata.WithCorrelationID(ctx, request.Header.Get("X-Request-ID"))
+	ctx = g2metadata.WithLoadID(ctx, "nightly-2023-03-01")
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", recordJson, "")
+
+Values that are the same for every call of a process, such as the application name,
+can be given once to g2client.WithMetadata() or UnaryClientInterceptor();
+values on the context win over them.
+*/
+package g2metadata
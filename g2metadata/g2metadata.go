@@ -0,0 +1,191 @@
+/*
+ *
+ */
+
+// Package g2metadata propagates request metadata of Senzing SDK calls.
+package g2metadata
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Metadata holds the values sent with a call. Empty values are not sent.
+type Metadata struct {
+	Caller        string // The calling application or user, sent as CallerHeader.
+	CorrelationID string // Identifies the request the call is made for, sent as CorrelationIDHeader.
+	LoadID        string // The load the call belongs to, sent as LoadIDHeader.
+	Tenant        string // The tenant the call is made for, sent as TenantHeader.
+}
+
+// The key of the Metadata value of a context.
+type contextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Set the metadata keys of the non-empty values, replacing existing values if replace is true.
+func (values Metadata) setOutgoing(outgoing metadata.MD, replace bool) {
+	for header, value := range map[string]string{
+		CallerHeader:        values.Caller,
+		CorrelationIDHeader: values.CorrelationID,
+		LoadIDHeader:        values.LoadID,
+		TenantHeader:        values.Tenant,
+	} {
+		if value != "" && (replace || len(outgoing.Get(header)) == 0) {
+			outgoing.Set(header, value)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CorrelationID function returns the correlation ID of a context.
+
+Input
+  - ctx: A context, e.g. one returned by WithCorrelationID() or NewContext().
+
+Output
+  - The correlation ID; empty if the context has none.
+*/
+func CorrelationID(ctx context.Context) string {
+	return FromContext(ctx).CorrelationID
+}
+
+/*
+The FromContext function returns the Metadata of a context.
+
+Input
+  - ctx: A context, e.g. one returned by a With...() function.
+
+Output
+  - The Metadata; the zero value if the context has none.
+*/
+func FromContext(ctx context.Context) Metadata {
+	values, _ := ctx.Value(contextKey{}).(Metadata)
+	return values
+}
+
+/*
+The NewContext function returns a context for a call: its Metadata has a correlation ID, generated by
+NewCorrelationID() if ctx has none, and its outgoing gRPC metadata carries the non-empty values of the Metadata.
+It is called by every method of the SDK clients; calling it again on the returned context changes nothing.
+
+Input
+  - ctx: The caller's context.
+
+Output
+  - The context to make the call with.
+*/
+func NewContext(ctx context.Context) context.Context {
+	values := FromContext(ctx)
+	if values.CorrelationID == "" {
+		values.CorrelationID = NewCorrelationID()
+		ctx = context.WithValue(ctx, contextKey{}, values)
+	}
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+	outgoing = outgoing.Copy()
+	values.setOutgoing(outgoing, true)
+	return metadata.NewOutgoingContext(ctx, outgoing)
+}
+
+/*
+The NewCorrelationID function returns a new random correlation ID, formatted as a version 4 UUID.
+*/
+func NewCorrelationID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Sprintf("%032x", time.Now().UnixNano())
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
+
+/*
+The UnaryClientInterceptor function returns an interceptor sending default values with every call
+made over a gRPC connection, e.g. the name of the application.
+Values already in the outgoing metadata of a call, such as those set by NewContext(), win over the defaults.
+Calls without a correlation ID, e.g. those not made by the SDK clients, are sent with a new one.
+
+Input
+  - defaults: The values sent with calls lacking them; its CorrelationID is ignored.
+*/
+func UnaryClientInterceptor(defaults Metadata) grpc.UnaryClientInterceptor {
+	defaults.CorrelationID = ""
+	return func(ctx context.Context, method string, request, reply interface{}, connection *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		outgoing, _ := metadata.FromOutgoingContext(ctx)
+		outgoing = outgoing.Copy()
+		defaults.setOutgoing(outgoing, false)
+		if len(outgoing.Get(CorrelationIDHeader)) == 0 {
+			outgoing.Set(CorrelationIDHeader, NewCorrelationID())
+		}
+		return invoker(metadata.NewOutgoingContext(ctx, outgoing), method, request, reply, connection, opts...)
+	}
+}
+
+/*
+The WithCaller function returns a copy of a context whose calls are sent with the name of the caller.
+
+Input
+  - ctx: The parent context.
+  - caller: The calling application or user.
+*/
+func WithCaller(ctx context.Context, caller string) context.Context {
+	values := FromContext(ctx)
+	values.Caller = caller
+	return context.WithValue(ctx, contextKey{}, values)
+}
+
+/*
+The WithCorrelationID function returns a copy of a context whose calls are sent with a correlation ID.
+
+Input
+  - ctx: The parent context.
+  - correlationID: The ID, e.g. the request ID of an incoming HTTP request. gRPC metadata values must be printable ASCII.
+*/
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	values := FromContext(ctx)
+	values.CorrelationID = correlationID
+	return context.WithValue(ctx, contextKey{}, values)
+}
+
+/*
+The WithLoadID function returns a copy of a context whose calls are sent with a load ID.
+It does not change the loadID parameter of G2engine methods.
+
+Input
+  - ctx: The parent context.
+  - loadID: The load the calls belong to.
+*/
+func WithLoadID(ctx context.Context, loadID string) context.Context {
+	values := FromContext(ctx)
+	values.LoadID = loadID
+	return context.WithValue(ctx, contextKey{}, values)
+}
+
+/*
+The WithTenant function returns a copy of a context whose calls are sent with a tenant.
+
+Input
+  - ctx: The parent context.
+  - tenant: The tenant the calls are made for.
+*/
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	values := FromContext(ctx)
+	values.Tenant = tenant
+	return context.WithValue(ctx, contextKey{}, values)
+}
@@ -0,0 +1,148 @@
+package g2metadata
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// A server recording the metadata of each call.
+type recordingServer struct {
+	*grpctest.Server
+	lock     sync.Mutex
+	incoming []metadata.MD
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func newRecordingServer(test *testing.T) *recordingServer {
+	server := &recordingServer{}
+	server.Server = grpctest.NewServer(grpc.ChainUnaryInterceptor(server.record))
+	test.Cleanup(server.Close)
+	return server
+}
+
+// A generated G2engine client of the server, with the dial options given.
+func getTestObject(ctx context.Context, test *testing.T, server *recordingServer, opts ...grpc.DialOption) g2enginepb.G2EngineClient {
+	grpcConnection, err := server.Dial(ctx, opts...)
+	testError(test, err)
+	test.Cleanup(func() { grpcConnection.Close() })
+	return g2enginepb.NewG2EngineClient(grpcConnection)
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (server *recordingServer) record(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	server.lock.Lock()
+	server.incoming = append(server.incoming, incoming)
+	server.lock.Unlock()
+	return handler(ctx, request)
+}
+
+// The values of a metadata key in each call recorded.
+func (server *recordingServer) values(header string) []string {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	result := []string{}
+	for _, incoming := range server.incoming {
+		result = append(result, incoming.Get(header)...)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewCorrelationID(test *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	first := NewCorrelationID()
+	assert.Regexp(test, uuid, first)
+	assert.NotEqual(test, first, NewCorrelationID())
+}
+
+func TestNewContext(test *testing.T) {
+	ctx := NewContext(context.TODO())
+	correlationID := CorrelationID(ctx)
+	assert.NotEmpty(test, correlationID)
+	outgoing, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(test, []string{correlationID}, outgoing.Get(CorrelationIDHeader))
+	assert.Empty(test, outgoing.Get(CallerHeader), "empty values are not sent")
+
+	// Calling it again keeps the ID and does not repeat the metadata.
+	ctx = NewContext(ctx)
+	assert.Equal(test, correlationID, CorrelationID(ctx))
+	outgoing, _ = metadata.FromOutgoingContext(ctx)
+	assert.Equal(test, []string{correlationID}, outgoing.Get(CorrelationIDHeader))
+
+	// Values set later replace those sent.
+	ctx = NewContext(WithTenant(WithCorrelationID(ctx, "request-2"), "acme"))
+	outgoing, _ = metadata.FromOutgoingContext(ctx)
+	assert.Equal(test, []string{"request-2"}, outgoing.Get(CorrelationIDHeader))
+	assert.Equal(test, []string{"acme"}, outgoing.Get(TenantHeader))
+}
+
+func TestWith(test *testing.T) {
+	ctx := WithCaller(context.TODO(), "loader")
+	ctx = WithCorrelationID(ctx, "request-1")
+	ctx = WithLoadID(ctx, "load-1")
+	ctx = WithTenant(ctx, "acme")
+	assert.Equal(test, Metadata{Caller: "loader", CorrelationID: "request-1", LoadID: "load-1", Tenant: "acme"}, FromContext(ctx))
+	assert.Equal(test, Metadata{}, FromContext(context.TODO()))
+}
+
+func TestNewContext_Sent(test *testing.T) {
+	ctx := context.TODO()
+	server := newRecordingServer(test)
+	client := getTestObject(ctx, test, server)
+	callCtx := NewContext(WithCaller(WithLoadID(WithCorrelationID(ctx, "request-1"), "load-1"), "loader"))
+	_, err := client.CountRedoRecords(callCtx, &g2enginepb.CountRedoRecordsRequest{})
+	assert.Nil(test, err)
+	assert.Equal(test, []string{"request-1"}, server.values(CorrelationIDHeader))
+	assert.Equal(test, []string{"loader"}, server.values(CallerHeader))
+	assert.Equal(test, []string{"load-1"}, server.values(LoadIDHeader))
+	assert.Empty(test, server.values(TenantHeader))
+}
+
+func TestUnaryClientInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	server := newRecordingServer(test)
+	client := getTestObject(ctx, test, server, grpc.WithChainUnaryInterceptor(UnaryClientInterceptor(Metadata{Caller: "loader", CorrelationID: "ignored", Tenant: "acme"})))
+	_, err := client.CountRedoRecords(NewContext(ctx), &g2enginepb.CountRedoRecordsRequest{})
+	assert.Nil(test, err)
+	_, err = client.CountRedoRecords(NewContext(WithTenant(ctx, "globex")), &g2enginepb.CountRedoRecordsRequest{})
+	assert.Nil(test, err)
+	assert.Equal(test, []string{"loader", "loader"}, server.values(CallerHeader))
+	assert.Equal(test, []string{"acme", "globex"}, server.values(TenantHeader), "the context wins over the defaults")
+	assert.NotContains(test, server.values(CorrelationIDHeader), "ignored")
+
+	// Calls made without NewContext() are sent with a new ID.
+	_, err = client.CountRedoRecords(ctx, &g2enginepb.CountRedoRecordsRequest{})
+	assert.Nil(test, err)
+	sent := server.values(CorrelationIDHeader)
+	assert.Len(test, sent, 3)
+	assert.NotEmpty(test, sent[2])
+	assert.NotEqual(test, sent[0], sent[2])
+}
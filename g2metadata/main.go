@@ -0,0 +1,13 @@
+package g2metadata
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Metadata keys sent with every call; gRPC metadata keys are lowercase.
+const (
+	CallerHeader        = "x-senzing-caller"
+	CorrelationIDHeader = "x-correlation-id"
+	LoadIDHeader        = "x-senzing-load-id"
+	TenantHeader        = "x-senzing-tenant"
+)
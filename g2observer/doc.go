@@ -0,0 +1,27 @@
+/*
+The g2observer package delivers the messages of Senzing SDK clients to their observers, in order.
+
+Each of the G2config, G2configmgr, G2diagnostic, G2engine and G2product clients keeps its observers in a Queue.
+A method queues its message before it returns, and one goroutine per Queue delivers the messages
+in the order they were queued, calling each observer's UpdateObserver() in turn.
+Messages carry a "sequence" number, increasing by one per message of a Queue,
+so an observer can tell that messages were dropped.
+
+A Queue holds up to Capacity messages waiting for delivery. When it is full, the Block policy makes the method wait
+for room, unless the context of the call is done, and the Drop policy drops the message; Dropped() counts both.
+Observers get a context that is never cancelled but keeps the values of the context of the call,
+such as its g2metadata correlation ID.
+
+Before a process exits, Flush() waits for the messages already queued to be delivered:
+
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithObserverQueue(10000, g2observer.Drop))
+	...
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = clientSet.FlushObservers(flushCtx)
+
+An observer calling back into the client it observes must pass the context it got:
+its Queue is not delivering other messages until UpdateObserver() returns, so with the Block policy
+such a call's message is dropped when the Queue is full, where a call with another context would wait forever.
+*/
+package g2observer
@@ -0,0 +1,301 @@
+/*
+ *
+ */
+
+// Package g2observer delivers observer messages in order.
+package g2observer
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/senzing/g2-sdk-go-grpc/internal/detached"
+	"github.com/senzing/go-observing/observer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// OverflowPolicy is what a Queue does with a message when Capacity messages are waiting.
+type OverflowPolicy int
+
+/*
+Queue keeps the observers of a client and delivers its messages to them, in order, from one goroutine.
+It implements github.com/senzing/go-observing/subject.Subject. Set its fields before the first message.
+
+With the Block policy, a message from a call made with the context an observer got never waits:
+the Queue is not delivering until UpdateObserver() returns, so if the Queue is full, the message is dropped.
+An observer calling back into its client with any other context waits forever once the Queue is full.
+*/
+type Queue struct {
+	Capacity   int            // The most messages waiting for delivery; if zero or less, there is no limit.
+	Overflow   OverflowPolicy // What happens to a message when Capacity messages are waiting.
+	delivering uint64         // The sequence number of the message being delivered; 0 if none.
+	dropped    uint64
+	lock       sync.Mutex
+	observers  []observer.Observer
+	pending    []message
+	progress   chan struct{}
+	running    bool
+	sequence   uint64
+}
+
+// The key of the Queue delivering a message in the context given to its observers.
+type deliveringKey struct{}
+
+// A message waiting for delivery, with the observers registered when it was queued.
+type message struct {
+	ctx       context.Context
+	observers []observer.Observer
+	sequence  uint64
+	text      string
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewQueue function returns a Queue holding up to DefaultCapacity messages, with the Block policy.
+*/
+func NewQueue() *Queue {
+	return &Queue{
+		Capacity: DefaultCapacity,
+		Overflow: Block,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Deliver the pending messages in order, stopping when there are none.
+func (queue *Queue) deliver() {
+	queue.lock.Lock()
+	for len(queue.pending) > 0 {
+		next := queue.pending[0]
+		queue.pending[0] = message{}
+		queue.pending = queue.pending[1:]
+		queue.delivering = next.sequence
+		queue.signal()
+		queue.lock.Unlock()
+		ctx := context.WithValue(next.ctx, deliveringKey{}, queue)
+		for _, observer := range next.observers {
+			observer.UpdateObserver(ctx, next.text)
+		}
+		queue.lock.Lock()
+		queue.delivering = 0
+		queue.signal()
+	}
+	queue.running = false
+	queue.lock.Unlock()
+}
+
+// Report whether the messages numbered up to sequence are delivered or dropped; the caller holds the lock.
+func (queue *Queue) deliveredThrough(sequence uint64) bool {
+	if len(queue.pending) > 0 && queue.pending[0].sequence <= sequence {
+		return false
+	}
+	return queue.delivering == 0 || queue.delivering > sequence
+}
+
+/*
+Queue the message made by format from the next sequence number, waiting for room or dropping it
+by the Overflow policy. Nothing is queued, and no number taken, if there are no observers.
+A message from an observer of this Queue is dropped rather than wait for its own delivery to end.
+*/
+func (queue *Queue) enqueue(ctx context.Context, format func(sequence uint64) (string, error)) error {
+	fromObserver := ctx.Value(deliveringKey{}) == queue
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	for len(queue.observers) > 0 && queue.Capacity > 0 && len(queue.pending) >= queue.Capacity {
+		if queue.Overflow == Drop || fromObserver || ctx.Err() != nil {
+			queue.sequence++
+			queue.dropped++
+			return nil
+		}
+		progress := queue.waitChannel()
+		queue.lock.Unlock()
+		select {
+		case <-progress:
+		case <-ctx.Done():
+		}
+		queue.lock.Lock()
+	}
+	if len(queue.observers) == 0 {
+		return nil
+	}
+	text, err := format(queue.sequence + 1)
+	if err != nil {
+		return err
+	}
+	queue.sequence++
+	queue.pending = append(queue.pending, message{
+		ctx:       detached.WithoutCancel(ctx),
+		observers: append([]observer.Observer{}, queue.observers...),
+		sequence:  queue.sequence,
+		text:      text,
+	})
+	if !queue.running {
+		queue.running = true
+		go queue.deliver()
+	}
+	return nil
+}
+
+// Wake up the callers waiting for progress; the caller holds the lock.
+func (queue *Queue) signal() {
+	if queue.progress != nil {
+		close(queue.progress)
+		queue.progress = nil
+	}
+}
+
+// A channel closed on the next progress; the caller holds the lock.
+func (queue *Queue) waitChannel() chan struct{} {
+	if queue.progress == nil {
+		queue.progress = make(chan struct{})
+	}
+	return queue.progress
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Dropped method returns the number of messages dropped because the Queue was full.
+*/
+func (queue *Queue) Dropped() uint64 {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	return queue.dropped
+}
+
+/*
+The Flush method waits until the messages queued before the call are delivered, e.g. before a process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (queue *Queue) Flush(ctx context.Context) error {
+	queue.lock.Lock()
+	sequence := queue.sequence
+	for !queue.deliveredThrough(sequence) {
+		progress := queue.waitChannel()
+		queue.lock.Unlock()
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		queue.lock.Lock()
+	}
+	queue.lock.Unlock()
+	return nil
+}
+
+/*
+The GetObservers method returns a copy of the list of observers.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (queue *Queue) GetObservers(ctx context.Context) []observer.Observer {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	return append([]observer.Observer{}, queue.observers...)
+}
+
+/*
+The HasObservers method reports whether any observer is registered.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (queue *Queue) HasObservers(ctx context.Context) bool {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	return len(queue.observers) > 0
+}
+
+/*
+The Notify method queues a message to the observers registered: the details, with their "sequence" number, as JSON.
+
+Input
+  - ctx: The context of the call; observers get its values, but not its cancellation.
+  - details: The fields of the message. The "sequence" field is added.
+
+Output
+  - An error if the details could not be formatted.
+*/
+func (queue *Queue) Notify(ctx context.Context, details map[string]string) error {
+	return queue.enqueue(ctx, func(sequence uint64) (string, error) {
+		details["sequence"] = strconv.FormatUint(sequence, 10)
+		message, err := json.Marshal(details)
+		return string(message), err
+	})
+}
+
+/*
+The NotifyObservers method queues a message to the observers registered, as is.
+It takes a sequence number, but the message does not carry it.
+
+Input
+  - ctx: The context of the call; observers get its values, but not its cancellation.
+  - message: The message.
+*/
+func (queue *Queue) NotifyObservers(ctx context.Context, message string) error {
+	return queue.enqueue(ctx, func(sequence uint64) (string, error) {
+		return message, nil
+	})
+}
+
+/*
+The RegisterObserver method adds an observer, unless one with the same ID is registered.
+It gets the messages queued from now on.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer.
+*/
+func (queue *Queue) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	observerID := observer.GetObserverId(ctx)
+	for _, registered := range queue.observers {
+		if registered.GetObserverId(ctx) == observerID {
+			return nil
+		}
+	}
+	queue.observers = append(queue.observers, observer)
+	return nil
+}
+
+/*
+The UnregisterObserver method removes the observer with the ID of an observer.
+It still gets the messages queued before.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer.
+*/
+func (queue *Queue) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+	observerID := observer.GetObserverId(ctx)
+	observers := queue.observers[:0:0]
+	for _, registered := range queue.observers {
+		if registered.GetObserverId(ctx) != observerID {
+			observers = append(observers, registered)
+		}
+	}
+	queue.observers = observers
+	return nil
+}
@@ -0,0 +1,280 @@
+package g2observer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// An observer recording its messages, optionally waiting for release before returning from each.
+type testObserver struct {
+	id       string
+	contexts []context.Context
+	lock     sync.Mutex
+	messages []string
+	release  chan struct{}
+}
+
+// An observer that, on its first message, notifies its Queue reentries times with the context it got.
+type reentrantObserver struct {
+	testObserver
+	queue     *Queue
+	reentries int
+}
+
+type contextKey struct{}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The "sequence" of each message, which must be JSON.
+func sequences(test *testing.T, messages []string) []uint64 {
+	result := []uint64{}
+	for _, message := range messages {
+		details := map[string]string{}
+		if err := json.Unmarshal([]byte(message), &details); err != nil {
+			assert.FailNow(test, err.Error())
+		}
+		sequence, err := strconv.ParseUint(details["sequence"], 10, 64)
+		if err != nil {
+			assert.FailNow(test, err.Error())
+		}
+		result = append(result, sequence)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	if observer.release != nil {
+		<-observer.release
+	}
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.contexts = append(observer.contexts, ctx)
+	observer.messages = append(observer.messages, message)
+}
+
+func (observer *reentrantObserver) UpdateObserver(ctx context.Context, message string) {
+	observer.testObserver.UpdateObserver(ctx, message)
+	for ; observer.reentries > 0; observer.reentries-- {
+		_ = observer.queue.Notify(ctx, map[string]string{})
+	}
+}
+
+func (observer *testObserver) received() []string {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	return append([]string{}, observer.messages...)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestQueue_Order(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	queue.Capacity = 10
+	first := &testObserver{id: "first"}
+	second := &testObserver{id: "second"}
+	assert.Nil(test, queue.RegisterObserver(ctx, first))
+	assert.Nil(test, queue.RegisterObserver(ctx, second))
+	assert.Nil(test, queue.RegisterObserver(ctx, &testObserver{id: "first"}), "an ID is registered once")
+	for i := 1; i <= 1000; i++ {
+		assert.Nil(test, queue.Notify(ctx, map[string]string{"index": strconv.Itoa(i)}))
+	}
+	assert.Nil(test, queue.Flush(ctx))
+
+	// Both observers get every message, in order, although the queue was full most of the time.
+	for _, observer := range []*testObserver{first, second} {
+		received := observer.received()
+		assert.Len(test, received, 1000)
+		for i, sequence := range sequences(test, received) {
+			assert.Equal(test, uint64(i+1), sequence)
+			assert.Contains(test, received[i], fmt.Sprintf(`"index":"%d"`, i+1))
+		}
+	}
+	assert.Equal(test, uint64(0), queue.Dropped())
+}
+
+func TestQueue_Concurrent(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	queue.Capacity = 5
+	observer := &testObserver{id: "observer"}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for i := 0; i < 100; i++ {
+				_ = queue.Notify(ctx, map[string]string{})
+			}
+		}()
+	}
+	waitGroup.Wait()
+	assert.Nil(test, queue.Flush(ctx))
+
+	// No message is lost and the sequence numbers are delivered in order.
+	received := sequences(test, observer.received())
+	assert.Len(test, received, 800)
+	for i, sequence := range received {
+		assert.Equal(test, uint64(i+1), sequence)
+	}
+}
+
+func TestQueue_Drop(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	queue.Capacity = 2
+	queue.Overflow = Drop
+	observer := &testObserver{id: "observer", release: make(chan struct{})}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+
+	// The first message is being delivered and two are waiting; the rest are dropped.
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+	assert.Eventually(test, func() bool {
+		queue.lock.Lock()
+		defer queue.lock.Unlock()
+		return queue.delivering == 1
+	}, time.Second, time.Millisecond)
+	for i := 0; i < 5; i++ {
+		assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+	}
+	assert.Equal(test, uint64(3), queue.Dropped())
+	close(observer.release)
+	assert.Nil(test, queue.Flush(ctx))
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+	assert.Nil(test, queue.Flush(ctx))
+
+	// Dropped messages leave gaps in the sequence numbers.
+	assert.Equal(test, []uint64{1, 2, 3, 7}, sequences(test, observer.received()))
+}
+
+func TestQueue_Block(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	queue.Capacity = 1
+	observer := &testObserver{id: "observer", release: make(chan struct{})}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+
+	// The queue is full: a third message waits for room.
+	queued := make(chan struct{})
+	go func() {
+		_ = queue.Notify(ctx, map[string]string{})
+		close(queued)
+	}()
+	select {
+	case <-queued:
+		assert.Fail(test, "the message did not wait for room")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(observer.release)
+	<-queued
+
+	// A message whose context is done does not wait.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	blocked := &testObserver{id: "blocked", release: make(chan struct{})}
+	blockedQueue := &Queue{Capacity: 1, Overflow: Block}
+	assert.Nil(test, blockedQueue.RegisterObserver(ctx, blocked))
+	assert.Nil(test, blockedQueue.Notify(ctx, map[string]string{}))
+	assert.Nil(test, blockedQueue.Notify(ctx, map[string]string{}))
+	assert.Nil(test, blockedQueue.Notify(cancelledCtx, map[string]string{}))
+	assert.Equal(test, uint64(1), blockedQueue.Dropped())
+	close(blocked.release)
+
+	assert.Nil(test, queue.Flush(ctx))
+	assert.Nil(test, blockedQueue.Flush(ctx))
+	assert.Equal(test, []uint64{1, 2, 3}, sequences(test, observer.received()))
+}
+
+func TestQueue_Block_Reentrant(test *testing.T) {
+	ctx := context.TODO()
+	queue := &Queue{Capacity: 1, Overflow: Block}
+	observer := &reentrantObserver{testObserver: testObserver{id: "observer"}, queue: queue, reentries: 3}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+
+	// The observer's first message fills the Queue; the others are dropped rather than wait for the observer.
+	flushCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	assert.Nil(test, queue.Flush(flushCtx))
+	assert.Nil(test, queue.Flush(flushCtx))
+	assert.Equal(test, []uint64{1, 2}, sequences(test, observer.received()))
+	assert.Equal(test, uint64(2), queue.Dropped())
+}
+
+func TestQueue_Flush(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	assert.Nil(test, queue.Flush(ctx), "an empty queue is flushed at once")
+	observer := &testObserver{id: "observer", release: make(chan struct{})}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	assert.Nil(test, queue.Notify(ctx, map[string]string{}))
+
+	// Flush() gives up when its context is done.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	assert.Equal(test, context.DeadlineExceeded, queue.Flush(timeoutCtx))
+	close(observer.release)
+	assert.Nil(test, queue.Flush(ctx))
+	assert.Len(test, observer.received(), 1)
+}
+
+func TestQueue_DetachedContext(test *testing.T) {
+	ctx := context.WithValue(context.TODO(), contextKey{}, "request-1")
+	callCtx, cancel := context.WithCancel(ctx)
+	queue := NewQueue()
+	observer := &testObserver{id: "observer", release: make(chan struct{})}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	assert.Nil(test, queue.Notify(callCtx, map[string]string{}))
+	cancel()
+	close(observer.release)
+	assert.Nil(test, queue.Flush(ctx))
+
+	// The observer gets the values of the context of the call, but not its cancellation.
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	assert.Len(test, observer.contexts, 1)
+	assert.Nil(test, observer.contexts[0].Err())
+	assert.Equal(test, "request-1", observer.contexts[0].Value(contextKey{}))
+}
+
+func TestQueue_UnregisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	queue := NewQueue()
+	observer := &testObserver{id: "observer", release: make(chan struct{})}
+	assert.Nil(test, queue.RegisterObserver(ctx, observer))
+	assert.Nil(test, queue.NotifyObservers(ctx, "before"))
+	assert.Nil(test, queue.UnregisterObserver(ctx, observer))
+	assert.False(test, queue.HasObservers(ctx))
+	assert.Nil(test, queue.NotifyObservers(ctx, "after"))
+	close(observer.release)
+	assert.Nil(test, queue.Flush(ctx))
+
+	// Messages queued before the observer was removed are still delivered.
+	assert.Equal(test, []string{"before"}, observer.received())
+}
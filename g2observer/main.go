@@ -0,0 +1,14 @@
+package g2observer
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// What a Queue does with a message when Capacity messages are waiting.
+const (
+	Block OverflowPolicy = iota // Wait for room, unless the context of the call is done or was given to an observer; then drop the message.
+	Drop                        // Drop the message.
+)
+
+// The Capacity of NewQueue() queues.
+const DefaultCapacity = 1000
@@ -0,0 +1,19 @@
+/*
+The g2otel package instruments Senzing gRPC calls with OpenTelemetry.
+
+A Telemetry is installed as a gRPC unary client interceptor, usually with g2client.WithTelemetry().
+For each call it:
+  - starts a client span named after the gRPC method, e.g. "g2engine.G2Engine/GetEntityByRecordID",
+    with the dataSourceCode, recordID, entityID, loadID and flags of the request as attributes,
+    and, for calls on two records or entities such as FindPath*() and WhyRecords(),
+    dataSourceCode1, recordID1, dataSourceCode2, recordID2, entityID1 and entityID2;
+  - injects the span context into the gRPC metadata, so a Senzing gRPC server can join the trace;
+  - records the call's latency in the "senzing.client.duration" histogram and,
+    if the call fails, increments the "senzing.client.errors" counter, both keyed by method.
+
+Providers default to the global OpenTelemetry providers:
+
+	telemetry := &g2otel.Telemetry{}
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithTelemetry(telemetry))
+*/
+package g2otel
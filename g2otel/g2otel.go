@@ -0,0 +1,195 @@
+/*
+ *
+ */
+
+// Package g2otel instruments Senzing gRPC calls with OpenTelemetry.
+package g2otel
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Telemetry holds the OpenTelemetry providers used to instrument calls. The zero value is usable.
+type Telemetry struct {
+	MeterProvider  metric.MeterProvider          // If nil, otel.GetMeterProvider() is used.
+	Propagator     propagation.TextMapPropagator // If nil, W3C trace context and baggage are propagated.
+	TracerProvider trace.TracerProvider          // If nil, otel.GetTracerProvider() is used.
+	duration       metric.Float64Histogram
+	errors         metric.Int64Counter
+	initErr        error
+	once           sync.Once
+	propagator     propagation.TextMapPropagator
+	tracer         trace.Tracer
+}
+
+// Adapts outgoing gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (carrier metadataCarrier) Get(key string) string {
+	values := metadata.MD(carrier).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (carrier metadataCarrier) Keys() []string {
+	result := make([]string, 0, len(carrier))
+	for key := range carrier {
+		result = append(result, key)
+	}
+	return result
+}
+
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+// Create the tracer and instruments on first use.
+func (telemetry *Telemetry) init() error {
+	telemetry.once.Do(func() {
+		tracerProvider := telemetry.TracerProvider
+		if tracerProvider == nil {
+			tracerProvider = otel.GetTracerProvider()
+		}
+		telemetry.tracer = tracerProvider.Tracer(InstrumentationName)
+		meterProvider := telemetry.MeterProvider
+		if meterProvider == nil {
+			meterProvider = otel.GetMeterProvider()
+		}
+		meter := meterProvider.Meter(InstrumentationName)
+		telemetry.propagator = telemetry.Propagator
+		if telemetry.propagator == nil {
+			telemetry.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+		}
+		telemetry.duration, telemetry.initErr = meter.Float64Histogram(DurationHistogramName,
+			metric.WithDescription("Duration of Senzing gRPC calls."),
+			metric.WithUnit("s"),
+		)
+		if telemetry.initErr != nil {
+			return
+		}
+		telemetry.errors, telemetry.initErr = meter.Int64Counter(ErrorCounterName,
+			metric.WithDescription("Number of failed Senzing gRPC calls."),
+		)
+	})
+	return telemetry.initErr
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Attributes of the Senzing request, from the getters of the protobuf message.
+func requestAttributes(request interface{}) []attribute.KeyValue {
+	result := []attribute.KeyValue{}
+	if typedRequest, ok := request.(interface{ GetDataSourceCode() string }); ok {
+		result = append(result, DataSourceCodeKey.String(typedRequest.GetDataSourceCode()))
+	}
+	if typedRequest, ok := request.(interface{ GetDataSourceCode1() string }); ok {
+		result = append(result, DataSourceCode1Key.String(typedRequest.GetDataSourceCode1()))
+	}
+	if typedRequest, ok := request.(interface{ GetDataSourceCode2() string }); ok {
+		result = append(result, DataSourceCode2Key.String(typedRequest.GetDataSourceCode2()))
+	}
+	if typedRequest, ok := request.(interface{ GetEntityID() int64 }); ok {
+		result = append(result, EntityIDKey.Int64(typedRequest.GetEntityID()))
+	}
+	if typedRequest, ok := request.(interface{ GetEntityID1() int64 }); ok {
+		result = append(result, EntityID1Key.Int64(typedRequest.GetEntityID1()))
+	}
+	if typedRequest, ok := request.(interface{ GetEntityID2() int64 }); ok {
+		result = append(result, EntityID2Key.Int64(typedRequest.GetEntityID2()))
+	}
+	if typedRequest, ok := request.(interface{ GetFlags() int64 }); ok {
+		result = append(result, FlagsKey.Int64(typedRequest.GetFlags()))
+	}
+	if typedRequest, ok := request.(interface{ GetLoadID() string }); ok {
+		result = append(result, LoadIDKey.String(typedRequest.GetLoadID()))
+	}
+	if typedRequest, ok := request.(interface{ GetRecordID() string }); ok {
+		result = append(result, RecordIDKey.String(typedRequest.GetRecordID()))
+	}
+	if typedRequest, ok := request.(interface{ GetRecordID1() string }); ok {
+		result = append(result, RecordID1Key.String(typedRequest.GetRecordID1()))
+	}
+	if typedRequest, ok := request.(interface{ GetRecordID2() string }); ok {
+		result = append(result, RecordID2Key.String(typedRequest.GetRecordID2()))
+	}
+	return result
+}
+
+// Attributes identifying the method, e.g. "/g2engine.G2Engine/AddRecord".
+func methodAttributes(method string) []attribute.KeyValue {
+	service, name, found := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !found {
+		name = service
+		service = ""
+	}
+	return []attribute.KeyValue{
+		RpcSystemKey.String("grpc"),
+		RpcServiceKey.String(service),
+		RpcMethodKey.String(name),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The UnaryClientInterceptor method returns a gRPC interceptor that traces and measures every unary call.
+*/
+func (telemetry *Telemetry) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if telemetry.init() != nil {
+			return invoker(ctx, method, request, reply, cc, opts...)
+		}
+		attributes := methodAttributes(method)
+		ctx, span := telemetry.tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attributes...),
+			trace.WithAttributes(requestAttributes(request)...),
+		)
+		defer span.End()
+
+		outgoing, _ := metadata.FromOutgoingContext(ctx)
+		outgoing = outgoing.Copy()
+		telemetry.propagator.Inject(ctx, metadataCarrier(outgoing))
+		ctx = metadata.NewOutgoingContext(ctx, outgoing)
+
+		entryTime := time.Now()
+		err := invoker(ctx, method, request, reply, cc, opts...)
+		grpcStatus, _ := status.FromError(err)
+		attributes = append(attributes, RpcGrpcStatusKey.Int64(int64(grpcStatus.Code())))
+		span.SetAttributes(RpcGrpcStatusKey.Int64(int64(grpcStatus.Code())))
+		telemetry.duration.Record(ctx, time.Since(entryTime).Seconds(), metric.WithAttributes(attributes...))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, grpcStatus.Message())
+			telemetry.errors.Add(ctx, 1, metric.WithAttributes(attributes...))
+		}
+		return err
+	}
+}
@@ -0,0 +1,298 @@
+package g2otel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufferSize = 1024 * 1024
+)
+
+var (
+	engineServer = &g2engineServer{}
+	listener     *bufconn.Listener
+	server       *grpc.Server
+)
+
+// A G2engine server recording the "traceparent" metadata it receives.
+type g2engineServer struct {
+	g2pb.UnimplementedG2EngineServer
+	lock         sync.Mutex
+	traceparents []string
+}
+
+func (server *g2engineServer) record(ctx context.Context) {
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.traceparents = append(server.traceparents, incoming.Get("traceparent")...)
+}
+
+func (server *g2engineServer) AddRecord(ctx context.Context, request *g2pb.AddRecordRequest) (*g2pb.AddRecordResponse, error) {
+	server.record(ctx)
+	return nil, status.Error(codes.InvalidArgument, "SENZ0023|Conflicting DATA_SOURCE values")
+}
+
+func (server *g2engineServer) FindPathByEntityID(ctx context.Context, request *g2pb.FindPathByEntityIDRequest) (*g2pb.FindPathByEntityIDResponse, error) {
+	server.record(ctx)
+	return &g2pb.FindPathByEntityIDResponse{Result: `{}`}, nil
+}
+
+func (server *g2engineServer) GetEntityByRecordID_V2(ctx context.Context, request *g2pb.GetEntityByRecordID_V2Request) (*g2pb.GetEntityByRecordID_V2Response, error) {
+	server.record(ctx)
+	return &g2pb.GetEntityByRecordID_V2Response{Result: `{}`}, nil
+}
+
+func (server *g2engineServer) WhyRecords(ctx context.Context, request *g2pb.WhyRecordsRequest) (*g2pb.WhyRecordsResponse, error) {
+	server.record(ctx)
+	return &g2pb.WhyRecordsResponse{Result: `{}`}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func bufDialer(ctx context.Context, address string) (net.Conn, error) {
+	return listener.DialContext(ctx)
+}
+
+// A G2engine instrumented with in-memory trace and metric exporters.
+func getTestObject(ctx context.Context, test *testing.T) (*g2engine.G2engine, *tracetest.InMemoryExporter, sdkmetric.Reader) {
+	spanExporter := tracetest.NewInMemoryExporter()
+	metricReader := sdkmetric.NewManualReader()
+	telemetry := &Telemetry{
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(metricReader)),
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)),
+	}
+	connection, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(telemetry.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { connection.Close() })
+	return &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}, spanExporter, metricReader
+}
+
+func getMetric(test *testing.T, reader sdkmetric.Reader, name string) metricdata.Aggregation {
+	resourceMetrics := metricdata.ResourceMetrics{}
+	err := reader.Collect(context.TODO(), &resourceMetrics)
+	assert.Nil(test, err)
+	for _, scopeMetrics := range resourceMetrics.ScopeMetrics {
+		for _, metric := range scopeMetrics.Metrics {
+			if metric.Name == name {
+				return metric.Data
+			}
+		}
+	}
+	assert.FailNow(test, "metric not found", name)
+	return nil
+}
+
+func getAttribute(attributes []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, keyValue := range attributes {
+		if keyValue.Key == key {
+			return keyValue.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2pb.RegisterG2EngineServer(server, engineServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestTelemetry_Span(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	_, err := g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 8)
+	assert.Nil(test, err)
+	spans := spanExporter.GetSpans()
+	assert.Len(test, spans, 1)
+	span := spans[0]
+	assert.Equal(test, "g2engine.G2Engine/GetEntityByRecordID_V2", span.Name)
+	assert.Equal(test, trace.SpanKindClient, span.SpanKind)
+	assert.Equal(test, "CUSTOMERS", getAttribute(span.Attributes, DataSourceCodeKey).AsString())
+	assert.Equal(test, "1001", getAttribute(span.Attributes, RecordIDKey).AsString())
+	assert.Equal(test, int64(8), getAttribute(span.Attributes, FlagsKey).AsInt64())
+	assert.Equal(test, "g2engine.G2Engine", getAttribute(span.Attributes, RpcServiceKey).AsString())
+	assert.Equal(test, otelcodes.Unset, span.Status.Code)
+}
+
+func TestTelemetry_Span_EntityPair(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	_, err := g2engine.FindPathByEntityID(ctx, 1, 2, 3)
+	assert.Nil(test, err)
+	spans := spanExporter.GetSpans()
+	assert.Len(test, spans, 1)
+	assert.Equal(test, int64(1), getAttribute(spans[0].Attributes, EntityID1Key).AsInt64())
+	assert.Equal(test, int64(2), getAttribute(spans[0].Attributes, EntityID2Key).AsInt64())
+}
+
+func TestTelemetry_Span_RecordPair(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	_, err := g2engine.WhyRecords(ctx, "CUSTOMERS", "1001", "WATCHLIST", "1002")
+	assert.Nil(test, err)
+	spans := spanExporter.GetSpans()
+	assert.Len(test, spans, 1)
+	assert.Equal(test, "CUSTOMERS", getAttribute(spans[0].Attributes, DataSourceCode1Key).AsString())
+	assert.Equal(test, "1001", getAttribute(spans[0].Attributes, RecordID1Key).AsString())
+	assert.Equal(test, "WATCHLIST", getAttribute(spans[0].Attributes, DataSourceCode2Key).AsString())
+	assert.Equal(test, "1002", getAttribute(spans[0].Attributes, RecordID2Key).AsString())
+}
+
+func TestTelemetry_Span_Error(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "LOAD")
+	assert.NotNil(test, err)
+	spans := spanExporter.GetSpans()
+	assert.Len(test, spans, 1)
+	assert.Equal(test, otelcodes.Error, spans[0].Status.Code)
+	assert.Equal(test, "LOAD", getAttribute(spans[0].Attributes, LoadIDKey).AsString())
+	assert.Equal(test, int64(codes.InvalidArgument), getAttribute(spans[0].Attributes, RpcGrpcStatusKey).AsInt64())
+	assert.Len(test, spans[0].Events, 1)
+}
+
+func TestTelemetry_Propagation(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	engineServer.lock.Lock()
+	engineServer.traceparents = nil
+	engineServer.lock.Unlock()
+	_, err := g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	assert.Nil(test, err)
+	spanContext := spanExporter.GetSpans()[0].SpanContext
+	expected := fmt.Sprintf("00-%s-%s-01", spanContext.TraceID(), spanContext.SpanID())
+	engineServer.lock.Lock()
+	defer engineServer.lock.Unlock()
+	assert.Equal(test, []string{expected}, engineServer.traceparents)
+}
+
+func TestTelemetry_Propagation_ParentSpan(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, spanExporter, _ := getTestObject(ctx, test)
+	parentProvider := sdktrace.NewTracerProvider()
+	ctx, parent := parentProvider.Tracer("test").Start(ctx, "parent")
+	_, err := g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	parent.End()
+	assert.Nil(test, err)
+	span := spanExporter.GetSpans()[0]
+	assert.Equal(test, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+	assert.Equal(test, parent.SpanContext().SpanID(), span.Parent.SpanID())
+}
+
+func TestTelemetry_Metrics(test *testing.T) {
+	ctx := context.TODO()
+	g2engine, _, metricReader := getTestObject(ctx, test)
+	for i := 0; i < 3; i++ {
+		_, err := g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+		assert.Nil(test, err)
+	}
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "LOAD")
+	assert.NotNil(test, err)
+
+	duration := getMetric(test, metricReader, DurationHistogramName).(metricdata.Histogram[float64])
+	counts := map[string]uint64{}
+	for _, dataPoint := range duration.DataPoints {
+		method, _ := dataPoint.Attributes.Value(RpcMethodKey)
+		counts[method.AsString()] += dataPoint.Count
+	}
+	assert.Equal(test, map[string]uint64{"AddRecord": 1, "GetEntityByRecordID_V2": 3}, counts)
+
+	errors := getMetric(test, metricReader, ErrorCounterName).(metricdata.Sum[int64])
+	assert.Len(test, errors.DataPoints, 1)
+	method, _ := errors.DataPoints[0].Attributes.Value(RpcMethodKey)
+	assert.Equal(test, "AddRecord", method.AsString())
+	assert.Equal(test, int64(1), errors.DataPoints[0].Value)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleTelemetry_UnaryClientInterceptor() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2otel/g2otel_test.go
+	ctx := context.TODO()
+	spanExporter := tracetest.NewInMemoryExporter()
+	telemetry := &Telemetry{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)),
+	}
+	connection, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(telemetry.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		fmt.Println(err)
+	}
+	defer connection.Close()
+	g2engine := &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}
+	_, err = g2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", 0)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(spanExporter.GetSpans()[0].Name)
+	// Output: g2engine.G2Engine/GetEntityByRecordID_V2
+}
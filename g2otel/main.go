@@ -0,0 +1,35 @@
+package g2otel
+
+import "go.opentelemetry.io/otel/attribute"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Name of the instrumentation library reported with spans and metrics.
+const InstrumentationName = "github.com/senzing/g2-sdk-go-grpc/g2otel"
+
+// Names of the metric instruments.
+const (
+	DurationHistogramName = "senzing.client.duration"
+	ErrorCounterName      = "senzing.client.errors"
+)
+
+// Attribute keys.
+const (
+	DataSourceCode1Key = attribute.Key("senzing.dataSourceCode1")
+	DataSourceCode2Key = attribute.Key("senzing.dataSourceCode2")
+	DataSourceCodeKey  = attribute.Key("senzing.dataSourceCode")
+	EntityID1Key       = attribute.Key("senzing.entityID1")
+	EntityID2Key       = attribute.Key("senzing.entityID2")
+	EntityIDKey        = attribute.Key("senzing.entityID")
+	FlagsKey           = attribute.Key("senzing.flags")
+	LoadIDKey          = attribute.Key("senzing.loadID")
+	RecordID1Key       = attribute.Key("senzing.recordID1")
+	RecordID2Key       = attribute.Key("senzing.recordID2")
+	RecordIDKey        = attribute.Key("senzing.recordID")
+	RpcGrpcStatusKey   = attribute.Key("rpc.grpc.status_code")
+	RpcMethodKey       = attribute.Key("rpc.method")
+	RpcServiceKey      = attribute.Key("rpc.service")
+	RpcSystemKey       = attribute.Key("rpc.system")
+)
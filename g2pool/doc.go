@@ -0,0 +1,24 @@
+/*
+The g2pool package spreads Senzing SDK calls over several Senzing gRPC servers that share one database.
+
+A Pool holds a gRPC connection to each endpoint and implements grpc.ClientConnInterface,
+so the generated Senzing clients, and g2client.NewClientSetFromPool(), build on it as on a single connection:
+
+	pool, err := g2pool.NewPool(ctx, []string{"dns:///senzing.example.com:8258"}, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	pool.Policy = g2pool.LeastOutstanding
+	clientSet := g2client.NewClientSetFromPool(pool)
+
+Each call goes to an endpoint chosen by the Policy: RoundRobin, or LeastOutstanding, the endpoint waiting on the fewest calls.
+Endpoints whose connection is failing, or whose last MaxFailures calls failed with codes.Unavailable, are ejected
+for EjectionDuration. When every endpoint is ejected, calls go to all of them rather than failing outright.
+
+Calls that carry a handle created on the server are sticky: a G2config configuration handle,
+a G2engine export handle or a G2diagnostic entity list handle is only valid on the server that created it,
+so FetchNext(), CloseExport(), FetchNextEntityBySize(), Save() and the other calls given the handle
+go to that server, whether or not it is ejected. Closing the handle, or a Destroy() of its service
+on that server, forgets it. As those calls carry the handle alone, a call creating a handle
+already open on another endpoint is refused: the new handle is closed and the error wraps ErrHandleConflict.
+
+Endpoints given as "dns:///host:port" are resolved once, by NewPool(), into one endpoint per address.
+*/
+package g2pool
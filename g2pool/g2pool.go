@@ -0,0 +1,409 @@
+/*
+ *
+ */
+
+// Package g2pool spreads Senzing SDK calls over several Senzing gRPC servers that share one database.
+package g2pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Pool is a grpc.ClientConnInterface spreading calls over connections to several endpoints.
+Set its exported fields before the first call. It is safe for concurrent use.
+*/
+type Pool struct {
+	EjectionDuration time.Duration // How long an endpoint is ejected for.
+	MaxFailures      int           // Consecutive codes.Unavailable failures that eject an endpoint; 0 never ejects.
+	Policy           Policy        // How endpoints are chosen.
+	endpoints        []*endpoint
+	handles          map[string]*endpoint // The endpoint that created each open handle, by handleKey().
+	lock             sync.Mutex
+	next             int
+}
+
+// EndpointStatus describes an endpoint of a Pool, as reported by Endpoints().
+type EndpointStatus struct {
+	Address             string    // The gRPC target of the endpoint.
+	Calls               int64     // Calls sent, including failed calls.
+	ConsecutiveFailures int       // Failures with codes.Unavailable since the last success.
+	EjectedUntil        time.Time // Zero unless the endpoint is ejected.
+	Handles             int       // Open handles created on the endpoint.
+	Outstanding         int       // Calls waiting for a response.
+	State               string    // The connectivity state of the connection, e.g. "READY".
+}
+
+// Policy is how a Pool chooses the endpoint of a call.
+type Policy int
+
+// An endpoint and its health; the fields other than address and connection are guarded by Pool.lock.
+type endpoint struct {
+	address             string
+	calls               int64
+	connection          *grpc.ClientConn
+	consecutiveFailures int
+	ejectedUntil        time.Time
+	outstanding         int
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewPool function dials each endpoint and returns a Pool of the connections.
+Dialing does not block; connection errors surface on the first calls.
+
+Input
+  - ctx: A context to control lifecycle.
+  - addresses: The gRPC targets of the endpoints, e.g. "senzing-1:8258";
+    "dns:///host:port" adds an endpoint for each address of host.
+  - dialOptions: The options of every connection, e.g. the dial options of a g2client.ClientSet.
+
+Output
+  - A Pool using RoundRobin, DefaultMaxFailures and DefaultEjectionDuration, whose Close() method must be called.
+*/
+func NewPool(ctx context.Context, addresses []string, dialOptions ...grpc.DialOption) (*Pool, error) {
+	targets := []string{}
+	for _, address := range addresses {
+		resolved, err := resolve(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, resolved...)
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("g2pool: no endpoints")
+	}
+	connections := []*grpc.ClientConn{}
+	for _, target := range targets {
+		connection, err := grpc.DialContext(ctx, target, dialOptions...)
+		if err != nil {
+			for _, opened := range connections {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("g2pool: dialing %s: %w", target, err)
+		}
+		connections = append(connections, connection)
+	}
+	return NewPoolFromConnections(connections...), nil
+}
+
+/*
+The NewPoolFromConnections function returns a Pool of established connections.
+The Pool takes ownership of the connections; Close() will close them.
+
+Input
+  - connections: A connection to each endpoint.
+*/
+func NewPoolFromConnections(connections ...*grpc.ClientConn) *Pool {
+	pool := &Pool{
+		EjectionDuration: DefaultEjectionDuration,
+		MaxFailures:      DefaultMaxFailures,
+		Policy:           RoundRobin,
+		handles:          map[string]*endpoint{},
+	}
+	for _, connection := range connections {
+		pool.endpoints = append(pool.endpoints, &endpoint{address: connection.Target(), connection: connection})
+	}
+	return pool
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Close the handle returned in the reply of a handle-creating method, on the connection that created it.
+func closeHandle(ctx context.Context, connection *grpc.ClientConn, method string, reply interface{}) error {
+	closingMethod := handleCreatingMethods[method]
+	serviceName, methodName, _ := strings.Cut(strings.TrimPrefix(closingMethod, "/"), "/")
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return err
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return fmt.Errorf("g2pool: %s is not a service", serviceName)
+	}
+	methodDescriptor := service.Methods().ByName(protoreflect.Name(methodName))
+	if methodDescriptor == nil {
+		return fmt.Errorf("g2pool: unknown method %s", closingMethod)
+	}
+	requestType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Input().FullName())
+	if err != nil {
+		return err
+	}
+	responseType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Output().FullName())
+	if err != nil {
+		return err
+	}
+	created := reply.(proto.Message).ProtoReflect()
+	handle := created.Get(created.Descriptor().Fields().ByName("result"))
+	request := requestType.New()
+	fields := request.Descriptor().Fields()
+	for _, fieldName := range handleFieldNames {
+		if field := fields.ByName(protoreflect.Name(fieldName)); field != nil {
+			request.Set(field, handle)
+			break
+		}
+	}
+	return connection.Invoke(ctx, closingMethod, request.Interface(), responseType.New().Interface())
+}
+
+// The value of the first handle field of a message, or "" if it has none.
+func handleOf(message interface{}, fieldNames ...string) string {
+	protoMessage, ok := message.(proto.Message)
+	if !ok {
+		return ""
+	}
+	reflected := protoMessage.ProtoReflect()
+	fields := reflected.Descriptor().Fields()
+	for _, fieldName := range fieldNames {
+		if field := fields.ByName(protoreflect.Name(fieldName)); field != nil {
+			return fmt.Sprint(reflected.Get(field).Interface())
+		}
+	}
+	return ""
+}
+
+// The key of a handle: handles of different services may have the same value.
+func handleKey(method string, handle string) string {
+	return serviceOf(method) + "/" + handle
+}
+
+// The targets of an address: itself, or one per address of a "dns:///" host.
+func resolve(ctx context.Context, address string) ([]string, error) {
+	if !strings.HasPrefix(address, dnsScheme) {
+		return []string{address}, nil
+	}
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(address, dnsScheme))
+	if err != nil {
+		return nil, fmt.Errorf("g2pool: invalid endpoint %q: %w", address, err)
+	}
+	hostAddresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("g2pool: resolving %q: %w", address, err)
+	}
+	result := []string{}
+	for _, hostAddress := range hostAddresses {
+		result = append(result, net.JoinHostPort(hostAddress, port))
+	}
+	return result, nil
+}
+
+// The service of a full gRPC method name, e.g. "g2engine.G2Engine".
+func serviceOf(method string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return service
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Report whether an endpoint may be chosen; the caller holds the lock.
+func (endpoint *endpoint) isHealthy(now time.Time) bool {
+	if now.Before(endpoint.ejectedUntil) {
+		return false
+	}
+	state := endpoint.connection.GetState()
+	return state != connectivity.TransientFailure && state != connectivity.Shutdown
+}
+
+// Choose the endpoint of a call and count it as outstanding.
+func (pool *Pool) acquire(method string, request interface{}) *endpoint {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+	chosen := pool.sticky(method, request)
+	if chosen == nil {
+		chosen = pool.choose()
+	}
+	chosen.calls++
+	chosen.outstanding++
+	return chosen
+}
+
+// Choose an endpoint by the Policy among the healthy endpoints, or among all if none are; the caller holds the lock.
+func (pool *Pool) choose() *endpoint {
+	now := time.Now()
+	candidates := []*endpoint{}
+	for _, endpoint := range pool.endpoints {
+		if endpoint.isHealthy(now) {
+			candidates = append(candidates, endpoint)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = pool.endpoints
+	}
+	start := pool.next % len(candidates)
+	pool.next++
+	chosen := candidates[start]
+	if pool.Policy == LeastOutstanding {
+		for offset := 1; offset < len(candidates); offset++ {
+			candidate := candidates[(start+offset)%len(candidates)]
+			if candidate.outstanding < chosen.outstanding {
+				chosen = candidate
+			}
+		}
+	}
+	return chosen
+}
+
+/*
+Record the outcome of a call: the endpoint's health and the handles it created, closed or destroyed.
+A new handle already open on another endpoint is not recorded: the error returned wraps ErrHandleConflict.
+*/
+func (pool *Pool) release(chosen *endpoint, method string, request interface{}, reply interface{}, err error) error {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+	chosen.outstanding--
+	switch {
+	case status.Code(err) == codes.Unavailable:
+		chosen.consecutiveFailures++
+		if pool.MaxFailures > 0 && chosen.consecutiveFailures >= pool.MaxFailures {
+			chosen.ejectedUntil = time.Now().Add(pool.EjectionDuration)
+			chosen.consecutiveFailures = 0
+		}
+	case err == nil:
+		chosen.consecutiveFailures = 0
+	}
+	if err != nil {
+		return nil
+	}
+	if _, ok := handleCreatingMethods[method]; ok {
+		if handle := handleOf(reply, "result"); handle != "" {
+			key := handleKey(method, handle)
+			if owner := pool.handles[key]; owner != nil && owner != chosen {
+				return fmt.Errorf("%w: %s returned handle %s on %s, open on %s", ErrHandleConflict, method, handle, chosen.address, owner.address)
+			}
+			pool.handles[key] = chosen
+		}
+	}
+	if handleClosingMethods[method] {
+		delete(pool.handles, handleKey(method, handleOf(request, handleFieldNames...)))
+	}
+	if handleDestroyingMethods[method] {
+		prefix := serviceOf(method) + "/"
+		for key, owner := range pool.handles {
+			if owner == chosen && strings.HasPrefix(key, prefix) {
+				delete(pool.handles, key)
+			}
+		}
+	}
+	return nil
+}
+
+// The endpoint that created the handle of a request, or nil; the caller holds the lock.
+func (pool *Pool) sticky(method string, request interface{}) *endpoint {
+	handle := handleOf(request, handleFieldNames...)
+	if handle == "" {
+		return nil
+	}
+	return pool.handles[handleKey(method, handle)]
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Close method closes the connection to every endpoint.
+*/
+func (pool *Pool) Close() error {
+	var result error
+	for _, endpoint := range pool.endpoints {
+		if err := endpoint.connection.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+/*
+The Connections method returns the connection to each endpoint, e.g. to check their health.
+*/
+func (pool *Pool) Connections() []*grpc.ClientConn {
+	result := []*grpc.ClientConn{}
+	for _, endpoint := range pool.endpoints {
+		result = append(result, endpoint.connection)
+	}
+	return result
+}
+
+/*
+The Endpoints method returns the health and load of each endpoint.
+*/
+func (pool *Pool) Endpoints() []EndpointStatus {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+	handles := map[*endpoint]int{}
+	for _, endpoint := range pool.handles {
+		handles[endpoint]++
+	}
+	now := time.Now()
+	result := []EndpointStatus{}
+	for _, endpoint := range pool.endpoints {
+		endpointStatus := EndpointStatus{
+			Address:             endpoint.address,
+			Calls:               endpoint.calls,
+			ConsecutiveFailures: endpoint.consecutiveFailures,
+			Handles:             handles[endpoint],
+			Outstanding:         endpoint.outstanding,
+			State:               endpoint.connection.GetState().String(),
+		}
+		if now.Before(endpoint.ejectedUntil) {
+			endpointStatus.EjectedUntil = endpoint.ejectedUntil
+		}
+		result = append(result, endpointStatus)
+	}
+	return result
+}
+
+/*
+The Invoke method sends a unary call to the endpoint chosen for it.
+If the call creates a handle already open on another endpoint, the new handle is closed
+and the error wraps ErrHandleConflict.
+It implements grpc.ClientConnInterface.
+*/
+func (pool *Pool) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	chosen := pool.acquire(method, args)
+	err := chosen.connection.Invoke(ctx, method, args, reply, opts...)
+	if conflict := pool.release(chosen, method, args, reply, err); conflict != nil {
+		if closeErr := closeHandle(ctx, chosen.connection, method, reply); closeErr != nil {
+			return fmt.Errorf("%w; closing it failed: %v", conflict, closeErr)
+		}
+		return conflict
+	}
+	return err
+}
+
+/*
+The NewStream method opens a stream to the endpoint chosen by the Policy.
+Streams are not sticky and are counted as outstanding only while they open.
+It implements grpc.ClientConnInterface.
+*/
+func (pool *Pool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	chosen := pool.acquire(method, nil)
+	stream, err := chosen.connection.NewStream(ctx, desc, method, opts...)
+	_ = pool.release(chosen, method, nil, nil, err)
+	return stream, err
+}
@@ -0,0 +1,251 @@
+package g2pool
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2config"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go-grpc/g2product"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
+	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	testServers []*grpctest.Server
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A Pool of connections to servers. The servers do not share a repository, so a handle is only valid on its own server.
+func getTestObject(ctx context.Context, test *testing.T, servers ...*grpctest.Server) *Pool {
+	connections := []*grpc.ClientConn{}
+	for _, server := range servers {
+		server.Reset()
+		connection, err := server.Dial(ctx)
+		testError(test, err)
+		connections = append(connections, connection)
+	}
+	pool := NewPoolFromConnections(connections...)
+	test.Cleanup(func() { pool.Close() })
+	return pool
+}
+
+func calls(pool *Pool) []int64 {
+	result := []int64{}
+	for _, endpoint := range pool.Endpoints() {
+		result = append(result, endpoint.Calls)
+	}
+	return result
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	testServers = []*grpctest.Server{grpctest.NewServer(), grpctest.NewServer()}
+	code := m.Run()
+	for _, server := range testServers {
+		server.Close()
+	}
+	os.Exit(code)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestPool_RoundRobin(test *testing.T) {
+	ctx := context.TODO()
+	pool := getTestObject(ctx, test, testServers...)
+	client := &g2product.G2product{GrpcClient: g2productpb.NewG2ProductClient(pool)}
+	for i := 0; i < 4; i++ {
+		_, err := client.Version(ctx)
+		testError(test, err)
+	}
+	assert.Equal(test, []int64{2, 2}, calls(pool))
+}
+
+func TestPool_LeastOutstanding(test *testing.T) {
+	ctx := context.TODO()
+	pool := getTestObject(ctx, test, testServers...)
+	pool.Policy = LeastOutstanding
+	pool.endpoints[0].outstanding = 5
+	for i := 0; i < 3; i++ {
+		assert.Same(test, pool.endpoints[1], pool.choose())
+	}
+	pool.endpoints[1].outstanding = 5
+	first, second := pool.choose(), pool.choose()
+	assert.NotSame(test, first, second, "ties are broken in turn")
+}
+
+func TestPool_StickyConfigHandle(test *testing.T) {
+	ctx := context.TODO()
+	pool := getTestObject(ctx, test, testServers...)
+	client := &g2config.G2config{GrpcClient: g2configpb.NewG2ConfigClient(pool)}
+	for i := 0; i < 4; i++ {
+		session, err := g2config.NewConfigSession(ctx, client, "")
+		testError(test, err)
+		_, err = session.AddDataSource(ctx, "CUSTOMERS")
+		testError(test, err)
+		_, err = session.Save(ctx)
+		testError(test, err)
+		testError(test, session.Close(ctx))
+	}
+	for _, endpoint := range pool.Endpoints() {
+		assert.Zero(test, endpoint.Handles)
+	}
+}
+
+func TestPool_StickyExportHandle(test *testing.T) {
+	ctx := context.TODO()
+	pool := getTestObject(ctx, test, testServers...)
+	client := &g2engine.G2engine{GrpcClient: g2enginepb.NewG2EngineClient(pool)}
+	for _, server := range testServers {
+		connection, err := server.Dial(ctx)
+		testError(test, err)
+		direct := &g2engine.G2engine{GrpcClient: g2enginepb.NewG2EngineClient(connection)}
+		testError(test, direct.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, ""))
+		connection.Close()
+	}
+	exportHandle, err := client.ExportJSONEntityReport(ctx, 0)
+	testError(test, err)
+	handles := 0
+	for _, endpoint := range pool.Endpoints() {
+		handles += endpoint.Handles
+	}
+	assert.Equal(test, 1, handles)
+	lines := 0
+	for {
+		line, err := client.FetchNext(ctx, exportHandle)
+		testError(test, err)
+		if line == "" {
+			break
+		}
+		lines++
+	}
+	assert.Equal(test, 1, lines)
+	testError(test, client.CloseExport(ctx, exportHandle))
+	for _, endpoint := range pool.Endpoints() {
+		assert.Zero(test, endpoint.Handles)
+	}
+}
+
+func TestPool_HandleConflict(test *testing.T) {
+	ctx := context.TODO()
+	servers := []*grpctest.Server{grpctest.NewServer(), grpctest.NewServer()}
+	for _, server := range servers {
+		defer server.Close()
+	}
+	pool := getTestObject(ctx, test, servers...)
+	client := g2configpb.NewG2ConfigClient(pool)
+	created, err := client.Create(ctx, &g2configpb.CreateRequest{})
+	testError(test, err)
+
+	// Each server numbers its handles from 1: the second server's handle is refused and closed there.
+	_, err = client.Create(ctx, &g2configpb.CreateRequest{})
+	assert.ErrorIs(test, err, ErrHandleConflict)
+	connection, err := servers[1].Dial(ctx)
+	testError(test, err)
+	defer connection.Close()
+	_, err = g2configpb.NewG2ConfigClient(connection).Close(ctx, &g2configpb.CloseRequest{ConfigHandle: created.GetResult()})
+	assert.Error(test, err)
+
+	// The first handle still goes to its own server.
+	_, err = client.ListDataSources(ctx, &g2configpb.ListDataSourcesRequest{ConfigHandle: created.GetResult()})
+	testError(test, err)
+	_, err = client.Close(ctx, &g2configpb.CloseRequest{ConfigHandle: created.GetResult()})
+	testError(test, err)
+	for _, endpoint := range pool.Endpoints() {
+		assert.Zero(test, endpoint.Handles)
+	}
+}
+
+func TestPool_Destroy(test *testing.T) {
+	ctx := context.TODO()
+	destroy := func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == "/g2config.G2Config/Destroy" {
+			return &g2configpb.DestroyResponse{}, nil
+		}
+		return handler(ctx, request)
+	}
+	server := grpctest.NewServer(grpc.ChainUnaryInterceptor(destroy))
+	defer server.Close()
+	pool := getTestObject(ctx, test, server)
+	client := g2configpb.NewG2ConfigClient(pool)
+	_, err := client.Create(ctx, &g2configpb.CreateRequest{})
+	testError(test, err)
+	assert.Equal(test, 1, pool.Endpoints()[0].Handles)
+	_, err = client.Destroy(ctx, &g2configpb.DestroyRequest{})
+	testError(test, err)
+	assert.Zero(test, pool.Endpoints()[0].Handles, "Destroy() invalidates the handles of its service")
+}
+
+func TestPool_Ejection(test *testing.T) {
+	ctx := context.TODO()
+	failing := grpctest.NewServer()
+	pool := getTestObject(ctx, test, testServers[0], failing)
+	pool.MaxFailures = 1
+	pool.EjectionDuration = time.Minute
+	failing.Close()
+	client := &g2product.G2product{GrpcClient: g2productpb.NewG2ProductClient(pool)}
+	failures := 0
+	for i := 0; i < 10; i++ {
+		if _, err := client.Version(ctx); err != nil {
+			failures++
+		}
+	}
+	assert.LessOrEqual(test, failures, pool.MaxFailures)
+	endpoints := pool.Endpoints()
+	assert.True(test, !endpoints[1].EjectedUntil.IsZero() || endpoints[1].State == "TRANSIENT_FAILURE", endpoints[1])
+}
+
+func TestPool_AllEjected(test *testing.T) {
+	ctx := context.TODO()
+	pool := getTestObject(ctx, test, testServers...)
+	for _, endpoint := range pool.endpoints {
+		endpoint.ejectedUntil = time.Now().Add(time.Minute)
+	}
+	client := &g2product.G2product{GrpcClient: g2productpb.NewG2ProductClient(pool)}
+	_, err := client.Version(ctx)
+	testError(test, err)
+}
+
+func TestNewPool(test *testing.T) {
+	ctx := context.TODO()
+	pool, err := NewPool(ctx, []string{"dns:///localhost:8258", "senzing:8258"}, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	testError(test, err)
+	defer pool.Close()
+	addresses := []string{}
+	for _, endpoint := range pool.Endpoints() {
+		addresses = append(addresses, endpoint.Address)
+	}
+	assert.GreaterOrEqual(test, len(addresses), 2)
+	assert.Equal(test, "senzing:8258", addresses[len(addresses)-1])
+	assert.NotContains(test, addresses, "dns:///localhost:8258")
+	_, err = NewPool(ctx, nil)
+	assert.Error(test, err)
+}
+
+func TestHandleOf(test *testing.T) {
+	assert.Equal(test, "7", handleOf(&g2configpb.SaveRequest{ConfigHandle: 7}, handleFieldNames...))
+	assert.Equal(test, "", handleOf(&g2productpb.VersionRequest{}, handleFieldNames...))
+	assert.Equal(test, "", handleOf(nil, handleFieldNames...))
+	assert.NotEqual(test, handleKey("/g2config.G2Config/Save", "7"), handleKey("/g2engine.G2Engine/FetchNext", "7"))
+}
@@ -0,0 +1,57 @@
+package g2pool
+
+import (
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// How a Pool chooses the endpoint of a call that is not sticky.
+const (
+	RoundRobin       Policy = iota // Each endpoint in turn.
+	LeastOutstanding               // The endpoint waiting on the fewest calls; ties in turn.
+)
+
+// Default values used by NewPool().
+const (
+	DefaultEjectionDuration = 30 * time.Second
+	DefaultMaxFailures      = 3
+)
+
+// The prefix of endpoints resolved to one endpoint per address.
+const dnsScheme = "dns:///"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrHandleConflict is wrapped by the error of a call whose new handle is already open on another endpoint.
+var ErrHandleConflict = errors.New("g2pool: handle already open on another endpoint")
+
+// Full gRPC method names that create a handle, returned as their "result", and the method closing it.
+var handleCreatingMethods = map[string]string{
+	"/g2config.G2Config/Create":                      "/g2config.G2Config/Close",
+	"/g2diagnostic.G2Diagnostic/GetEntityListBySize": "/g2diagnostic.G2Diagnostic/CloseEntityListBySize",
+	"/g2engine.G2Engine/ExportCSVEntityReport":       "/g2engine.G2Engine/CloseExport",
+	"/g2engine.G2Engine/ExportJSONEntityReport":      "/g2engine.G2Engine/CloseExport",
+}
+
+// Full gRPC method names that close the handle they are given.
+var handleClosingMethods = map[string]bool{
+	"/g2config.G2Config/Close":                         true,
+	"/g2diagnostic.G2Diagnostic/CloseEntityListBySize": true,
+	"/g2engine.G2Engine/CloseExport":                   true,
+}
+
+// Full gRPC method names that invalidate every handle of their service on the server.
+var handleDestroyingMethods = map[string]bool{
+	"/g2config.G2Config/Destroy":         true,
+	"/g2diagnostic.G2Diagnostic/Destroy": true,
+	"/g2engine.G2Engine/Destroy":         true,
+}
+
+// Request fields holding a handle; a request with one is sent to the endpoint that created the handle.
+var handleFieldNames = []string{"configHandle", "entityListBySizeHandle", "responseHandle"}
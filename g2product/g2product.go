@@ -7,17 +7,18 @@ package g2product
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/senzing/g2-sdk-go-grpc/g2deadline"
+	"github.com/senzing/g2-sdk-go-grpc/g2metadata"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
 	g2productapi "github.com/senzing/g2-sdk-go/g2product"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2product"
 	"github.com/senzing/go-logging/logger"
 	"github.com/senzing/go-logging/messagelogger"
 	"github.com/senzing/go-observing/observer"
-	"github.com/senzing/go-observing/subject"
 )
 
 // ----------------------------------------------------------------------------
@@ -26,9 +27,10 @@ import (
 
 type G2product struct {
 	GrpcClient g2pb.G2ProductClient
+	deadlines  *g2deadline.Deadlines
 	isTrace    bool
 	logger     messagelogger.MessageLoggerInterface
-	observers  subject.Subject
+	observers  *g2observer.Queue
 }
 
 // ----------------------------------------------------------------------------
@@ -49,25 +51,24 @@ func (client *G2product) notify(ctx context.Context, messageId int, err error, d
 	details["subjectId"] = strconv.Itoa(ProductId)
 	details["messageId"] = strconv.Itoa(messageId)
 	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	details["correlationID"] = g2metadata.CorrelationID(ctx)
 	if err != nil {
 		details["error"] = err.Error()
 	}
-	message, err := json.Marshal(details)
+	err = client.observers.Notify(ctx, details)
 	if err != nil {
 		fmt.Printf("Error: %s", err.Error())
-	} else {
-		client.observers.NotifyObservers(ctx, string(message))
 	}
 }
 
 // Trace method entry.
-func (client *G2product) traceEntry(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2product) traceEntry(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // Trace method exit.
-func (client *G2product) traceExit(errorNumber int, details ...interface{}) {
-	client.getLogger().Log(errorNumber, details...)
+func (client *G2product) traceExit(ctx context.Context, errorNumber int, details ...interface{}) {
+	client.getLogger().Log(errorNumber, append(details, map[string]string{"correlationID": g2metadata.CorrelationID(ctx)})...)
 }
 
 // ----------------------------------------------------------------------------
@@ -82,24 +83,40 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2product) Destroy(ctx context.Context) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(3)
+		client.traceEntry(ctx, 3)
 	}
 	entryTime := time.Now()
 	request := g2pb.DestroyRequest{}
-	_, err := client.GrpcClient.Destroy(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Destroy", &request, client.GrpcClient.Destroy)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8001, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8001, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(4, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 4, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The FlushObservers method waits until the messages to observers queued before the call are delivered,
+e.g. before the process exits.
+
+Input
+  - ctx: A context to control lifecycle; its deadline bounds the wait.
+
+Output
+  - ctx.Err() if ctx is done first.
+*/
+func (client *G2product) FlushObservers(ctx context.Context) error {
+	if client.observers == nil {
+		return nil
+	}
+	return client.observers.Flush(ctx)
+}
+
 /*
 The GetSdkId method returns the identifier of this particular Software Development Kit (SDK).
 It is handy when working with multiple implementations of the same G2productInterface.
@@ -109,19 +126,18 @@ Input
   - ctx: A context to control lifecycle.
 */
 func (client *G2product) GetSdkId(ctx context.Context) string {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(25)
+		client.traceEntry(ctx, 25)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8007, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8007, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(26, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 26, err, time.Since(entryTime))
 	}
 	return "grpc"
 }
@@ -137,8 +153,9 @@ Input
   - verboseLogging: A flag to enable deeper logging of the G2 processing. 0 for no Senzing logging; 1 for logging.
 */
 func (client *G2product) Init(ctx context.Context, moduleName string, iniParams string, verboseLogging int) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(9, moduleName, iniParams, verboseLogging)
+		client.traceEntry(ctx, 9, moduleName, iniParams, verboseLogging)
 	}
 	entryTime := time.Now()
 	request := g2pb.InitRequest{
@@ -146,19 +163,17 @@ func (client *G2product) Init(ctx context.Context, moduleName string, iniParams
 		IniParams:      iniParams,
 		VerboseLogging: int32(verboseLogging),
 	}
-	_, err := client.GrpcClient.Init(ctx, &request)
+	_, err := g2deadline.Call(ctx, client.deadlines, "Init", &request, client.GrpcClient.Init)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"iniParams":      iniParams,
-				"moduleName":     moduleName,
-				"verboseLogging": strconv.Itoa(verboseLogging),
-			}
-			client.notify(ctx, 8002, err, details)
-		}()
+		details := map[string]string{
+			"iniParams":      iniParams,
+			"moduleName":     moduleName,
+			"verboseLogging": strconv.Itoa(verboseLogging),
+		}
+		client.notify(ctx, 8002, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(10, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 10, moduleName, iniParams, verboseLogging, err, time.Since(entryTime))
 	}
 	return err
 }
@@ -174,20 +189,19 @@ Output
     See the example output.
 */
 func (client *G2product) License(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(11)
+		client.traceEntry(ctx, 11)
 	}
 	entryTime := time.Now()
 	request := g2pb.LicenseRequest{}
-	response, err := client.GrpcClient.License(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "License", &request, client.GrpcClient.License)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8003, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8003, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(12, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 12, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -200,28 +214,39 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2product) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(21, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 21, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	if client.observers == nil {
-		client.observers = &subject.SubjectImpl{}
+		client.observers = g2observer.NewQueue()
 	}
 	err := client.observers.RegisterObserver(ctx, observer)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"observerID": observer.GetObserverId(ctx),
-			}
-			client.notify(ctx, 8008, err, details)
-		}()
+		details := map[string]string{
+			"observerID": observer.GetObserverId(ctx),
+		}
+		client.notify(ctx, 8008, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(22, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 22, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetDeadlines method sets the default deadlines of calls whose context has none.
+Without it, the client uses g2deadline.DefaultTimeout and g2deadline.DefaultTimeouts;
+use g2deadline.NoTimeout in Deadlines to opt a method out.
+
+Input
+  - deadlines: The default timeout of each method.
+*/
+func (client *G2product) SetDeadlines(deadlines *g2deadline.Deadlines) {
+	client.deadlines = deadlines
+}
+
 /*
 The SetLogLevel method sets the level of logging.
 
@@ -230,27 +255,44 @@ Input
   - logLevel: The desired log level. TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
 */
 func (client *G2product) SetLogLevel(ctx context.Context, logLevel logger.Level) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(13, logLevel)
+		client.traceEntry(ctx, 13, logLevel)
 	}
 	entryTime := time.Now()
 	var err error = nil
 	client.getLogger().SetLogLevel(messagelogger.Level(logLevel))
 	client.isTrace = (client.getLogger().GetLogLevel() == messagelogger.LevelTrace)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{
-				"logLevel": logger.LevelToTextMap[logLevel],
-			}
-			client.notify(ctx, 8009, err, details)
-		}()
+		details := map[string]string{
+			"logLevel": logger.LevelToTextMap[logLevel],
+		}
+		client.notify(ctx, 8009, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(14, logLevel, err, time.Since(entryTime))
+		defer client.traceExit(ctx, 14, logLevel, err, time.Since(entryTime))
 	}
 	return err
 }
 
+/*
+The SetObserverQueue method sets the Queue delivering the messages of the client to its observers.
+The observers already registered are registered on it.
+Without it, the client uses a Queue created by g2observer.NewQueue().
+
+Input
+  - queue: The Queue, usually created by g2observer.NewQueue().
+*/
+func (client *G2product) SetObserverQueue(queue *g2observer.Queue) {
+	if client.observers != nil {
+		ctx := context.Background()
+		for _, observer := range client.observers.GetObservers(ctx) {
+			_ = queue.RegisterObserver(ctx, observer)
+		}
+	}
+	client.observers = queue
+}
+
 /*
 The UnregisterObserver method removes the observer to the list of observers notified.
 
@@ -259,27 +301,22 @@ Input
   - observer: The observer to be added.
 */
 func (client *G2product) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(23, observer.GetObserverId(ctx))
+		client.traceEntry(ctx, 23, observer.GetObserverId(ctx))
 	}
 	entryTime := time.Now()
 	var err error = nil
 	if client.observers != nil {
-		// Tricky code:
-		// client.notify is called synchronously before client.observers is set to nil.
-		// In client.notify, each observer will get notified in a goroutine.
-		// Then client.observers may be set to nil, but observer goroutines will be OK.
+		// The message is queued before the observer is removed, so the observer gets it.
 		details := map[string]string{
 			"observerID": observer.GetObserverId(ctx),
 		}
 		client.notify(ctx, 8010, err, details)
-	}
-	err = client.observers.UnregisterObserver(ctx, observer)
-	if !client.observers.HasObservers(ctx) {
-		client.observers = nil
+		err = client.observers.UnregisterObserver(ctx, observer)
 	}
 	if client.isTrace {
-		defer client.traceExit(24, observer.GetObserverId(ctx), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 24, observer.GetObserverId(ctx), err, time.Since(entryTime))
 	}
 	return err
 }
@@ -297,22 +334,21 @@ Output
   - The returned string has additional information.
 */
 func (client *G2product) ValidateLicenseFile(ctx context.Context, licenseFilePath string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(15, licenseFilePath)
+		client.traceEntry(ctx, 15, licenseFilePath)
 	}
 	entryTime := time.Now()
 	request := g2pb.ValidateLicenseFileRequest{
 		LicenseFilePath: licenseFilePath,
 	}
-	response, err := client.GrpcClient.ValidateLicenseFile(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ValidateLicenseFile", &request, client.GrpcClient.ValidateLicenseFile)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8004, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8004, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(16, licenseFilePath, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 16, licenseFilePath, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -331,22 +367,21 @@ Output
     See the example output.
 */
 func (client *G2product) ValidateLicenseStringBase64(ctx context.Context, licenseString string) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(17, licenseString)
+		client.traceEntry(ctx, 17, licenseString)
 	}
 	entryTime := time.Now()
 	request := g2pb.ValidateLicenseStringBase64Request{
 		LicenseString: licenseString,
 	}
-	response, err := client.GrpcClient.ValidateLicenseStringBase64(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "ValidateLicenseStringBase64", &request, client.GrpcClient.ValidateLicenseStringBase64)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8005, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8005, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(18, licenseString, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 18, licenseString, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
@@ -362,20 +397,19 @@ Output
     See the example output.
 */
 func (client *G2product) Version(ctx context.Context) (string, error) {
+	ctx = g2metadata.NewContext(ctx)
 	if client.isTrace {
-		client.traceEntry(19)
+		client.traceEntry(ctx, 19)
 	}
 	entryTime := time.Now()
 	request := g2pb.VersionRequest{}
-	response, err := client.GrpcClient.Version(ctx, &request)
+	response, err := g2deadline.Call(ctx, client.deadlines, "Version", &request, client.GrpcClient.Version)
 	if client.observers != nil {
-		go func() {
-			details := map[string]string{}
-			client.notify(ctx, 8006, err, details)
-		}()
+		details := map[string]string{}
+		client.notify(ctx, 8006, err, details)
 	}
 	if client.isTrace {
-		defer client.traceExit(20, response.GetResult(), err, time.Since(entryTime))
+		defer client.traceExit(ctx, 20, response.GetResult(), err, time.Since(entryTime))
 	}
 	return response.GetResult(), err
 }
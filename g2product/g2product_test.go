@@ -5,10 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	truncator "github.com/aquilax/truncate"
+	"github.com/senzing/g2-sdk-go-grpc/g2observer"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 	"github.com/senzing/g2-sdk-go/g2api"
 	g2pb "github.com/senzing/g2-sdk-proto/go/g2product"
 	"github.com/senzing/go-logging/logger"
@@ -28,6 +33,33 @@ var (
 	grpcConnection     *grpc.ClientConn
 )
 
+// ----------------------------------------------------------------------------
+// Internal types
+// ----------------------------------------------------------------------------
+
+// An observer recording each message, slower than the calls it observes.
+type slowObserver struct {
+	lock     sync.Mutex
+	messages []map[string]string
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (observer *slowObserver) GetObserverId(ctx context.Context) string {
+	return "slowObserver"
+}
+
+func (observer *slowObserver) UpdateObserver(ctx context.Context, message string) {
+	time.Sleep(time.Millisecond)
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, details)
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -35,7 +67,11 @@ var (
 func getGrpcConnection() *grpc.ClientConn {
 	var err error
 	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if grpctest.Enabled() {
+			grpcConnection, err = grpctest.Dial(context.TODO())
+		} else {
+			grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
 		if err != nil {
 			fmt.Printf("Did not connect: %v\n", err)
 		}
@@ -181,6 +217,48 @@ func TestG2product_Version(test *testing.T) {
 	printActual(test, actual)
 }
 
+func TestG2product_ObserverOrder(test *testing.T) {
+	ctx := context.TODO()
+	fakeServer := grpctest.NewServer()
+	defer fakeServer.Close()
+	grpcConnection, err := fakeServer.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	defer grpcConnection.Close()
+	g2product := &G2product{GrpcClient: g2pb.NewG2ProductClient(grpcConnection)}
+	queue := g2observer.NewQueue()
+	queue.Capacity = 2
+	g2product.SetObserverQueue(queue)
+	observer := &slowObserver{}
+	assert.Nil(test, g2product.RegisterObserver(ctx, observer))
+
+	// Calls outpace the observer; with the Block policy, none of their messages is lost.
+	expected := []string{"8008"}
+	for i := 0; i < 20; i++ {
+		_, err = g2product.Version(ctx)
+		assert.Nil(test, err)
+		_, err = g2product.License(ctx)
+		assert.Nil(test, err)
+		expected = append(expected, "8006", "8003")
+	}
+	assert.Nil(test, g2product.FlushObservers(ctx))
+	assert.Nil(test, g2product.UnregisterObserver(ctx, observer))
+	assert.Nil(test, g2product.FlushObservers(ctx))
+	expected = append(expected, "8010")
+
+	// Messages arrive in the order of the calls, numbered from 1.
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	messageIds := []string{}
+	for i, message := range observer.messages {
+		messageIds = append(messageIds, message["messageId"])
+		assert.Equal(test, strconv.Itoa(i+1), message["sequence"])
+	}
+	assert.Equal(test, expected, messageIds)
+	assert.Equal(test, uint64(0), queue.Dropped())
+}
+
 func TestG2product_Destroy(test *testing.T) {
 	ctx := context.TODO()
 	g2product := getTestObject(ctx, test)
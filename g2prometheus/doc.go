@@ -0,0 +1,18 @@
+/*
+The g2prometheus package exposes Senzing SDK client usage as Prometheus metrics.
+
+Metrics is a prometheus.Collector counting calls, errors by Senzing error code, in-flight calls
+and call latency, by service and method. It is fed in one of two ways:
+  - as a gRPC unary client interceptor, usually with g2client.WithUnaryInterceptors(metrics.UnaryClientInterceptor()),
+    which measures every call including in-flight calls and latency;
+  - as a go-observing observer registered with G2engine, G2diagnostic and G2configmgr clients,
+    which translates the 8xxx message IDs sent by their notify() calls into call and error counts.
+    Observers are not told about in-flight calls or latency.
+
+Use one or the other for a given client; using both counts each call twice.
+
+	metrics := g2prometheus.NewMetrics()
+	prometheus.MustRegister(metrics)
+	http.Handle("/metrics", promhttp.Handler())
+*/
+package g2prometheus
@@ -0,0 +1,190 @@
+/*
+ *
+ */
+
+// Package g2prometheus exposes Senzing SDK client usage as Prometheus metrics.
+package g2prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Metrics counts and times Senzing calls. It is a prometheus.Collector and a go-observing observer.
+type Metrics struct {
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	inFlight *prometheus.GaugeVec
+}
+
+// The methods of one client package, by messageId.
+type observedService struct {
+	methods map[string]string
+	service string
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewMetrics function returns Metrics with no calls recorded.
+Latency is bucketed by DefaultBuckets.
+*/
+func NewMetrics() *Metrics {
+	return &Metrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "calls_total",
+			Help:      "Number of Senzing calls made.",
+		}, []string{"service", "method"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "call_duration_seconds",
+			Help:      "Duration of Senzing calls.",
+			Buckets:   DefaultBuckets,
+		}, []string{"service", "method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "errors_total",
+			Help:      "Number of failed Senzing calls, by Senzing error code.",
+		}, []string{"service", "method", "code"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "in_flight_calls",
+			Help:      "Number of Senzing calls waiting for a response.",
+		}, []string{"service", "method"}),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// The Senzing error code of a message, e.g. "33", or "unknown" if it has none.
+func errorCode(message string) string {
+	senzingCode := g2error.G2ErrorCode(message)
+	if senzingCode == 0 {
+		return "unknown"
+	}
+	return strconv.Itoa(senzingCode)
+}
+
+// The service and method of a full gRPC method name, e.g. "g2engine" and "AddRecord" for "/g2engine.G2Engine/AddRecord".
+func splitMethod(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	service, _, _ = strings.Cut(service, ".")
+	return service, method
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (metrics *Metrics) record(service string, method string, failed bool, errorMessage string) {
+	metrics.calls.WithLabelValues(service, method).Inc()
+	if failed {
+		metrics.errors.WithLabelValues(service, method, errorCode(errorMessage)).Inc()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// prometheus.Collector methods
+// ----------------------------------------------------------------------------
+
+/*
+The Collect method sends the current value of every metric to the channel.
+*/
+func (metrics *Metrics) Collect(ch chan<- prometheus.Metric) {
+	metrics.calls.Collect(ch)
+	metrics.duration.Collect(ch)
+	metrics.errors.Collect(ch)
+	metrics.inFlight.Collect(ch)
+}
+
+/*
+The Describe method sends the description of every metric to the channel.
+*/
+func (metrics *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	metrics.calls.Describe(ch)
+	metrics.duration.Describe(ch)
+	metrics.errors.Describe(ch)
+	metrics.inFlight.Describe(ch)
+}
+
+// ----------------------------------------------------------------------------
+// observer.Observer methods
+// ----------------------------------------------------------------------------
+
+/*
+The GetObserverId method returns the identifier of the Metrics observer.
+*/
+func (metrics *Metrics) GetObserverId(ctx context.Context) string {
+	return "g2prometheus"
+}
+
+/*
+The UpdateObserver method counts the call described by a message sent by a G2engine, G2diagnostic or G2configmgr client.
+Messages from other subjects, and messages that do not describe calls, are ignored.
+
+Input
+  - ctx: A context to control lifecycle.
+  - message: The JSON message, with "subjectId", "messageId" and, for failed calls, "error".
+*/
+func (metrics *Metrics) UpdateObserver(ctx context.Context, message string) {
+	details := map[string]string{}
+	if json.Unmarshal([]byte(message), &details) != nil {
+		return
+	}
+	if _, registration := details["observerID"]; registration {
+		return
+	}
+	observed, ok := observedMethods[details["subjectId"]]
+	if !ok {
+		return
+	}
+	method, ok := observed.methods[details["messageId"]]
+	if !ok {
+		return
+	}
+	errorMessage, failed := details["error"]
+	metrics.record(observed.service, method, failed, errorMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Interceptors
+// ----------------------------------------------------------------------------
+
+/*
+The UnaryClientInterceptor method returns a gRPC interceptor that measures every unary call.
+*/
+func (metrics *Metrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, fullMethod string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		service, method := splitMethod(fullMethod)
+		inFlight := metrics.inFlight.WithLabelValues(service, method)
+		inFlight.Inc()
+		entryTime := time.Now()
+		err := invoker(ctx, fullMethod, request, reply, cc, opts...)
+		metrics.duration.WithLabelValues(service, method).Observe(time.Since(entryTime).Seconds())
+		inFlight.Dec()
+		metrics.record(service, method, err != nil, status.Convert(err).Message())
+		return err
+	}
+}
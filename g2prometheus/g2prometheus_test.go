@@ -0,0 +1,224 @@
+package g2prometheus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	g2configmgrpb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufferSize = 1024 * 1024
+)
+
+var (
+	engineServer = &g2engineServer{release: make(chan struct{})}
+	listener     *bufconn.Listener
+	server       *grpc.Server
+)
+
+type g2configmgrServer struct {
+	g2configmgrpb.UnimplementedG2ConfigMgrServer
+}
+
+func (server *g2configmgrServer) GetDefaultConfigID(ctx context.Context, request *g2configmgrpb.GetDefaultConfigIDRequest) (*g2configmgrpb.GetDefaultConfigIDResponse, error) {
+	return &g2configmgrpb.GetDefaultConfigIDResponse{ConfigID: 1}, nil
+}
+
+// A G2engine server where AddRecord succeeds, GetRecord fails and Stats blocks until released.
+type g2engineServer struct {
+	g2pb.UnimplementedG2EngineServer
+	release chan struct{}
+}
+
+func (server *g2engineServer) AddRecord(ctx context.Context, request *g2pb.AddRecordRequest) (*g2pb.AddRecordResponse, error) {
+	return &g2pb.AddRecordResponse{}, nil
+}
+
+func (server *g2engineServer) GetRecord(ctx context.Context, request *g2pb.GetRecordRequest) (*g2pb.GetRecordResponse, error) {
+	return nil, status.Error(codes.NotFound, `{"errors":[{"text":"0033E|Unknown record: dsrc[CUSTOMERS], record[9999]"}]}`)
+}
+
+func (server *g2engineServer) Stats(ctx context.Context, request *g2pb.StatsRequest) (*g2pb.StatsResponse, error) {
+	<-server.release
+	return &g2pb.StatsResponse{Result: `{}`}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func bufDialer(ctx context.Context, address string) (net.Conn, error) {
+	return listener.DialContext(ctx)
+}
+
+func getConnection(ctx context.Context, test *testing.T, opts ...grpc.DialOption) *grpc.ClientConn {
+	opts = append([]grpc.DialOption{grpc.WithContextDialer(bufDialer), grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	connection, err := grpc.DialContext(ctx, "bufnet", opts...)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { connection.Close() })
+	return connection
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2configmgrpb.RegisterG2ConfigMgrServer(server, &g2configmgrServer{})
+	g2pb.RegisterG2EngineServer(server, engineServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestMetrics_UnaryClientInterceptor(test *testing.T) {
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	connection := getConnection(ctx, test, grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor()))
+	g2engine := &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}
+	for i := 0; i < 3; i++ {
+		assert.Nil(test, g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "LOAD"))
+	}
+	_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "9999")
+	assert.NotNil(test, err)
+
+	assert.Equal(test, 3.0, testutil.ToFloat64(metrics.calls.WithLabelValues("g2engine", "AddRecord")))
+	assert.Equal(test, 1.0, testutil.ToFloat64(metrics.calls.WithLabelValues("g2engine", "GetRecord")))
+	assert.Equal(test, 1.0, testutil.ToFloat64(metrics.errors.WithLabelValues("g2engine", "GetRecord", "33")))
+	assert.Equal(test, 0.0, testutil.ToFloat64(metrics.inFlight.WithLabelValues("g2engine", "AddRecord")))
+	assert.Equal(test, 2, testutil.CollectAndCount(metrics, "senzing_client_call_duration_seconds"))
+}
+
+func TestMetrics_UnaryClientInterceptor_InFlight(test *testing.T) {
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	connection := getConnection(ctx, test, grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor()))
+	g2engine := &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}
+	done := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, _ = g2engine.Stats(ctx)
+			done <- struct{}{}
+		}()
+	}
+	inFlight := metrics.inFlight.WithLabelValues("g2engine", "Stats")
+	assert.Eventually(test, func() bool { return testutil.ToFloat64(inFlight) == 2 }, time.Second, time.Millisecond)
+	engineServer.release <- struct{}{}
+	engineServer.release <- struct{}{}
+	<-done
+	<-done
+	assert.Equal(test, 0.0, testutil.ToFloat64(inFlight))
+}
+
+func TestMetrics_UpdateObserver(test *testing.T) {
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	connection := getConnection(ctx, test)
+	g2engine := &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(connection)}
+	g2configmgr := &g2configmgr.G2configmgr{GrpcClient: g2configmgrpb.NewG2ConfigMgrClient(connection)}
+	assert.Nil(test, g2engine.RegisterObserver(ctx, metrics))
+	assert.Nil(test, g2configmgr.RegisterObserver(ctx, metrics))
+	assert.Nil(test, g2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{}`, "LOAD"))
+	_, err := g2engine.GetRecord(ctx, "CUSTOMERS", "9999")
+	assert.NotNil(test, err)
+	_, err = g2configmgr.GetDefaultConfigID(ctx)
+	assert.Nil(test, err)
+
+	// Observers are notified asynchronously.
+
+	assert.Eventually(test, func() bool {
+		return testutil.ToFloat64(metrics.calls.WithLabelValues("g2configmgr", "GetDefaultConfigID")) == 1 &&
+			testutil.ToFloat64(metrics.calls.WithLabelValues("g2engine", "AddRecord")) == 1 &&
+			testutil.ToFloat64(metrics.errors.WithLabelValues("g2engine", "GetRecord", "33")) == 1
+	}, time.Second, time.Millisecond)
+
+	// Registering an observer is not a call.
+
+	assert.Equal(test, 3, testutil.CollectAndCount(metrics, "senzing_client_calls_total"))
+}
+
+func TestMetrics_UpdateObserver_Ignored(test *testing.T) {
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	metrics.UpdateObserver(ctx, `not JSON`)
+	metrics.UpdateObserver(ctx, `{"subjectId":"6027","messageId":"8001"}`)
+	metrics.UpdateObserver(ctx, `{"subjectId":"6024","messageId":"8999"}`)
+	metrics.UpdateObserver(ctx, `{"subjectId":"6022","messageId":"8010","observerID":"g2prometheus"}`)
+	metrics.UpdateObserver(ctx, `{"subjectId":"6024","messageId":"8001","error":"connection refused"}`)
+	assert.Equal(test, 1, testutil.CollectAndCount(metrics, "senzing_client_calls_total"))
+	assert.Equal(test, 1.0, testutil.ToFloat64(metrics.errors.WithLabelValues("g2engine", "AddRecord", "unknown")))
+}
+
+func TestMetrics_Collector(test *testing.T) {
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	registry := prometheus.NewPedanticRegistry()
+	assert.Nil(test, registry.Register(metrics))
+	metrics.UpdateObserver(ctx, `{"subjectId":"6023","messageId":"8007","error":"0033E|Unknown"}`)
+	expected := `
+# HELP senzing_client_errors_total Number of failed Senzing calls, by Senzing error code.
+# TYPE senzing_client_errors_total counter
+senzing_client_errors_total{code="33",method="GetDataSourceCounts",service="g2diagnostic"} 1
+`
+	assert.Nil(test, testutil.GatherAndCompare(registry, strings.NewReader(expected), "senzing_client_errors_total"))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleMetrics_UpdateObserver() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2prometheus/g2prometheus_test.go
+	ctx := context.TODO()
+	metrics := NewMetrics()
+	metrics.UpdateObserver(ctx, `{"subjectId":"6024","messageId":"8001","dataSourceCode":"CUSTOMERS","recordID":"1001"}`)
+	fmt.Println(testutil.ToFloat64(metrics.calls.WithLabelValues("g2engine", "AddRecord")))
+	// Output: 1
+}
@@ -0,0 +1,151 @@
+package g2prometheus
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Prefix of every metric name, e.g. "senzing_client_calls_total".
+const (
+	Namespace = "senzing"
+	Subsystem = "client"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Upper bounds, in seconds, of the latency histogram buckets.
+var DefaultBuckets = prometheus.ExponentialBuckets(0.001, 4, 9)
+
+// Service names and method names of the messageIds sent to observers, by subjectId.
+// Observer registration messages are not calls; they are told apart by their observerID, not by messageId.
+var observedMethods = map[string]observedService{
+	"6022": {
+		service: "g2configmgr",
+		methods: map[string]string{
+			"8001": "AddConfig",
+			"8002": "Destroy",
+			"8003": "GetConfig",
+			"8004": "GetConfigList",
+			"8005": "GetDefaultConfigID",
+			"8006": "Init",
+			"8007": "ReplaceDefaultConfigID",
+			"8008": "SetDefaultConfigID",
+			"8010": "GetSdkId",
+			"8011": "SetLogLevel",
+		},
+	},
+	"6023": {
+		service: "g2diagnostic",
+		methods: map[string]string{
+			"8001": "CheckDBPerf",
+			"8002": "CloseEntityListBySize",
+			"8003": "Destroy",
+			"8004": "FetchNextEntityBySize",
+			"8005": "FindEntitiesByFeatureIDs",
+			"8006": "GetAvailableMemory",
+			"8007": "GetDataSourceCounts",
+			"8008": "GetDBInfo",
+			"8009": "GetEntityDetails",
+			"8010": "GetEntityListBySize",
+			"8011": "GetEntityResume",
+			"8012": "GetEntitySizeBreakdown",
+			"8013": "GetFeature",
+			"8014": "GetGenericFeatures",
+			"8015": "GetLogicalCores",
+			"8016": "GetMappingStatistics",
+			"8017": "GetPhysicalCores",
+			"8018": "GetRelationshipDetails",
+			"8019": "GetResolutionStatistics",
+			"8020": "GetTotalSystemMemory",
+			"8021": "Init",
+			"8022": "InitWithConfigID",
+			"8023": "Reinit",
+			"8024": "GetSdkId",
+			"8026": "SetLogLevel",
+		},
+	},
+	"6024": {
+		service: "g2engine",
+		methods: map[string]string{
+			"8001": "AddRecord",
+			"8002": "AddRecordWithInfo",
+			"8003": "AddRecordWithInfoWithReturnedRecordID",
+			"8004": "AddRecordWithReturnedRecordID",
+			"8005": "CheckRecord",
+			"8006": "CloseExport",
+			"8007": "CountRedoRecords",
+			"8008": "DeleteRecord",
+			"8009": "DeleteRecordWithInfo",
+			"8010": "Destroy",
+			"8011": "ExportConfig",
+			"8012": "ExportConfigAndConfigID",
+			"8013": "ExportCSVEntityReport",
+			"8014": "ExportJSONEntityReport",
+			"8015": "FetchNext",
+			"8016": "FindInterestingEntitiesByEntityID",
+			"8017": "FindInterestingEntitiesByRecordID",
+			"8018": "FindNetworkByEntityID",
+			"8019": "FindNetworkByEntityID_V2",
+			"8020": "FindNetworkByRecordID",
+			"8021": "FindNetworkByRecordID_V2",
+			"8022": "FindPathByEntityID",
+			"8023": "FindPathByEntityID_V2",
+			"8024": "FindPathByRecordID",
+			"8025": "FindPathByRecordID_V2",
+			"8026": "FindPathExcludingByEntityID",
+			"8027": "FindPathExcludingByEntityID_V2",
+			"8028": "FindPathExcludingByRecordID",
+			"8029": "FindPathExcludingByRecordID_V2",
+			"8030": "FindPathIncludingSourceByEntityID",
+			"8031": "FindPathIncludingSourceByEntityID_V2",
+			"8032": "FindPathIncludingSourceByRecordID",
+			"8033": "FindPathIncludingSourceByRecordID_V2",
+			"8034": "GetActiveConfigID",
+			"8035": "GetEntityByEntityID",
+			"8036": "GetEntityByEntityID_V2",
+			"8037": "GetEntityByRecordID",
+			"8038": "GetEntityByRecordID_V2",
+			"8039": "GetRecord",
+			"8040": "GetRecord_V2",
+			"8041": "GetRedoRecord",
+			"8042": "GetRepositoryLastModifiedTime",
+			"8043": "GetVirtualEntityByRecordID",
+			"8044": "GetVirtualEntityByRecordID_V2",
+			"8045": "HowEntityByEntityID",
+			"8046": "HowEntityByEntityID_V2",
+			"8047": "Init",
+			"8048": "InitWithConfigID",
+			"8049": "PrimeEngine",
+			"8050": "Process",
+			"8051": "ProcessRedoRecord",
+			"8052": "ProcessRedoRecordWithInfo",
+			"8053": "ProcessWithInfo",
+			"8054": "ProcessWithResponse",
+			"8055": "ProcessWithResponseResize",
+			"8056": "PurgeRepository",
+			"8057": "ReevaluateEntity",
+			"8058": "ReevaluateEntityWithInfo",
+			"8059": "ReevaluateRecord",
+			"8060": "ReevaluateRecordWithInfo",
+			"8061": "Reinit",
+			"8062": "ReplaceRecord",
+			"8063": "ReplaceRecordWithInfo",
+			"8064": "SearchByAttributes",
+			"8065": "SearchByAttributes_V2",
+			"8066": "Stats",
+			"8067": "WhyEntities",
+			"8068": "WhyEntities_V2",
+			"8069": "WhyEntityByEntityID",
+			"8070": "WhyEntityByEntityID_V2",
+			"8071": "WhyEntityByRecordID",
+			"8072": "WhyEntityByRecordID_V2",
+			"8073": "WhyRecords",
+			"8074": "WhyRecords_V2",
+			"8075": "GetSdkId",
+			"8077": "SetLogLevel",
+		},
+	},
+}
@@ -0,0 +1,33 @@
+/*
+The g2replay package records Senzing gRPC calls to a golden file and replays them without a server,
+so integration tests captured once against a staging Senzing server can run deterministically in CI.
+
+A Recorder is a gRPC unary client interceptor that passes every call to the server and records its
+method, request, response and status. Save() writes the calls to a golden file:
+
+	recorder := g2replay.NewRecorder("testdata/golden/g2engine.json")
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithAddress("staging:8258"),
+		g2client.WithUnaryInterceptors(recorder.UnaryClientInterceptor()))
+	// ... run the test ...
+	err = recorder.Save()
+
+A Player is a gRPC unary client interceptor that answers every call from a golden file and never calls the server.
+The address of the connection is never dialled, so any address will do:
+
+	player, err := g2replay.NewPlayer("testdata/golden/g2engine.json",
+		g2replay.IgnoreFields("loadID"),
+		g2replay.ForMethods(g2replay.IgnoreFields("recordID"), "AddRecord", "DeleteRecord"),
+		g2replay.IgnoreJSONKeys("LOAD_TIMESTAMP"))
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithAddress("replay"),
+		g2client.WithUnaryInterceptors(player.UnaryClientInterceptor()))
+
+A call is answered by the first recorded call, not yet replayed, of the same method whose request matches.
+Requests match when they are equal after normalization:
+  - string fields holding a JSON document are compared as JSON, so key order and whitespace do not matter;
+  - each Rule passed to NewPlayer() then removes volatile parts, such as generated record IDs and timestamps.
+
+Calls repeated more often than they were recorded replay the last matching call again.
+A call that matches no recorded call fails with codes.NotFound.
+Failed calls are recorded with their status, so replay returns the same Senzing errors.
+*/
+package g2replay
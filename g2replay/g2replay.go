@@ -0,0 +1,411 @@
+/*
+ *
+ */
+
+// Package g2replay records Senzing gRPC calls to a golden file and replays them without a server.
+package g2replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Call is one recorded unary call. Request and Response are the protobuf JSON encoding of the messages.
+type Call struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Code     string          `json:"code"`
+	Message  string          `json:"message,omitempty"`
+}
+
+// Player answers calls from a golden file. It is safe for concurrent use.
+type Player struct {
+	calls    []playerCall
+	lock     sync.Mutex
+	replayed []bool
+	rules    []Rule
+}
+
+// Recorder records calls for a golden file. It is safe for concurrent use.
+type Recorder struct {
+	calls []Call
+	lock  sync.Mutex
+	path  string
+}
+
+/*
+Rule removes volatile parts of a normalized request before it is compared.
+The request is the protobuf JSON encoding of the request message, decoded into a map,
+with string fields holding a JSON document replaced by the decoded document.
+
+Input
+  - method: The full gRPC method name, e.g. "/g2engine.G2Engine/AddRecord".
+  - request: The normalized request, changed in place.
+*/
+type Rule func(method string, request map[string]interface{})
+
+// The contents of a golden file.
+type goldenFile struct {
+	Calls []Call `json:"calls"`
+}
+
+// A recorded call and its normalized request.
+type playerCall struct {
+	call    Call
+	code    codes.Code
+	request map[string]interface{}
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewPlayer function reads a golden file written by Recorder.Save().
+
+Input
+  - path: The golden file.
+  - rules: Rules applied, in order, to recorded and live requests before they are compared.
+
+Output
+  - A Player whose UnaryClientInterceptor() answers calls from the golden file.
+*/
+func NewPlayer(path string, rules ...Rule) (*Player, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	golden := goldenFile{}
+	if err := json.Unmarshal(contents, &golden); err != nil {
+		return nil, fmt.Errorf("g2replay: %s: %w", path, err)
+	}
+	player := &Player{
+		replayed: make([]bool, len(golden.Calls)),
+		rules:    rules,
+	}
+	for index, call := range golden.Calls {
+		code, ok := codesByName[call.Code]
+		if !ok {
+			return nil, fmt.Errorf("g2replay: %s: call %d of %s has unknown status code %q", path, index, call.Method, call.Code)
+		}
+		request, err := normalize(call.Method, call.Request, rules)
+		if err != nil {
+			return nil, fmt.Errorf("g2replay: %s: call %d of %s: %w", path, index, call.Method, err)
+		}
+		player.calls = append(player.calls, playerCall{call: call, code: code, request: request})
+	}
+	return player, nil
+}
+
+/*
+The NewRecorder function returns a Recorder with no calls recorded.
+
+Input
+  - path: The golden file written by Save().
+*/
+func NewRecorder(path string) *Recorder {
+	return &Recorder{
+		calls: []Call{},
+		path:  path,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Decode JSON, keeping numbers as json.Number so they compare exactly.
+func decodeJson(document []byte, target interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	return decoder.Decode(target)
+}
+
+// The decoded document of a string holding a JSON object or array.
+func decodeDocument(value string) (interface{}, bool) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return nil, false
+	}
+	var document interface{}
+	if decodeJson([]byte(trimmed), &document) != nil {
+		return nil, false
+	}
+	return document, true
+}
+
+// Protobuf JSON with stable whitespace; protojson deliberately varies it between runs.
+func marshalMessage(message proto.Message) (json.RawMessage, error) {
+	encoded, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	buffer := &bytes.Buffer{}
+	if err := json.Compact(buffer, encoded); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// The request as rules see it.
+func normalize(method string, requestJson json.RawMessage, rules []Rule) (map[string]interface{}, error) {
+	request := map[string]interface{}{}
+	if len(requestJson) > 0 {
+		if err := decodeJson(requestJson, &request); err != nil {
+			return nil, err
+		}
+	}
+	for field, value := range request {
+		if text, ok := value.(string); ok {
+			if document, ok := decodeDocument(text); ok {
+				request[field] = document
+			}
+		}
+	}
+	for _, rule := range rules {
+		rule(method, request)
+	}
+	return request, nil
+}
+
+// Delete keys from every object in a decoded document.
+func removeKeys(document interface{}, keys map[string]bool) {
+	switch typedDocument := document.(type) {
+	case map[string]interface{}:
+		for key, value := range typedDocument {
+			if keys[key] {
+				delete(typedDocument, key)
+				continue
+			}
+			removeKeys(value, keys)
+		}
+	case []interface{}:
+		for _, value := range typedDocument {
+			removeKeys(value, keys)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The first matching call not yet replayed, or else the last matching call.
+func (player *Player) match(method string, request map[string]interface{}) (*playerCall, bool) {
+	player.lock.Lock()
+	defer player.lock.Unlock()
+	last := -1
+	for index := range player.calls {
+		candidate := &player.calls[index]
+		if candidate.call.Method != method || !reflect.DeepEqual(candidate.request, request) {
+			continue
+		}
+		if !player.replayed[index] {
+			player.replayed[index] = true
+			return candidate, true
+		}
+		last = index
+	}
+	if last < 0 {
+		return nil, false
+	}
+	return &player.calls[last], true
+}
+
+func (recorder *Recorder) record(call Call) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.calls = append(recorder.calls, call)
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Unused method returns the recorded calls that have not been replayed, in the order they were recorded.
+A test can assert that it is empty to check that replay followed the recording.
+*/
+func (player *Player) Unused() []Call {
+	player.lock.Lock()
+	defer player.lock.Unlock()
+	result := []Call{}
+	for index, replayed := range player.replayed {
+		if !replayed {
+			result = append(result, player.calls[index].call)
+		}
+	}
+	return result
+}
+
+/*
+The Calls method returns the calls recorded so far, in the order they completed.
+*/
+func (recorder *Recorder) Calls() []Call {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append([]Call{}, recorder.calls...)
+}
+
+/*
+The Save method writes the calls recorded so far to the golden file, replacing it.
+*/
+func (recorder *Recorder) Save() error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	contents, err := json.MarshalIndent(goldenFile{Calls: recorder.calls}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(recorder.path, append(contents, '\n'), goldenFilePermissions)
+}
+
+// ----------------------------------------------------------------------------
+// Interceptors
+// ----------------------------------------------------------------------------
+
+/*
+The UnaryClientInterceptor method returns a gRPC interceptor that answers every unary call from the golden file.
+The call never reaches the server.
+*/
+func (player *Player) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		requestMessage, ok := request.(proto.Message)
+		if !ok {
+			return status.Errorf(codes.Internal, "g2replay: request of %s is not a protobuf message", method)
+		}
+		replyMessage, ok := reply.(proto.Message)
+		if !ok {
+			return status.Errorf(codes.Internal, "g2replay: reply of %s is not a protobuf message", method)
+		}
+		requestJson, err := marshalMessage(requestMessage)
+		if err != nil {
+			return status.Errorf(codes.Internal, "g2replay: %s: %v", method, err)
+		}
+		normalized, err := normalize(method, requestJson, player.rules)
+		if err != nil {
+			return status.Errorf(codes.Internal, "g2replay: %s: %v", method, err)
+		}
+		call, ok := player.match(method, normalized)
+		if !ok {
+			return status.Errorf(codes.NotFound, "g2replay: no recorded call of %s matches request %s", method, requestJson)
+		}
+		if call.code != codes.OK {
+			return status.Error(call.code, call.call.Message)
+		}
+		if err := protojson.Unmarshal(call.call.Response, replyMessage); err != nil {
+			return status.Errorf(codes.Internal, "g2replay: response of %s: %v", method, err)
+		}
+		return nil
+	}
+}
+
+/*
+The UnaryClientInterceptor method returns a gRPC interceptor that passes every unary call to the server and records it.
+Calls whose messages are not protobuf messages are passed on without being recorded.
+*/
+func (recorder *Recorder) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		requestMessage, isRequestMessage := request.(proto.Message)
+		replyMessage, isReplyMessage := reply.(proto.Message)
+		err := invoker(ctx, method, request, reply, cc, opts...)
+		if !isRequestMessage || !isReplyMessage {
+			return err
+		}
+		requestJson, marshalErr := marshalMessage(requestMessage)
+		if marshalErr != nil {
+			return err
+		}
+		callStatus := status.Convert(err)
+		call := Call{
+			Method:  method,
+			Request: requestJson,
+			Code:    callStatus.Code().String(),
+			Message: callStatus.Message(),
+		}
+		if err == nil {
+			call.Response, marshalErr = marshalMessage(replyMessage)
+			if marshalErr != nil {
+				return err
+			}
+		}
+		recorder.record(call)
+		return err
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Rules
+// ----------------------------------------------------------------------------
+
+/*
+The ForMethods function limits a rule to some methods.
+
+Input
+  - rule: The rule to limit.
+  - methods: Full gRPC method names, e.g. "/g2engine.G2Engine/AddRecord", or method names, e.g. "AddRecord".
+*/
+func ForMethods(rule Rule, methods ...string) Rule {
+	return func(method string, request map[string]interface{}) {
+		shortMethod := method[strings.LastIndex(method, "/")+1:]
+		for _, candidate := range methods {
+			if candidate == method || candidate == shortMethod {
+				rule(method, request)
+				return
+			}
+		}
+	}
+}
+
+/*
+The IgnoreFields function returns a rule that ignores fields of the request message.
+
+Input
+  - fields: Protobuf JSON field names, e.g. "recordID" or "loadID".
+*/
+func IgnoreFields(fields ...string) Rule {
+	return func(method string, request map[string]interface{}) {
+		for _, field := range fields {
+			delete(request, field)
+		}
+	}
+}
+
+/*
+The IgnoreJSONKeys function returns a rule that ignores keys, at any depth, of JSON documents held in request fields,
+e.g. "RECORD_ID" in the jsonData of AddRecord or a timestamp key of a record.
+
+Input
+  - keys: JSON keys, e.g. "RECORD_ID" or "LOAD_TIMESTAMP".
+*/
+func IgnoreJSONKeys(keys ...string) Rule {
+	ignored := map[string]bool{}
+	for _, key := range keys {
+		ignored[key] = true
+	}
+	return func(method string, request map[string]interface{}) {
+		for _, value := range request {
+			removeKeys(value, ignored)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package g2replay
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2client"
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	server *grpctest.Server
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A ClientSet connected to the fake server through a Recorder.
+func getRecordingClientSet(ctx context.Context, test *testing.T, recorder *Recorder) *g2client.ClientSet {
+	server.Reset()
+	clientSet, err := g2client.NewClientSet(ctx,
+		g2client.WithAddress("bufnet"),
+		g2client.WithDialOptions(server.DialOption()),
+		g2client.WithUnaryInterceptors(recorder.UnaryClientInterceptor()))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	return clientSet
+}
+
+// A ClientSet answered by a Player; the address is never dialled.
+func getReplayingClientSet(ctx context.Context, test *testing.T, player *Player) *g2client.ClientSet {
+	clientSet, err := g2client.NewClientSet(ctx,
+		g2client.WithAddress("replay.invalid:1"),
+		g2client.WithUnaryInterceptors(player.UnaryClientInterceptor()))
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	return clientSet
+}
+
+func getGoldenFile(test *testing.T) string {
+	return filepath.Join(test.TempDir(), "golden.json")
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	server = grpctest.NewServer()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Close()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestPlayer_Replay(test *testing.T) {
+	ctx := context.TODO()
+	path := getGoldenFile(test)
+	recorder := NewRecorder(path)
+	clientSet := getRecordingClientSet(ctx, test, recorder)
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, ""))
+	recorded, err := clientSet.G2engine.GetRecord(ctx, "TEST", "1")
+	testError(test, err)
+	_, recordedErr := clientSet.G2engine.GetRecord(ctx, "TEST", "2")
+	assert.True(test, g2error.Is(recordedErr, g2error.G2NotFound), recordedErr)
+	testError(test, clientSet.Close())
+	testError(test, recorder.Save())
+	assert.Len(test, recorder.Calls(), 3)
+
+	player, err := NewPlayer(path)
+	testError(test, err)
+	clientSet = getReplayingClientSet(ctx, test, player)
+	defer clientSet.Close()
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{ "NAME_FULL" : "Robert Smith" }`, ""))
+	replayed, err := clientSet.G2engine.GetRecord(ctx, "TEST", "1")
+	testError(test, err)
+	assert.Equal(test, recorded, replayed)
+	_, err = clientSet.G2engine.GetRecord(ctx, "TEST", "2")
+	assert.True(test, g2error.Is(err, g2error.G2NotFound), err)
+	assert.Equal(test, recordedErr.Error(), err.Error())
+	assert.Empty(test, player.Unused())
+}
+
+func TestPlayer_Replay_NoMatch(test *testing.T) {
+	ctx := context.TODO()
+	path := getGoldenFile(test)
+	recorder := NewRecorder(path)
+	clientSet := getRecordingClientSet(ctx, test, recorder)
+	_, err := clientSet.G2product.Version(ctx)
+	testError(test, err)
+	testError(test, clientSet.Close())
+	testError(test, recorder.Save())
+
+	player, err := NewPlayer(path)
+	testError(test, err)
+	clientSet = getReplayingClientSet(ctx, test, player)
+	defer clientSet.Close()
+	_, err = clientSet.G2product.License(ctx)
+	assert.Equal(test, codes.NotFound, status.Code(err), err)
+	assert.Len(test, player.Unused(), 1)
+}
+
+func TestPlayer_Replay_Rules(test *testing.T) {
+	ctx := context.TODO()
+	path := getGoldenFile(test)
+	recorder := NewRecorder(path)
+	clientSet := getRecordingClientSet(ctx, test, recorder)
+	err := clientSet.G2engine.AddRecord(ctx, "TEST", "A-1", `{"NAME_FULL": "Robert Smith", "META": {"LOAD_TIMESTAMP": "2023-03-01T10:00:00Z"}}`, "")
+	testError(test, err)
+	testError(test, clientSet.Close())
+	testError(test, recorder.Save())
+	replay := func(player *Player) error {
+		clientSet := getReplayingClientSet(ctx, test, player)
+		defer clientSet.Close()
+		return clientSet.G2engine.AddRecord(ctx, "TEST", "A-2", `{"NAME_FULL": "Robert Smith", "META": {"LOAD_TIMESTAMP": "2023-03-02T11:30:00Z"}}`, "")
+	}
+
+	player, err := NewPlayer(path)
+	testError(test, err)
+	err = replay(player)
+	assert.Equal(test, codes.NotFound, status.Code(err), err)
+
+	player, err = NewPlayer(path, ForMethods(IgnoreFields("recordID"), "AddRecord"), IgnoreJSONKeys("LOAD_TIMESTAMP"))
+	testError(test, err)
+	testError(test, replay(player))
+	assert.Empty(test, player.Unused())
+
+	player, err = NewPlayer(path, ForMethods(IgnoreFields("recordID"), "DeleteRecord"), IgnoreJSONKeys("LOAD_TIMESTAMP"))
+	testError(test, err)
+	err = replay(player)
+	assert.Equal(test, codes.NotFound, status.Code(err), err)
+}
+
+func TestPlayer_Replay_Sequence(test *testing.T) {
+	ctx := context.TODO()
+	path := getGoldenFile(test)
+	recorder := NewRecorder(path)
+	clientSet := getRecordingClientSet(ctx, test, recorder)
+	server.AddRedoRecord(`{"DATA_SOURCE":"TEST","RECORD_ID":"1"}`)
+	server.AddRedoRecord(`{"DATA_SOURCE":"TEST","RECORD_ID":"2"}`)
+	recorded := []string{}
+	for i := 0; i < 3; i++ {
+		redoRecord, err := clientSet.G2engine.GetRedoRecord(ctx)
+		testError(test, err)
+		recorded = append(recorded, redoRecord)
+	}
+	testError(test, clientSet.Close())
+	testError(test, recorder.Save())
+	assert.Equal(test, "", recorded[2])
+
+	player, err := NewPlayer(path)
+	testError(test, err)
+	clientSet = getReplayingClientSet(ctx, test, player)
+	defer clientSet.Close()
+	replayed := []string{}
+	for i := 0; i < 4; i++ {
+		redoRecord, err := clientSet.G2engine.GetRedoRecord(ctx)
+		testError(test, err)
+		replayed = append(replayed, redoRecord)
+	}
+	assert.Equal(test, append(recorded, ""), replayed)
+}
+
+func TestPlayer_Replay_Cancelled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	path := getGoldenFile(test)
+	testError(test, NewRecorder(path).Save())
+	player, err := NewPlayer(path)
+	testError(test, err)
+	clientSet := getReplayingClientSet(ctx, test, player)
+	defer clientSet.Close()
+	cancel()
+	_, err = clientSet.G2product.Version(ctx)
+	assert.Equal(test, codes.Canceled, status.Code(err), err)
+}
+
+func TestNewPlayer_Invalid(test *testing.T) {
+	path := getGoldenFile(test)
+	_, err := NewPlayer(path)
+	assert.ErrorIs(test, err, os.ErrNotExist)
+	testError(test, os.WriteFile(path, []byte(`{"calls":[{"method":"/g2product.G2Product/Version","request":{},"code":"Fine"}]}`), goldenFilePermissions))
+	_, err = NewPlayer(path)
+	assert.ErrorContains(test, err, `unknown status code "Fine"`)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleNewPlayer() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/g2replay/g2replay_test.go
+	ctx := context.TODO()
+	player, err := NewPlayer("../testdata/g2replay/version.json")
+	if err != nil {
+		fmt.Println(err)
+	}
+	clientSet, err := g2client.NewClientSet(ctx, g2client.WithAddress("replay.invalid:1"), g2client.WithUnaryInterceptors(player.UnaryClientInterceptor()))
+	if err != nil {
+		fmt.Println(err)
+	}
+	defer clientSet.Close()
+	result, err := clientSet.G2product.Version(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(result)
+	// Output: {"PRODUCT_NAME":"Senzing API","VERSION":"3.4.0","BUILD_VERSION":"3.4.0.23012","BUILD_DATE":"2023-01-12","BUILD_NUMBER":"2023_01_12__10_52","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"},"SCHEMA_VERSION":{"ENGINE_SCHEMA_VERSION":"3.4","MINIMUM_REQUIRED_SCHEMA_VERSION":"3.0","MAXIMUM_REQUIRED_SCHEMA_VERSION":"3.99"}}
+}
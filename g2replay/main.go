@@ -0,0 +1,23 @@
+package g2replay
+
+import "google.golang.org/grpc/codes"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Permissions of a golden file written by Recorder.Save().
+const goldenFilePermissions = 0644
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// gRPC status codes by the name recorded in golden files, e.g. "NotFound".
+var codesByName = func() map[string]codes.Code {
+	result := map[string]codes.Code{}
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		result[code.String()] = code
+	}
+	return result
+}()
@@ -0,0 +1,9 @@
+/*
+The g2retry package retries Senzing gRPC calls that fail with transient errors.
+A RetryPolicy is installed as a gRPC unary client interceptor, usually with g2client.WithRetryPolicy().
+Calls are retried when the error is classified as g2error.G2Retryable
+(e.g. gRPC Unavailable, or a Senzing "retryable" error code),
+using exponential backoff with jitter.
+Methods that are not safe to repeat, such as PurgeRepository, are never retried.
+*/
+package g2retry
@@ -0,0 +1,191 @@
+/*
+ *
+ */
+
+// Package g2retry implements retry with backoff for Senzing gRPC calls.
+package g2retry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"github.com/senzing/go-observing/observer"
+	"github.com/senzing/go-observing/subject"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// RetryPolicy controls how failed calls are retried.
+type RetryPolicy struct {
+	InitialBackoff       time.Duration   // Delay before the first retry.
+	Jitter               float64         // Fraction (0.0 - 1.0) by which each delay is randomly shortened or lengthened.
+	MaxAttempts          int             // Total attempts, including the first. A value of 1 or less disables retries.
+	MaxBackoff           time.Duration   // Upper bound of any single delay.
+	Multiplier           float64         // Growth factor of the delay after each retry.
+	NonIdempotentMethods map[string]bool // Full gRPC method names never retried. If nil, DefaultNonIdempotentMethods is used.
+	lock                 sync.Mutex
+	observers            subject.Subject
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewRetryPolicy function returns a RetryPolicy populated with the package defaults.
+*/
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		InitialBackoff: DefaultInitialBackoff,
+		Jitter:         DefaultJitter,
+		MaxAttempts:    DefaultMaxAttempts,
+		MaxBackoff:     DefaultMaxBackoff,
+		Multiplier:     DefaultMultiplier,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Notify registered observers.
+func (policy *RetryPolicy) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	now := time.Now()
+	details["subjectId"] = strconv.Itoa(ProductId)
+	details["messageId"] = strconv.Itoa(messageId)
+	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	if err != nil {
+		details["error"] = err.Error()
+	}
+	message, err := json.Marshal(details)
+	if err != nil {
+		fmt.Printf("Error: %s", err.Error())
+	} else {
+		policy.getObservers().NotifyObservers(ctx, string(message))
+	}
+}
+
+func (policy *RetryPolicy) getObservers() subject.Subject {
+	policy.lock.Lock()
+	defer policy.lock.Unlock()
+	return policy.observers
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The Backoff method returns the delay before the given retry.
+
+Input
+  - retry: The retry number, starting at 1 for the first retry.
+*/
+func (policy *RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := float64(policy.InitialBackoff) * math.Pow(policy.Multiplier, float64(retry-1))
+	if policy.MaxBackoff > 0 && backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+	if policy.Jitter > 0 {
+		backoff = backoff * (1 + policy.Jitter*(2*rand.Float64()-1))
+	}
+	return time.Duration(backoff)
+}
+
+/*
+The IsRetryable method reports whether a call to the method that failed with err may be retried.
+The number of attempts is not considered.
+
+Input
+  - method: The full gRPC method name, e.g. "/g2engine.G2Engine/AddRecord".
+  - err: The error returned by the call.
+*/
+func (policy *RetryPolicy) IsRetryable(method string, err error) bool {
+	if err == nil {
+		return false
+	}
+	nonIdempotentMethods := policy.NonIdempotentMethods
+	if nonIdempotentMethods == nil {
+		nonIdempotentMethods = DefaultNonIdempotentMethods
+	}
+	if nonIdempotentMethods[method] {
+		return false
+	}
+	return g2error.Is(g2error.Convert(err), g2error.G2Retryable)
+}
+
+/*
+The RegisterObserver method adds the observer to the list of observers notified of retries.
+Each retry produces a message with messageId 8001.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (policy *RetryPolicy) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	policy.lock.Lock()
+	defer policy.lock.Unlock()
+	if policy.observers == nil {
+		policy.observers = &subject.SubjectImpl{}
+	}
+	return policy.observers.RegisterObserver(ctx, observer)
+}
+
+/*
+The UnaryClientInterceptor method returns a gRPC interceptor applying the policy to every unary call.
+*/
+func (policy *RetryPolicy) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, request, reply, cc, opts...)
+		for attempt := 1; attempt < policy.MaxAttempts && policy.IsRetryable(method, err); attempt++ {
+			backoff := policy.Backoff(attempt)
+			if policy.getObservers() != nil {
+				details := map[string]string{
+					"attempt": strconv.Itoa(attempt + 1),
+					"backoff": backoff.String(),
+					"method":  method,
+				}
+				policy.notify(ctx, 8001, err, details)
+			}
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			err = invoker(ctx, method, request, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+/*
+The UnregisterObserver method removes the observer from the list of observers notified of retries.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (policy *RetryPolicy) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	policy.lock.Lock()
+	defer policy.lock.Unlock()
+	if policy.observers == nil {
+		return nil
+	}
+	err := policy.observers.UnregisterObserver(ctx, observer)
+	if !policy.observers.HasObservers(ctx) {
+		policy.observers = nil
+	}
+	return err
+}
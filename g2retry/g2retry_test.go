@@ -0,0 +1,256 @@
+package g2retry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2engine"
+	"github.com/senzing/g2-sdk-go/g2api"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	badInputMessage  = `{"id":"senzing-60044001","errors":[{"text":"0023E|Conflicting DATA_SOURCE values 'CUSTOMERS' and 'BOB'"}]}`
+	bufferSize       = 1024 * 1024
+	retryableMessage = `{"id":"senzing-60044001","errors":[{"text":"0054E|Resolved entity could not be locked"}]}`
+)
+
+var (
+	listener *bufconn.Listener
+	server   *grpc.Server
+	fake     = &g2engineServer{}
+)
+
+// A G2Engine server that fails a configurable number of calls before succeeding.
+type g2engineServer struct {
+	g2pb.UnimplementedG2EngineServer
+	calls    int
+	failWith error
+	failures int
+	lock     sync.Mutex
+}
+
+func (server *g2engineServer) reset(failures int, failWith error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.calls = 0
+	server.failures = failures
+	server.failWith = failWith
+}
+
+func (server *g2engineServer) call() error {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.calls++
+	if server.calls <= server.failures {
+		return server.failWith
+	}
+	return nil
+}
+
+func (server *g2engineServer) getCalls() int {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return server.calls
+}
+
+func (server *g2engineServer) AddRecord(ctx context.Context, request *g2pb.AddRecordRequest) (*g2pb.AddRecordResponse, error) {
+	return &g2pb.AddRecordResponse{}, server.call()
+}
+
+func (server *g2engineServer) PurgeRepository(ctx context.Context, request *g2pb.PurgeRepositoryRequest) (*g2pb.PurgeRepositoryResponse, error) {
+	return &g2pb.PurgeRepositoryResponse{}, server.call()
+}
+
+// An observer that records the messages it receives.
+type testObserver struct {
+	lock     sync.Mutex
+	messages []map[string]string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return "testObserver"
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, details)
+}
+
+func (observer *testObserver) count() int {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	return len(observer.messages)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getTestPolicy() *RetryPolicy {
+	policy := NewRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.MaxAttempts = 3
+	return policy
+}
+
+func getTestObject(ctx context.Context, test *testing.T, policy *RetryPolicy) g2api.G2engine {
+	bufDialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}
+	grpcConnection, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(policy.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	test.Cleanup(func() { grpcConnection.Close() })
+	return &g2engine.G2engine{
+		GrpcClient: g2pb.NewG2EngineClient(grpcConnection),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	listener = bufconn.Listen(bufferSize)
+	server = grpc.NewServer()
+	g2pb.RegisterG2EngineServer(server, fake)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	server.Stop()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestRetryPolicy_TransportError(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test, getTestPolicy())
+	fake.reset(2, status.Error(codes.Unavailable, "try again"))
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.Nil(test, err)
+	assert.Equal(test, 3, fake.getCalls())
+}
+
+func TestRetryPolicy_SenzingRetryableError(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test, getTestPolicy())
+	fake.reset(1, status.Error(codes.Unknown, retryableMessage))
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.Nil(test, err)
+	assert.Equal(test, 2, fake.getCalls())
+}
+
+func TestRetryPolicy_MaxAttempts(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test, getTestPolicy())
+	fake.reset(10, status.Error(codes.Unavailable, "try again"))
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.NotNil(test, err)
+	assert.Equal(test, 3, fake.getCalls())
+}
+
+func TestRetryPolicy_NotRetryableError(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test, getTestPolicy())
+	fake.reset(1, status.Error(codes.Unknown, badInputMessage))
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.NotNil(test, err)
+	assert.Equal(test, 1, fake.getCalls())
+}
+
+func TestRetryPolicy_NonIdempotentMethod(test *testing.T) {
+	ctx := context.TODO()
+	g2engine := getTestObject(ctx, test, getTestPolicy())
+	fake.reset(1, status.Error(codes.Unavailable, "try again"))
+	err := g2engine.PurgeRepository(ctx)
+	assert.NotNil(test, err)
+	assert.Equal(test, 1, fake.getCalls())
+}
+
+func TestRetryPolicy_ContextCanceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	policy := getTestPolicy()
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	g2engine := getTestObject(ctx, test, policy)
+	fake.reset(10, status.Error(codes.Unavailable, "try again"))
+	time.AfterFunc(10*time.Millisecond, cancel)
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.NotNil(test, err)
+	assert.Equal(test, 1, fake.getCalls())
+}
+
+func TestRetryPolicy_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	policy := getTestPolicy()
+	observer := &testObserver{}
+	assert.Nil(test, policy.RegisterObserver(ctx, observer))
+	g2engine := getTestObject(ctx, test, policy)
+	fake.reset(2, status.Error(codes.Unavailable, "try again"))
+	err := g2engine.AddRecord(ctx, "CUSTOMERS", "1001", "{}", "")
+	assert.Nil(test, err)
+	assert.Eventually(test, func() bool { return observer.count() == 2 }, time.Second, time.Millisecond)
+	observer.lock.Lock()
+	assert.Equal(test, "/g2engine.G2Engine/AddRecord", observer.messages[0]["method"])
+	assert.Equal(test, "8001", observer.messages[0]["messageId"])
+	observer.lock.Unlock()
+	assert.Nil(test, policy.UnregisterObserver(ctx, observer))
+}
+
+func TestRetryPolicy_Backoff(test *testing.T) {
+	policy := NewRetryPolicy()
+	policy.Jitter = 0
+	assert.Equal(test, DefaultInitialBackoff, policy.Backoff(1))
+	assert.Equal(test, 2*DefaultInitialBackoff, policy.Backoff(2))
+	assert.Equal(test, DefaultMaxBackoff, policy.Backoff(100))
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		backoff := policy.Backoff(1)
+		assert.True(test, backoff >= DefaultInitialBackoff/2 && backoff <= DefaultInitialBackoff*3/2)
+	}
+}
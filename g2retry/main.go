@@ -0,0 +1,53 @@
+package g2retry
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the g2retry package found messages having the format "senzing-6027xxxx".
+const ProductId = 6027
+
+// Default values used by NewRetryPolicy().
+const (
+	DefaultInitialBackoff = 100 * time.Millisecond
+	DefaultJitter         = 0.2
+	DefaultMaxAttempts    = 5
+	DefaultMaxBackoff     = 10 * time.Second
+	DefaultMultiplier     = 2.0
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Full gRPC method names that must never be retried because repeating them
+// has side effects beyond the first successful call (destroying data,
+// generating new identifiers, allocating server-side handles, advancing cursors).
+var DefaultNonIdempotentMethods = map[string]bool{
+	"/g2config.G2Config/Create":                                true,
+	"/g2config.G2Config/Destroy":                               true,
+	"/g2config.G2Config/Init":                                  true,
+	"/g2configmgr.G2ConfigMgr/AddConfig":                       true,
+	"/g2configmgr.G2ConfigMgr/Destroy":                         true,
+	"/g2configmgr.G2ConfigMgr/Init":                            true,
+	"/g2configmgr.G2ConfigMgr/ReplaceDefaultConfigID":          true,
+	"/g2diagnostic.G2Diagnostic/Destroy":                       true,
+	"/g2diagnostic.G2Diagnostic/FetchNextEntityBySize":         true,
+	"/g2diagnostic.G2Diagnostic/GetEntityListBySize":           true,
+	"/g2diagnostic.G2Diagnostic/Init":                          true,
+	"/g2diagnostic.G2Diagnostic/InitWithConfigID":              true,
+	"/g2engine.G2Engine/AddRecordWithInfoWithReturnedRecordID": true,
+	"/g2engine.G2Engine/AddRecordWithReturnedRecordID":         true,
+	"/g2engine.G2Engine/Destroy":                               true,
+	"/g2engine.G2Engine/ExportCSVEntityReport":                 true,
+	"/g2engine.G2Engine/ExportJSONEntityReport":                true,
+	"/g2engine.G2Engine/FetchNext":                             true,
+	"/g2engine.G2Engine/GetRedoRecord":                         true,
+	"/g2engine.G2Engine/Init":                                  true,
+	"/g2engine.G2Engine/InitWithConfigID":                      true,
+	"/g2engine.G2Engine/PurgeRepository":                       true,
+	"/g2product.G2Product/Destroy":                             true,
+	"/g2product.G2Product/Init":                                true,
+}
@@ -0,0 +1,30 @@
+/*
+The grpctest package is an in-process fake of the Senzing gRPC server, for tests that must not depend on
+a live server or database.
+
+A Server implements the G2Config, G2ConfigMgr, G2Diagnostic, G2Engine and G2Product services of
+g2-sdk-proto in memory, and the standard gRPC health service, and serves them over a bufconn listener:
+
+	server := grpctest.NewServer()
+	defer server.Close()
+	grpcConnection, err := server.Dial(ctx)
+	g2engine := &g2engine.G2engine{GrpcClient: g2pb.NewG2EngineClient(grpcConnection)}
+
+The fake keeps:
+  - configurations, by configuration ID, with a default configuration ID updated by compare-and-swap;
+  - configuration handles created by G2Config.Create() and Load();
+  - records, by data source and record ID, each resolving to its own entity;
+  - export handles and entity-list-by-size handles, fetched one line at a time.
+
+No entity resolution is performed, so documents have the shape of Senzing's but only the content the fake can know.
+Errors carry Senzing error codes, e.g. "0033E|Unknown record", so g2error classifies them as a real server's.
+Server options given to NewServer(), such as interceptors, let a test check what clients send, e.g. credentials.
+
+The test suites of this repository use the fake instead of localhost:8258 when the
+SENZING_TOOLS_GRPC_TEST_FAKE environment variable is "true":
+
+	SENZING_TOOLS_GRPC_TEST_FAKE=true go test -run '^Test' ./...
+
+Examples are excluded because their output documents come from a real server.
+*/
+package grpctest
@@ -0,0 +1,347 @@
+package grpctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// The JSON documents returned by the G2Engine service. Fields are in Senzing's order.
+
+type affectedEntityDocument struct {
+	EntityID int64 `json:"ENTITY_ID"`
+}
+
+type checkRecordDocument struct {
+	CheckRecordResponse []checkRecordResultDocument `json:"CHECK_RECORD_RESPONSE"`
+}
+
+type checkRecordResultDocument struct {
+	DsrcCode                 string `json:"DSRC_CODE"`
+	RecordID                 string `json:"RECORD_ID"`
+	MatchLevel               int    `json:"MATCH_LEVEL"`
+	MatchLevelCode           string `json:"MATCH_LEVEL_CODE"`
+	MatchKey                 string `json:"MATCH_KEY"`
+	ErruleCode               string `json:"ERRULE_CODE"`
+	ErruleID                 int    `json:"ERRULE_ID"`
+	CandidateMatch           string `json:"CANDIDATE_MATCH"`
+	NonGenericCandidateMatch string `json:"NON_GENERIC_CANDIDATE_MATCH"`
+}
+
+type entityDocument struct {
+	ResolvedEntity  resolvedEntityDocument `json:"RESOLVED_ENTITY"`
+	RelatedEntities json.RawMessage        `json:"RELATED_ENTITIES,omitempty"`
+}
+
+type entityPathDocument struct {
+	StartEntityID int64   `json:"START_ENTITY_ID"`
+	EndEntityID   int64   `json:"END_ENTITY_ID"`
+	Entities      []int64 `json:"ENTITIES"`
+}
+
+type entityRecordDocument struct {
+	DataSource string                 `json:"DATA_SOURCE"`
+	RecordID   string                 `json:"RECORD_ID"`
+	EntityType string                 `json:"ENTITY_TYPE,omitempty"`
+	InternalID int64                  `json:"INTERNAL_ID,omitempty"`
+	EntityKey  string                 `json:"ENTITY_KEY,omitempty"`
+	EntityDesc string                 `json:"ENTITY_DESC,omitempty"`
+	JsonData   map[string]interface{} `json:"JSON_DATA,omitempty"`
+}
+
+type howDocument struct {
+	HowResults howResultsDocument `json:"HOW_RESULTS"`
+}
+
+type howFinalStateDocument struct {
+	NeedReevaluation int                     `json:"NEED_REEVALUATION"`
+	VirtualEntities  []virtualEntityDocument `json:"VIRTUAL_ENTITIES"`
+}
+
+type howResultsDocument struct {
+	ResolutionSteps []struct{}            `json:"RESOLUTION_STEPS"`
+	FinalState      howFinalStateDocument `json:"FINAL_STATE"`
+}
+
+type interestingEntitiesDocument struct {
+	Entities []struct{} `json:"ENTITIES"`
+}
+
+type interestingEntitiesResponseDocument struct {
+	InterestingEntities interestingEntitiesDocument `json:"INTERESTING_ENTITIES"`
+}
+
+type matchInfoDocument struct {
+	MatchLevel     int    `json:"MATCH_LEVEL"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE"`
+	MatchKey       string `json:"MATCH_KEY"`
+	ErruleCode     string `json:"ERRULE_CODE"`
+}
+
+type memberRecordDocument struct {
+	InternalID int64               `json:"INTERNAL_ID"`
+	Records    []recordKeyDocument `json:"RECORDS"`
+}
+
+type networkDocument struct {
+	EntityPaths        []entityPathDocument `json:"ENTITY_PATHS"`
+	EntityNetworkLinks []struct{}           `json:"ENTITY_NETWORK_LINKS"`
+	Entities           []entityDocument     `json:"ENTITIES"`
+}
+
+type pathDocument struct {
+	EntityPaths []entityPathDocument `json:"ENTITY_PATHS"`
+	Entities    []entityDocument     `json:"ENTITIES"`
+}
+
+type recordDocument struct {
+	DataSource string                 `json:"DATA_SOURCE"`
+	RecordID   string                 `json:"RECORD_ID"`
+	JsonData   map[string]interface{} `json:"JSON_DATA,omitempty"`
+}
+
+type recordKeyDocument struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+type recordSummaryDocument struct {
+	DataSource  string `json:"DATA_SOURCE"`
+	RecordCount int64  `json:"RECORD_COUNT"`
+}
+
+type resolvedEntityDocument struct {
+	EntityID      int64                   `json:"ENTITY_ID"`
+	EntityName    string                  `json:"ENTITY_NAME,omitempty"`
+	RecordSummary []recordSummaryDocument `json:"RECORD_SUMMARY,omitempty"`
+	Records       []entityRecordDocument  `json:"RECORDS,omitempty"`
+}
+
+type searchDocument struct {
+	ResolvedEntities []searchResultDocument `json:"RESOLVED_ENTITIES"`
+}
+
+type searchResultDocument struct {
+	MatchInfo matchInfoDocument `json:"MATCH_INFO"`
+	Entity    entityDocument    `json:"ENTITY"`
+}
+
+type statsDocument struct {
+	Workload workloadDocument `json:"workload"`
+}
+
+type virtualEntityDocument struct {
+	VirtualEntityID string                 `json:"VIRTUAL_ENTITY_ID"`
+	MemberRecords   []memberRecordDocument `json:"MEMBER_RECORDS"`
+}
+
+type whyDocument struct {
+	WhyResults []whyResultDocument `json:"WHY_RESULTS"`
+	Entities   []entityDocument    `json:"ENTITIES"`
+}
+
+type whyMatchInfoDocument struct {
+	WhyKey         string `json:"WHY_KEY"`
+	WhyErruleCode  string `json:"WHY_ERRULE_CODE"`
+	MatchLevelCode string `json:"MATCH_LEVEL_CODE"`
+}
+
+type whyResultDocument struct {
+	InternalID    int64                `json:"INTERNAL_ID,omitempty"`
+	EntityID      int64                `json:"ENTITY_ID"`
+	FocusRecords  []recordKeyDocument  `json:"FOCUS_RECORDS,omitempty"`
+	InternalID2   int64                `json:"INTERNAL_ID_2,omitempty"`
+	EntityID2     int64                `json:"ENTITY_ID_2,omitempty"`
+	FocusRecords2 []recordKeyDocument  `json:"FOCUS_RECORDS_2,omitempty"`
+	MatchInfo     whyMatchInfoDocument `json:"MATCH_INFO"`
+}
+
+type withInfoDocument struct {
+	DataSource          string                      `json:"DATA_SOURCE"`
+	RecordID            string                      `json:"RECORD_ID"`
+	AffectedEntities    []affectedEntityDocument    `json:"AFFECTED_ENTITIES"`
+	InterestingEntities interestingEntitiesDocument `json:"INTERESTING_ENTITIES"`
+}
+
+type workloadDocument struct {
+	APIVersion     string `json:"apiVersion"`
+	LoadedRecords  int64  `json:"loadedRecords"`
+	AddedRecords   int64  `json:"addedRecords"`
+	DeletedRecords int64  `json:"deletedRecords"`
+	Reevaluations  int64  `json:"reevaluations"`
+	RedoTriggers   int64  `json:"redoTriggers"`
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Keys of record JSON that describe the record rather than the entity, so are not compared by searches.
+var nonAttributeKeys = map[string]bool{
+	"ADDR_TYPE":   true,
+	"DATA_SOURCE": true,
+	"DSRC_ACTION": true,
+	"ENTITY_TYPE": true,
+	"NAME_TYPE":   true,
+	"PHONE_TYPE":  true,
+	"RECORD_ID":   true,
+	"RECORD_TYPE": true,
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Collect "KEY=VALUE" for every scalar in record JSON, including those nested in lists like "NAMES".
+func collectAttributes(value interface{}, key string, result map[string]string) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for childKey, childValue := range typedValue {
+			collectAttributes(childValue, childKey, result)
+		}
+	case []interface{}:
+		for _, childValue := range typedValue {
+			collectAttributes(childValue, key, result)
+		}
+	case nil:
+	default:
+		if key == "" || nonAttributeKeys[key] {
+			return
+		}
+		scalar := strings.ToUpper(strings.TrimSpace(fmt.Sprint(typedValue)))
+		if scalar != "" {
+			result[key+"="+scalar] = key
+		}
+	}
+}
+
+func attributes(jsonData map[string]interface{}) map[string]string {
+	result := map[string]string{}
+	collectAttributes(jsonData, "", result)
+	return result
+}
+
+// A Senzing-like match key, e.g. "+NAME_LAST+SSN_NUMBER", of the attributes two records share.
+func matchKey(attributes1 map[string]string, attributes2 map[string]string) string {
+	keys := map[string]bool{}
+	for attribute, key := range attributes1 {
+		if _, ok := attributes2[attribute]; ok {
+			keys[key] = true
+		}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	if len(sortedKeys) == 0 {
+		return ""
+	}
+	return "+" + strings.Join(sortedKeys, "+")
+}
+
+// The name of an entity: a full or organization name, or the parts of a personal name.
+func entityName(jsonData map[string]interface{}) string {
+	candidates := []map[string]interface{}{jsonData}
+	if names, ok := jsonData["NAMES"].([]interface{}); ok {
+		for _, name := range names {
+			if fields, ok := name.(map[string]interface{}); ok {
+				candidates = append(candidates, fields)
+			}
+		}
+	}
+	for _, fields := range candidates {
+		for _, prefix := range []string{"", "PRIMARY_"} {
+			for _, key := range []string{"NAME_FULL", "NAME_ORG"} {
+				if name, ok := fields[prefix+key].(string); ok && name != "" {
+					return name
+				}
+			}
+			parts := []string{}
+			for _, key := range []string{"NAME_FIRST", "NAME_MIDDLE", "NAME_LAST"} {
+				if part, ok := fields[prefix+key].(string); ok && part != "" {
+					parts = append(parts, part)
+				}
+			}
+			if len(parts) > 0 {
+				return strings.Join(parts, " ")
+			}
+		}
+	}
+	return ""
+}
+
+// An entity document for records, including what flags ask for.
+func newEntityDocument(entityID int64, records []*storedRecord, flags g2flags.Flags) entityDocument {
+	result := entityDocument{ResolvedEntity: resolvedEntityDocument{EntityID: entityID}}
+	if flags.Has(g2flags.EntityIncludeEntityName) && len(records) > 0 {
+		result.ResolvedEntity.EntityName = entityName(records[0].jsonData)
+	}
+	if flags.Has(g2flags.EntityIncludeRecordSummary) {
+		counts := map[string]int64{}
+		for _, record := range records {
+			counts[record.key.dataSourceCode]++
+		}
+		for dataSourceCode, count := range counts {
+			result.ResolvedEntity.RecordSummary = append(result.ResolvedEntity.RecordSummary, recordSummaryDocument{DataSource: dataSourceCode, RecordCount: count})
+		}
+		sort.Slice(result.ResolvedEntity.RecordSummary, func(i, j int) bool {
+			return result.ResolvedEntity.RecordSummary[i].DataSource < result.ResolvedEntity.RecordSummary[j].DataSource
+		})
+	}
+	includeRecordData := flags.Has(g2flags.EntityIncludeRecordData)
+	includeJsonData := flags.Has(g2flags.EntityIncludeRecordJsonData)
+	if includeRecordData || includeJsonData || flags.Has(g2flags.EntityIncludeRecordMatchingInfo) {
+		for _, record := range records {
+			recordDocument := entityRecordDocument{DataSource: record.key.dataSourceCode, RecordID: record.key.recordID}
+			if includeRecordData {
+				recordDocument.EntityType = record.key.dataSourceCode
+				recordDocument.InternalID = record.entityID
+				recordDocument.EntityKey = fmt.Sprintf("%X", record.entityID)
+				recordDocument.EntityDesc = entityName(record.jsonData)
+			}
+			if includeJsonData {
+				recordDocument.JsonData = record.jsonData
+			}
+			result.ResolvedEntity.Records = append(result.ResolvedEntity.Records, recordDocument)
+		}
+	}
+	if flags&g2flags.EntityIncludeAllRelations != 0 {
+		result.RelatedEntities = json.RawMessage(`[]`)
+	}
+	return result
+}
+
+func newRecordDocument(record *storedRecord, flags g2flags.Flags) recordDocument {
+	result := recordDocument{DataSource: record.key.dataSourceCode, RecordID: record.key.recordID}
+	if flags.Has(g2flags.EntityIncludeRecordJsonData) {
+		result.JsonData = record.jsonData
+	}
+	return result
+}
+
+func newRecordKeyDocument(record *storedRecord) recordKeyDocument {
+	return recordKeyDocument{DataSource: record.key.dataSourceCode, RecordID: record.key.recordID}
+}
+
+func newWithInfoDocument(key recordKey, entityIDs ...int64) string {
+	result := withInfoDocument{
+		DataSource:          key.dataSourceCode,
+		RecordID:            key.recordID,
+		AffectedEntities:    []affectedEntityDocument{},
+		InterestingEntities: interestingEntitiesDocument{Entities: []struct{}{}},
+	}
+	for _, entityID := range entityIDs {
+		if entityID != 0 {
+			result.AffectedEntities = append(result.AffectedEntities, affectedEntityDocument{EntityID: entityID})
+		}
+	}
+	return encodeJson(result)
+}
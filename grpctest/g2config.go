@@ -0,0 +1,133 @@
+package grpctest
+
+import (
+	"context"
+
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2config"
+)
+
+// The G2Config service of a Server.
+type g2configServer struct {
+	g2pb.UnimplementedG2ConfigServer
+	server *Server
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// The configuration of a handle; the caller holds the lock.
+func (service *g2configServer) document(configHandle int64) (*configDocument, error) {
+	document, ok := service.server.configHandles[configHandle]
+	if !ok {
+		return nil, newCallError(g2configProductId, 7226, "Invalid configuration handle: %d", configHandle)
+	}
+	return document, nil
+}
+
+// ----------------------------------------------------------------------------
+// G2ConfigServer methods
+// ----------------------------------------------------------------------------
+
+func (service *g2configServer) AddDataSource(ctx context.Context, request *g2pb.AddDataSourceRequest) (*g2pb.AddDataSourceResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	document, err := service.document(request.GetConfigHandle())
+	if err != nil {
+		return nil, err
+	}
+	code, err := parseDataSourceCode(request.GetInputJson())
+	if err != nil {
+		return nil, newCallError(g2configProductId, 30121, "Invalid data source JSON: %v", err)
+	}
+	if document.hasDataSource(code) {
+		return nil, newCallError(g2configProductId, 7234, "Data source code [%s] already exists", code)
+	}
+	id := document.addDataSource(code)
+	return &g2pb.AddDataSourceResponse{Result: encodeJson(map[string]int64{"DSRC_ID": id})}, nil
+}
+
+func (service *g2configServer) Close(ctx context.Context, request *g2pb.CloseRequest) (*g2pb.CloseResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, err := service.document(request.GetConfigHandle()); err != nil {
+		return nil, err
+	}
+	delete(service.server.configHandles, request.GetConfigHandle())
+	return &g2pb.CloseResponse{}, nil
+}
+
+func (service *g2configServer) Create(ctx context.Context, request *g2pb.CreateRequest) (*g2pb.CreateResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	document, _ := parseConfig(templateConfigJson)
+	handle := service.server.nextHandle
+	service.server.nextHandle++
+	service.server.configHandles[handle] = document
+	return &g2pb.CreateResponse{Result: handle}, nil
+}
+
+func (service *g2configServer) DeleteDataSource(ctx context.Context, request *g2pb.DeleteDataSourceRequest) (*g2pb.DeleteDataSourceResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	document, err := service.document(request.GetConfigHandle())
+	if err != nil {
+		return nil, err
+	}
+	code, err := parseDataSourceCode(request.GetInputJson())
+	if err != nil {
+		return nil, newCallError(g2configProductId, 30121, "Invalid data source JSON: %v", err)
+	}
+	document.deleteDataSource(code)
+	return &g2pb.DeleteDataSourceResponse{}, nil
+}
+
+func (service *g2configServer) Destroy(ctx context.Context, request *g2pb.DestroyRequest) (*g2pb.DestroyResponse, error) {
+	return nil, newDestroyError(g2configProductId)
+}
+
+func (service *g2configServer) Init(ctx context.Context, request *g2pb.InitRequest) (*g2pb.InitResponse, error) {
+	return nil, newInitError(g2configProductId, initMessageNumber)
+}
+
+func (service *g2configServer) ListDataSources(ctx context.Context, request *g2pb.ListDataSourcesRequest) (*g2pb.ListDataSourcesResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	document, err := service.document(request.GetConfigHandle())
+	if err != nil {
+		return nil, err
+	}
+	type listedDataSource struct {
+		DsrcId   int64  `json:"DSRC_ID"`
+		DsrcCode string `json:"DSRC_CODE"`
+	}
+	dataSources := []listedDataSource{}
+	for _, dataSource := range document.dataSources() {
+		dataSources = append(dataSources, listedDataSource{DsrcId: dataSource.id, DsrcCode: dataSource.code})
+	}
+	return &g2pb.ListDataSourcesResponse{Result: encodeJson(map[string]interface{}{"DATA_SOURCES": dataSources})}, nil
+}
+
+func (service *g2configServer) Load(ctx context.Context, request *g2pb.LoadRequest) (*g2pb.LoadResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, err := service.document(request.GetConfigHandle()); err != nil {
+		return nil, err
+	}
+	document, err := parseConfig(request.GetJsonConfig())
+	if err != nil {
+		return nil, newCallError(g2configProductId, 7226, "Invalid configuration: %v", err)
+	}
+	service.server.configHandles[request.GetConfigHandle()] = document
+	return &g2pb.LoadResponse{}, nil
+}
+
+func (service *g2configServer) Save(ctx context.Context, request *g2pb.SaveRequest) (*g2pb.SaveResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	document, err := service.document(request.GetConfigHandle())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.SaveResponse{Result: document.json()}, nil
+}
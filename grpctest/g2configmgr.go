@@ -0,0 +1,94 @@
+package grpctest
+
+import (
+	"context"
+
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+)
+
+// The G2ConfigMgr service of a Server.
+type g2configmgrServer struct {
+	g2pb.UnimplementedG2ConfigMgrServer
+	server *Server
+}
+
+// ----------------------------------------------------------------------------
+// G2ConfigMgrServer methods
+// ----------------------------------------------------------------------------
+
+func (service *g2configmgrServer) AddConfig(ctx context.Context, request *g2pb.AddConfigRequest) (*g2pb.AddConfigResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, err := parseConfig(request.GetConfigStr()); err != nil {
+		return nil, newCallError(g2configmgrProductId, 7226, "Invalid configuration: %v", err)
+	}
+	configID := service.server.addConfig(request.GetConfigStr(), request.GetConfigComments())
+	return &g2pb.AddConfigResponse{Result: configID}, nil
+}
+
+func (service *g2configmgrServer) Destroy(ctx context.Context, request *g2pb.DestroyRequest) (*g2pb.DestroyResponse, error) {
+	return nil, newDestroyError(g2configmgrProductId)
+}
+
+func (service *g2configmgrServer) GetConfig(ctx context.Context, request *g2pb.GetConfigRequest) (*g2pb.GetConfigResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	config, ok := service.server.configs[request.GetConfigID()]
+	if !ok {
+		return nil, newCallError(g2configmgrProductId, 7221, "No configuration found for CONFIG_ID: %d", request.GetConfigID())
+	}
+	return &g2pb.GetConfigResponse{Result: config.configJson}, nil
+}
+
+func (service *g2configmgrServer) GetConfigList(ctx context.Context, request *g2pb.GetConfigListRequest) (*g2pb.GetConfigListResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	type listedConfig struct {
+		ConfigId       int64  `json:"CONFIG_ID"`
+		ConfigComments string `json:"CONFIG_COMMENTS"`
+		SysCreateDt    string `json:"SYS_CREATE_DT"`
+	}
+	configs := []listedConfig{}
+	for _, configID := range service.server.configIDs {
+		config := service.server.configs[configID]
+		configs = append(configs, listedConfig{
+			ConfigId:       configID,
+			ConfigComments: config.comments,
+			SysCreateDt:    config.createdAt.Format("2006-01-02 15:04:05.000"),
+		})
+	}
+	return &g2pb.GetConfigListResponse{Result: encodeJson(map[string]interface{}{"CONFIGS": configs})}, nil
+}
+
+func (service *g2configmgrServer) GetDefaultConfigID(ctx context.Context, request *g2pb.GetDefaultConfigIDRequest) (*g2pb.GetDefaultConfigIDResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.GetDefaultConfigIDResponse{ConfigID: service.server.defaultConfigID}, nil
+}
+
+func (service *g2configmgrServer) Init(ctx context.Context, request *g2pb.InitRequest) (*g2pb.InitResponse, error) {
+	return nil, newInitError(g2configmgrProductId, initMessageNumber)
+}
+
+func (service *g2configmgrServer) ReplaceDefaultConfigID(ctx context.Context, request *g2pb.ReplaceDefaultConfigIDRequest) (*g2pb.ReplaceDefaultConfigIDResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, ok := service.server.configs[request.GetNewConfigID()]; !ok {
+		return nil, newCallError(g2configmgrProductId, 7221, "No configuration found for CONFIG_ID: %d", request.GetNewConfigID())
+	}
+	if service.server.defaultConfigID != request.GetOldConfigID() {
+		return nil, newCallError(g2configmgrProductId, 7246, "Current configuration ID does not match specified data [%d]", request.GetOldConfigID())
+	}
+	service.server.defaultConfigID = request.GetNewConfigID()
+	return &g2pb.ReplaceDefaultConfigIDResponse{}, nil
+}
+
+func (service *g2configmgrServer) SetDefaultConfigID(ctx context.Context, request *g2pb.SetDefaultConfigIDRequest) (*g2pb.SetDefaultConfigIDResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, ok := service.server.configs[request.GetConfigID()]; !ok {
+		return nil, newCallError(g2configmgrProductId, 7221, "No configuration found for CONFIG_ID: %d", request.GetConfigID())
+	}
+	service.server.defaultConfigID = request.GetConfigID()
+	return &g2pb.SetDefaultConfigIDResponse{}, nil
+}
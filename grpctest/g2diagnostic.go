@@ -0,0 +1,211 @@
+package grpctest
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2diagnostic"
+)
+
+// The G2Diagnostic service of a Server.
+type g2diagnosticServer struct {
+	g2pb.UnimplementedG2DiagnosticServer
+	server *Server
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Lines of an entity list. Every entity has one record, so only a size of 1 lists anything.
+func (service *g2diagnosticServer) entityListLines(entitySize int32) []string {
+	result := []string{}
+	if entitySize != 1 {
+		return result
+	}
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	for _, record := range service.server.sortedRecords() {
+		result = append(result, encodeJson(map[string]int64{"ENTITY_ID": record.entityID, "ENTITY_SIZE": 1})+"\n")
+	}
+	return result
+}
+
+func (service *g2diagnosticServer) entityDocument(entityID int64) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, ok := service.server.recordByEntityID(entityID)
+	if !ok {
+		return "", newCallError(g2diagnosticProductId, 37, "Unknown resolved entity value '%d'", entityID)
+	}
+	flags := g2flags.New(g2flags.EntityIncludeEntityName, g2flags.EntityIncludeRecordSummary, g2flags.EntityIncludeRecordData)
+	return encodeJson(newEntityDocument(record.entityID, []*storedRecord{record}, flags)), nil
+}
+
+// ----------------------------------------------------------------------------
+// G2DiagnosticServer methods
+// ----------------------------------------------------------------------------
+
+func (service *g2diagnosticServer) CheckDBPerf(ctx context.Context, request *g2pb.CheckDBPerfRequest) (*g2pb.CheckDBPerfResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	result := encodeJson(map[string]int64{"numRecordsInserted": int64(len(service.server.records)), "insertTime": 0})
+	return &g2pb.CheckDBPerfResponse{Result: result}, nil
+}
+
+func (service *g2diagnosticServer) CloseEntityListBySize(ctx context.Context, request *g2pb.CloseEntityListBySizeRequest) (*g2pb.CloseEntityListBySizeResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, ok := service.server.entityLists[request.GetEntityListBySizeHandle()]; !ok {
+		return nil, newCallError(g2diagnosticProductId, 2, "Invalid entity list handle: %s", request.GetEntityListBySizeHandle())
+	}
+	delete(service.server.entityLists, request.GetEntityListBySizeHandle())
+	return &g2pb.CloseEntityListBySizeResponse{}, nil
+}
+
+func (service *g2diagnosticServer) Destroy(ctx context.Context, request *g2pb.DestroyRequest) (*g2pb.DestroyResponse, error) {
+	return nil, newDestroyError(g2diagnosticProductId)
+}
+
+func (service *g2diagnosticServer) FetchNextEntityBySize(ctx context.Context, request *g2pb.FetchNextEntityBySizeRequest) (*g2pb.FetchNextEntityBySizeResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	entityList, ok := service.server.entityLists[request.GetEntityListBySizeHandle()]
+	if !ok {
+		return nil, newCallError(g2diagnosticProductId, 2, "Invalid entity list handle: %s", request.GetEntityListBySizeHandle())
+	}
+	return &g2pb.FetchNextEntityBySizeResponse{Result: entityList.fetch()}, nil
+}
+
+// FindEntitiesByFeatureIDs finds nothing; the fake has no features.
+func (service *g2diagnosticServer) FindEntitiesByFeatureIDs(ctx context.Context, request *g2pb.FindEntitiesByFeatureIDsRequest) (*g2pb.FindEntitiesByFeatureIDsResponse, error) {
+	return &g2pb.FindEntitiesByFeatureIDsResponse{Result: "[]"}, nil
+}
+
+func (service *g2diagnosticServer) GetAvailableMemory(ctx context.Context, request *g2pb.GetAvailableMemoryRequest) (*g2pb.GetAvailableMemoryResponse, error) {
+	return &g2pb.GetAvailableMemoryResponse{Result: availableMemory}, nil
+}
+
+func (service *g2diagnosticServer) GetDataSourceCounts(ctx context.Context, request *g2pb.GetDataSourceCountsRequest) (*g2pb.GetDataSourceCountsResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	type dataSourceCount struct {
+		DsrcId          int64  `json:"DSRC_ID"`
+		DsrcCode        string `json:"DSRC_CODE"`
+		ObsEntCount     int64  `json:"OBS_ENT_COUNT"`
+		DsrcRecordCount int64  `json:"DSRC_RECORD_COUNT"`
+	}
+	counts := map[string]int64{}
+	for key := range service.server.records {
+		counts[key.dataSourceCode]++
+	}
+	result := []dataSourceCount{}
+	for _, dataSource := range service.server.activeConfig().document.dataSources() {
+		count := counts[dataSource.code]
+		result = append(result, dataSourceCount{DsrcId: dataSource.id, DsrcCode: dataSource.code, ObsEntCount: count, DsrcRecordCount: count})
+	}
+	return &g2pb.GetDataSourceCountsResponse{Result: encodeJson(result)}, nil
+}
+
+func (service *g2diagnosticServer) GetDBInfo(ctx context.Context, request *g2pb.GetDBInfoRequest) (*g2pb.GetDBInfoResponse, error) {
+	return &g2pb.GetDBInfoResponse{Result: dbInfoJson}, nil
+}
+
+func (service *g2diagnosticServer) GetEntityDetails(ctx context.Context, request *g2pb.GetEntityDetailsRequest) (*g2pb.GetEntityDetailsResponse, error) {
+	result, err := service.entityDocument(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityDetailsResponse{Result: result}, nil
+}
+
+func (service *g2diagnosticServer) GetEntityListBySize(ctx context.Context, request *g2pb.GetEntityListBySizeRequest) (*g2pb.GetEntityListBySizeResponse, error) {
+	lines := service.entityListLines(request.GetEntitySize())
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	handle := strconv.FormatInt(service.server.nextHandle, 10)
+	service.server.nextHandle++
+	service.server.entityLists[handle] = &lineIterator{lines: lines}
+	return &g2pb.GetEntityListBySizeResponse{Result: handle}, nil
+}
+
+func (service *g2diagnosticServer) GetEntityResume(ctx context.Context, request *g2pb.GetEntityResumeRequest) (*g2pb.GetEntityResumeResponse, error) {
+	result, err := service.entityDocument(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityResumeResponse{Result: result}, nil
+}
+
+func (service *g2diagnosticServer) GetEntitySizeBreakdown(ctx context.Context, request *g2pb.GetEntitySizeBreakdownRequest) (*g2pb.GetEntitySizeBreakdownResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	result := []map[string]int64{}
+	count := int64(len(service.server.records))
+	if request.GetMinimumEntitySize() <= 1 && count > 0 {
+		result = append(result, map[string]int64{"ENTITY_SIZE": 1, "ENTITY_COUNT": count})
+	}
+	return &g2pb.GetEntitySizeBreakdownResponse{Result: encodeJson(result)}, nil
+}
+
+func (service *g2diagnosticServer) GetFeature(ctx context.Context, request *g2pb.GetFeatureRequest) (*g2pb.GetFeatureResponse, error) {
+	return nil, newCallError(g2diagnosticProductId, 27, "Unknown feature ID value '%d'", request.GetLibFeatID())
+}
+
+func (service *g2diagnosticServer) GetGenericFeatures(ctx context.Context, request *g2pb.GetGenericFeaturesRequest) (*g2pb.GetGenericFeaturesResponse, error) {
+	return &g2pb.GetGenericFeaturesResponse{Result: "[]"}, nil
+}
+
+func (service *g2diagnosticServer) GetLogicalCores(ctx context.Context, request *g2pb.GetLogicalCoresRequest) (*g2pb.GetLogicalCoresResponse, error) {
+	return &g2pb.GetLogicalCoresResponse{Result: int32(runtime.NumCPU())}, nil
+}
+
+func (service *g2diagnosticServer) GetMappingStatistics(ctx context.Context, request *g2pb.GetMappingStatisticsRequest) (*g2pb.GetMappingStatisticsResponse, error) {
+	return &g2pb.GetMappingStatisticsResponse{Result: "[]"}, nil
+}
+
+func (service *g2diagnosticServer) GetPhysicalCores(ctx context.Context, request *g2pb.GetPhysicalCoresRequest) (*g2pb.GetPhysicalCoresResponse, error) {
+	return &g2pb.GetPhysicalCoresResponse{Result: int32(runtime.NumCPU())}, nil
+}
+
+// GetRelationshipDetails always fails; entities of the fake are never related.
+func (service *g2diagnosticServer) GetRelationshipDetails(ctx context.Context, request *g2pb.GetRelationshipDetailsRequest) (*g2pb.GetRelationshipDetailsResponse, error) {
+	return nil, newError(g2diagnosticProductId, callMessageNumber, fmt.Sprintf("Unknown relationship ID value '%d'", request.GetRelationshipID()))
+}
+
+func (service *g2diagnosticServer) GetResolutionStatistics(ctx context.Context, request *g2pb.GetResolutionStatisticsRequest) (*g2pb.GetResolutionStatisticsResponse, error) {
+	return &g2pb.GetResolutionStatisticsResponse{Result: `{"STATS":[]}`}, nil
+}
+
+func (service *g2diagnosticServer) GetTotalSystemMemory(ctx context.Context, request *g2pb.GetTotalSystemMemoryRequest) (*g2pb.GetTotalSystemMemoryResponse, error) {
+	return &g2pb.GetTotalSystemMemoryResponse{Result: totalSystemMemory}, nil
+}
+
+func (service *g2diagnosticServer) Init(ctx context.Context, request *g2pb.InitRequest) (*g2pb.InitResponse, error) {
+	return nil, newInitError(g2diagnosticProductId, initMessageNumber)
+}
+
+func (service *g2diagnosticServer) InitWithConfigID(ctx context.Context, request *g2pb.InitWithConfigIDRequest) (*g2pb.InitWithConfigIDResponse, error) {
+	return nil, newInitError(g2diagnosticProductId, initWithConfigIDMessageNumber)
+}
+
+func (service *g2diagnosticServer) Reinit(ctx context.Context, request *g2pb.ReinitRequest) (*g2pb.ReinitResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if err := service.server.reinit(g2diagnosticProductId, request.GetInitConfigID()); err != nil {
+		return nil, err
+	}
+	return &g2pb.ReinitResponse{}, nil
+}
+
+func (service *g2diagnosticServer) StreamEntityListBySize(request *g2pb.StreamEntityListBySizeRequest, stream g2pb.G2Diagnostic_StreamEntityListBySizeServer) error {
+	for _, line := range service.entityListLines(request.GetEntitySize()) {
+		if err := stream.Send(&g2pb.StreamEntityListBySizeResponse{Result: line}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
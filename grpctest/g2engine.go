@@ -0,0 +1,1165 @@
+package grpctest
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+)
+
+// The G2Engine service of a Server.
+type g2engineServer struct {
+	g2pb.UnimplementedG2EngineServer
+	server *Server
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Decode {"ENTITIES": [{"ENTITY_ID": 1}, ...]}.
+func parseEntityList(entityList string) ([]int64, error) {
+	list := struct {
+		Entities []struct {
+			EntityID int64 `json:"ENTITY_ID"`
+		} `json:"ENTITIES"`
+	}{}
+	if err := json.Unmarshal([]byte(entityList), &list); err != nil {
+		return nil, newCallError(g2engineProductId, 30121, "Invalid entity list: %v", err)
+	}
+	result := []int64{}
+	for _, entity := range list.Entities {
+		result = append(result, entity.EntityID)
+	}
+	return result, nil
+}
+
+// Decode {"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}, ...]}.
+func parseRecordList(recordList string) ([]recordKey, error) {
+	list := struct {
+		Records []recordKeyDocument `json:"RECORDS"`
+	}{}
+	if err := json.Unmarshal([]byte(recordList), &list); err != nil {
+		return nil, newCallError(g2engineProductId, 30121, "Invalid record list: %v", err)
+	}
+	result := []recordKey{}
+	for _, record := range list.Records {
+		result = append(result, recordKey{dataSourceCode: strings.ToUpper(record.DataSource), recordID: record.RecordID})
+	}
+	return result, nil
+}
+
+// Report whether an export includes singletons, which all entities of the fake are.
+// Flags that select no entities at all select every entity, as in Senzing.
+func exportsSingletons(flags g2flags.Flags) bool {
+	return flags.Has(g2flags.ExportIncludeSingletons) || flags&g2flags.ExportIncludeAllEntities == 0
+}
+
+// The string value of a field of record JSON, which may be a string or a number.
+func stringField(fields map[string]interface{}, key string) string {
+	value, ok := fields[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods; the caller holds the lock.
+// ----------------------------------------------------------------------------
+
+func (service *g2engineServer) record(key recordKey) (*storedRecord, error) {
+	record, ok := service.server.records[key]
+	if !ok {
+		return nil, newCallError(g2engineProductId, 33, "Unknown record: dsrc[%s], record[%s]", key.dataSourceCode, key.recordID)
+	}
+	return record, nil
+}
+
+func (service *g2engineServer) records(keys []recordKey) ([]*storedRecord, error) {
+	result := []*storedRecord{}
+	for _, key := range keys {
+		record, err := service.record(key)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+func (service *g2engineServer) entity(entityID int64) (*storedRecord, error) {
+	record, ok := service.server.recordByEntityID(entityID)
+	if !ok {
+		return nil, newCallError(g2engineProductId, 37, "Unknown resolved entity value '%d'", entityID)
+	}
+	return record, nil
+}
+
+func (service *g2engineServer) entities(entityIDs []int64) ([]*storedRecord, error) {
+	result := []*storedRecord{}
+	for _, entityID := range entityIDs {
+		record, err := service.entity(entityID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// Validate and store a record. Missing data source codes and record IDs are taken from the JSON.
+func (service *g2engineServer) addRecord(dataSourceCode string, recordID string, jsonData string, loadID string) (*storedRecord, error) {
+	fields := map[string]interface{}{}
+	if err := decodeJson(jsonData, &fields); err != nil {
+		return nil, newCallError(g2engineProductId, 30121, "Invalid JSON: %v", err)
+	}
+	jsonDataSourceCode := stringField(fields, "DATA_SOURCE")
+	if dataSourceCode == "" {
+		dataSourceCode = jsonDataSourceCode
+	} else if jsonDataSourceCode != "" && !strings.EqualFold(dataSourceCode, jsonDataSourceCode) {
+		return nil, newCallError(g2engineProductId, 23, "Conflicting DATA_SOURCE values '%s' and '%s'", dataSourceCode, jsonDataSourceCode)
+	}
+	jsonRecordID := stringField(fields, "RECORD_ID")
+	if recordID == "" {
+		recordID = jsonRecordID
+	} else if jsonRecordID != "" && recordID != jsonRecordID {
+		return nil, newCallError(g2engineProductId, 24, "Conflicting RECORD_ID values '%s' and '%s'", recordID, jsonRecordID)
+	}
+	if recordID == "" {
+		hash := sha1.Sum([]byte(jsonData))
+		recordID = strings.ToUpper(hex.EncodeToString(hash[:]))
+	}
+	dataSourceCode = strings.ToUpper(dataSourceCode)
+	if !service.server.knowsDataSource(dataSourceCode) {
+		return nil, newCallError(g2engineProductId, 30020, "Unknown data source '%s'", dataSourceCode)
+	}
+	service.server.stats.AddedRecords++
+	return service.server.putRecord(recordKey{dataSourceCode: dataSourceCode, recordID: recordID}, fields, loadID), nil
+}
+
+func (service *g2engineServer) deleteRecord(dataSourceCode string, recordID string) (string, error) {
+	key := recordKey{dataSourceCode: strings.ToUpper(dataSourceCode), recordID: recordID}
+	if !service.server.knowsDataSource(key.dataSourceCode) {
+		return "", newCallError(g2engineProductId, 30020, "Unknown data source '%s'", key.dataSourceCode)
+	}
+	entityID := service.server.deleteRecord(key)
+	if entityID != 0 {
+		service.server.stats.DeletedRecords++
+	}
+	return newWithInfoDocument(key, entityID), nil
+}
+
+func (service *g2engineServer) entityDocument(record *storedRecord, flags g2flags.Flags) entityDocument {
+	return newEntityDocument(record.entityID, []*storedRecord{record}, flags)
+}
+
+func (service *g2engineServer) entityDocuments(records []*storedRecord, flags g2flags.Flags) []entityDocument {
+	result := []entityDocument{}
+	for _, record := range records {
+		result = append(result, service.entityDocument(record, flags))
+	}
+	return result
+}
+
+// Entities are not related, so the only path is from an entity to itself.
+func (service *g2engineServer) path(record1 *storedRecord, record2 *storedRecord) entityPathDocument {
+	result := entityPathDocument{StartEntityID: record1.entityID, EndEntityID: record2.entityID, Entities: []int64{}}
+	if record1.entityID == record2.entityID {
+		result.Entities = []int64{record1.entityID}
+	}
+	return result
+}
+
+func (service *g2engineServer) pathDocument(record1 *storedRecord, record2 *storedRecord, flags g2flags.Flags) string {
+	return encodeJson(pathDocument{
+		EntityPaths: []entityPathDocument{service.path(record1, record2)},
+		Entities:    service.entityDocuments([]*storedRecord{record1, record2}, flags),
+	})
+}
+
+func (service *g2engineServer) networkDocument(records []*storedRecord, flags g2flags.Flags) string {
+	result := networkDocument{
+		EntityPaths:        []entityPathDocument{},
+		EntityNetworkLinks: []struct{}{},
+		Entities:           service.entityDocuments(records, flags),
+	}
+	for i := range records {
+		for j := i + 1; j < len(records); j++ {
+			result.EntityPaths = append(result.EntityPaths, service.path(records[i], records[j]))
+		}
+	}
+	return encodeJson(result)
+}
+
+func (service *g2engineServer) howDocument(record *storedRecord) string {
+	return encodeJson(howDocument{HowResults: howResultsDocument{
+		ResolutionSteps: []struct{}{},
+		FinalState: howFinalStateDocument{
+			VirtualEntities: []virtualEntityDocument{{
+				VirtualEntityID: fmt.Sprintf("V%d", record.entityID),
+				MemberRecords: []memberRecordDocument{{
+					InternalID: record.entityID,
+					Records:    []recordKeyDocument{newRecordKeyDocument(record)},
+				}},
+			}},
+		},
+	}})
+}
+
+// Why an entity resolved: each entity has a single record, so there is nothing to explain.
+func (service *g2engineServer) whyEntityDocument(record *storedRecord, flags g2flags.Flags) string {
+	return encodeJson(whyDocument{
+		WhyResults: []whyResultDocument{{
+			InternalID:   record.entityID,
+			EntityID:     record.entityID,
+			FocusRecords: []recordKeyDocument{newRecordKeyDocument(record)},
+		}},
+		Entities: service.entityDocuments([]*storedRecord{record}, flags),
+	})
+}
+
+// Why two records or entities did not resolve: the match key lists what they share.
+func (service *g2engineServer) whyPairDocument(record1 *storedRecord, record2 *storedRecord, includeRecords bool, flags g2flags.Flags) string {
+	result := whyResultDocument{
+		EntityID:  record1.entityID,
+		EntityID2: record2.entityID,
+		MatchInfo: whyMatchInfoDocument{WhyKey: matchKey(attributes(record1.jsonData), attributes(record2.jsonData))},
+	}
+	if includeRecords {
+		result.InternalID = record1.entityID
+		result.InternalID2 = record2.entityID
+		result.FocusRecords = []recordKeyDocument{newRecordKeyDocument(record1)}
+		result.FocusRecords2 = []recordKeyDocument{newRecordKeyDocument(record2)}
+	}
+	return encodeJson(whyDocument{
+		WhyResults: []whyResultDocument{result},
+		Entities:   service.entityDocuments([]*storedRecord{record1, record2}, flags),
+	})
+}
+
+func (service *g2engineServer) searchDocument(jsonData string, flags g2flags.Flags) (string, error) {
+	fields := map[string]interface{}{}
+	if err := decodeJson(jsonData, &fields); err != nil {
+		return "", newCallError(g2engineProductId, 30121, "Invalid JSON: %v", err)
+	}
+	searchAttributes := attributes(fields)
+	result := searchDocument{ResolvedEntities: []searchResultDocument{}}
+	for _, record := range service.server.sortedRecords() {
+		key := matchKey(searchAttributes, attributes(record.jsonData))
+		if key == "" {
+			continue
+		}
+		matchInfo := matchInfoDocument{MatchLevel: 3, MatchLevelCode: "POSSIBLY_RELATED", MatchKey: key, ErruleCode: "SF1"}
+		if strings.Count(key, "+") > 1 {
+			matchInfo = matchInfoDocument{MatchLevel: 1, MatchLevelCode: "RESOLVED", MatchKey: key, ErruleCode: "SF1_CNAME"}
+		}
+		result.ResolvedEntities = append(result.ResolvedEntities, searchResultDocument{
+			MatchInfo: matchInfo,
+			Entity:    service.entityDocument(record, flags),
+		})
+	}
+	return encodeJson(result), nil
+}
+
+func (service *g2engineServer) checkRecordDocument(jsonData string, recordQueryList string) (string, error) {
+	fields := map[string]interface{}{}
+	if err := decodeJson(jsonData, &fields); err != nil {
+		return "", newCallError(g2engineProductId, 30121, "Invalid JSON: %v", err)
+	}
+	keys, err := parseRecordList(recordQueryList)
+	if err != nil {
+		return "", err
+	}
+	checkAttributes := attributes(fields)
+	result := checkRecordDocument{CheckRecordResponse: []checkRecordResultDocument{}}
+	for _, key := range keys {
+		checkResult := checkRecordResultDocument{DsrcCode: key.dataSourceCode, RecordID: key.recordID, CandidateMatch: "N", NonGenericCandidateMatch: "N"}
+		if record, ok := service.server.records[key]; ok {
+			checkResult.MatchKey = matchKey(checkAttributes, attributes(record.jsonData))
+			if checkResult.MatchKey != "" {
+				checkResult.MatchLevel = 1
+				checkResult.MatchLevelCode = "RESOLVED"
+				checkResult.CandidateMatch = "Y"
+				checkResult.NonGenericCandidateMatch = "Y"
+			}
+		}
+		result.CheckRecordResponse = append(result.CheckRecordResponse, checkResult)
+	}
+	return encodeJson(result), nil
+}
+
+// Lines of a JSON export, one entity per line.
+func (service *g2engineServer) exportJsonLines(flags g2flags.Flags) []string {
+	result := []string{}
+	if !exportsSingletons(flags) {
+		return result
+	}
+	for _, record := range service.server.sortedRecords() {
+		result = append(result, encodeJson(service.entityDocument(record, flags))+"\n")
+	}
+	return result
+}
+
+// Lines of a CSV export: a header, then one line per record.
+func (service *g2engineServer) exportCsvLines(csvColumnList string, flags g2flags.Flags) []string {
+	columns := strings.Split(defaultCsvColumnList, ",")
+	if strings.TrimSpace(csvColumnList) != "" {
+		columns = strings.Split(strings.ToUpper(csvColumnList), ",")
+	}
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i])
+	}
+	result := []string{strings.Join(columns, ",") + "\n"}
+	if !exportsSingletons(flags) {
+		return result
+	}
+	for _, record := range service.server.sortedRecords() {
+		values := []string{}
+		for _, column := range columns {
+			switch column {
+			case "RESOLVED_ENTITY_ID":
+				values = append(values, strconv.FormatInt(record.entityID, 10))
+			case "RELATED_ENTITY_ID", "MATCH_LEVEL", "IS_DISCLOSED", "IS_AMBIGUOUS":
+				values = append(values, "0")
+			case "RESOLVED_ENTITY_NAME":
+				values = append(values, strconv.Quote(entityName(record.jsonData)))
+			case "DATA_SOURCE":
+				values = append(values, strconv.Quote(record.key.dataSourceCode))
+			case "RECORD_ID":
+				values = append(values, strconv.Quote(record.key.recordID))
+			case "JSON_DATA":
+				values = append(values, `"`+strings.ReplaceAll(encodeJson(record.jsonData), `"`, `""`)+`"`)
+			default:
+				values = append(values, `""`)
+			}
+		}
+		result = append(result, strings.Join(values, ",")+"\n")
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods shared by variants of a call; they take the lock.
+// ----------------------------------------------------------------------------
+
+func (service *g2engineServer) addRecordWithInfo(dataSourceCode string, recordID string, jsonData string, loadID string) (string, string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.addRecord(dataSourceCode, recordID, jsonData, loadID)
+	if err != nil {
+		return "", "", err
+	}
+	return newWithInfoDocument(record.key, record.entityID), record.key.recordID, nil
+}
+
+func (service *g2engineServer) deleteRecordWithInfo(dataSourceCode string, recordID string) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return service.deleteRecord(dataSourceCode, recordID)
+}
+
+func (service *g2engineServer) findInterestingEntities(findRecord func() (*storedRecord, error)) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, err := findRecord(); err != nil {
+		return "", err
+	}
+	return encodeJson(interestingEntitiesResponseDocument{InterestingEntities: interestingEntitiesDocument{Entities: []struct{}{}}}), nil
+}
+
+func (service *g2engineServer) findNetworkByEntityID(entityList string, flags g2flags.Flags) (string, error) {
+	entityIDs, err := parseEntityList(entityList)
+	if err != nil {
+		return "", err
+	}
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.entities(entityIDs)
+	if err != nil {
+		return "", err
+	}
+	return service.networkDocument(records, flags), nil
+}
+
+func (service *g2engineServer) findNetworkByRecordID(recordList string, flags g2flags.Flags) (string, error) {
+	keys, err := parseRecordList(recordList)
+	if err != nil {
+		return "", err
+	}
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.records(keys)
+	if err != nil {
+		return "", err
+	}
+	return service.networkDocument(records, flags), nil
+}
+
+func (service *g2engineServer) findPathByEntityID(entityID1 int64, entityID2 int64, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.entities([]int64{entityID1, entityID2})
+	if err != nil {
+		return "", err
+	}
+	return service.pathDocument(records[0], records[1], flags), nil
+}
+
+func (service *g2engineServer) findPathByRecordID(dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.records([]recordKey{newRecordKey(dataSourceCode1, recordID1), newRecordKey(dataSourceCode2, recordID2)})
+	if err != nil {
+		return "", err
+	}
+	return service.pathDocument(records[0], records[1], flags), nil
+}
+
+func (service *g2engineServer) getEntityByEntityID(entityID int64, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.entity(entityID)
+	if err != nil {
+		return "", err
+	}
+	return encodeJson(service.entityDocument(record, flags)), nil
+}
+
+func (service *g2engineServer) getEntityByRecordID(dataSourceCode string, recordID string, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.record(newRecordKey(dataSourceCode, recordID))
+	if err != nil {
+		return "", err
+	}
+	return encodeJson(service.entityDocument(record, flags)), nil
+}
+
+func (service *g2engineServer) getRecord(dataSourceCode string, recordID string, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.record(newRecordKey(dataSourceCode, recordID))
+	if err != nil {
+		return "", err
+	}
+	return encodeJson(newRecordDocument(record, flags)), nil
+}
+
+// A virtual entity of the records, identified by the entity of the first record.
+func (service *g2engineServer) getVirtualEntityByRecordID(recordList string, flags g2flags.Flags) (string, error) {
+	keys, err := parseRecordList(recordList)
+	if err != nil {
+		return "", err
+	}
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.records(keys)
+	if err != nil {
+		return "", err
+	}
+	var entityID int64 = 0
+	if len(records) > 0 {
+		entityID = records[0].entityID
+	}
+	return encodeJson(newEntityDocument(entityID, records, flags)), nil
+}
+
+func (service *g2engineServer) howEntityByEntityID(entityID int64) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.entity(entityID)
+	if err != nil {
+		return "", err
+	}
+	return service.howDocument(record), nil
+}
+
+func (service *g2engineServer) process(jsonData string) (string, error) {
+	return service.processWithLoadID(jsonData, "")
+}
+
+func (service *g2engineServer) processWithLoadID(jsonData string, loadID string) (string, error) {
+	result, _, err := service.addRecordWithInfo("", "", jsonData, loadID)
+	return result, err
+}
+
+// Take the first redo record and process it, returning it and its "with info" document, or "" if there is none.
+func (service *g2engineServer) processRedoRecord() (string, string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if len(service.server.redoRecords) == 0 {
+		return "", "", nil
+	}
+	redoRecord := service.server.redoRecords[0]
+	service.server.redoRecords = service.server.redoRecords[1:]
+	fields := map[string]interface{}{}
+	if err := decodeJson(redoRecord, &fields); err != nil {
+		return "", "", newCallError(g2engineProductId, 88, "Invalid redo record: %v", err)
+	}
+	key := newRecordKey(stringField(fields, "DATA_SOURCE"), stringField(fields, "RECORD_ID"))
+	var entityID int64 = 0
+	if record, ok := service.server.records[key]; ok {
+		entityID = record.entityID
+		service.server.stats.Reevaluations++
+	}
+	return redoRecord, newWithInfoDocument(key, entityID), nil
+}
+
+func (service *g2engineServer) reevaluateEntity(entityID int64) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.entity(entityID)
+	if err != nil {
+		return "", err
+	}
+	service.server.stats.Reevaluations++
+	return newWithInfoDocument(record.key, record.entityID), nil
+}
+
+func (service *g2engineServer) reevaluateRecord(dataSourceCode string, recordID string) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := service.record(newRecordKey(dataSourceCode, recordID))
+	if err != nil {
+		return "", err
+	}
+	service.server.stats.Reevaluations++
+	return newWithInfoDocument(record.key, record.entityID), nil
+}
+
+func (service *g2engineServer) searchByAttributes(jsonData string, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return service.searchDocument(jsonData, flags)
+}
+
+func (service *g2engineServer) whyEntities(entityID1 int64, entityID2 int64, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.entities([]int64{entityID1, entityID2})
+	if err != nil {
+		return "", err
+	}
+	return service.whyPairDocument(records[0], records[1], false, flags), nil
+}
+
+func (service *g2engineServer) whyEntity(findRecord func() (*storedRecord, error), flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	record, err := findRecord()
+	if err != nil {
+		return "", err
+	}
+	return service.whyEntityDocument(record, flags), nil
+}
+
+func (service *g2engineServer) whyRecords(dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags g2flags.Flags) (string, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	records, err := service.records([]recordKey{newRecordKey(dataSourceCode1, recordID1), newRecordKey(dataSourceCode2, recordID2)})
+	if err != nil {
+		return "", err
+	}
+	return service.whyPairDocument(records[0], records[1], true, flags), nil
+}
+
+// ----------------------------------------------------------------------------
+// G2EngineServer methods
+// ----------------------------------------------------------------------------
+
+func (service *g2engineServer) AddRecord(ctx context.Context, request *g2pb.AddRecordRequest) (*g2pb.AddRecordResponse, error) {
+	_, _, err := service.addRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID(), request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.AddRecordResponse{}, nil
+}
+
+func (service *g2engineServer) AddRecordWithInfo(ctx context.Context, request *g2pb.AddRecordWithInfoRequest) (*g2pb.AddRecordWithInfoResponse, error) {
+	result, _, err := service.addRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID(), request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.AddRecordWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) AddRecordWithInfoWithReturnedRecordID(ctx context.Context, request *g2pb.AddRecordWithInfoWithReturnedRecordIDRequest) (*g2pb.AddRecordWithInfoWithReturnedRecordIDResponse, error) {
+	result, recordID, err := service.addRecordWithInfo(request.GetDataSourceCode(), "", request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.AddRecordWithInfoWithReturnedRecordIDResponse{WithInfo: result, RecordID: recordID}, nil
+}
+
+func (service *g2engineServer) AddRecordWithReturnedRecordID(ctx context.Context, request *g2pb.AddRecordWithReturnedRecordIDRequest) (*g2pb.AddRecordWithReturnedRecordIDResponse, error) {
+	_, recordID, err := service.addRecordWithInfo(request.GetDataSourceCode(), "", request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.AddRecordWithReturnedRecordIDResponse{Result: recordID}, nil
+}
+
+func (service *g2engineServer) CheckRecord(ctx context.Context, request *g2pb.CheckRecordRequest) (*g2pb.CheckRecordResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	result, err := service.checkRecordDocument(request.GetRecord(), request.GetRecordQueryList())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.CheckRecordResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) CloseExport(ctx context.Context, request *g2pb.CloseExportRequest) (*g2pb.CloseExportResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if _, ok := service.server.exports[request.GetResponseHandle()]; !ok {
+		return nil, newCallError(g2engineProductId, 2, "Invalid export handle: %d", request.GetResponseHandle())
+	}
+	delete(service.server.exports, request.GetResponseHandle())
+	return &g2pb.CloseExportResponse{}, nil
+}
+
+func (service *g2engineServer) CountRedoRecords(ctx context.Context, request *g2pb.CountRedoRecordsRequest) (*g2pb.CountRedoRecordsResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.CountRedoRecordsResponse{Result: int64(len(service.server.redoRecords))}, nil
+}
+
+func (service *g2engineServer) DeleteRecord(ctx context.Context, request *g2pb.DeleteRecordRequest) (*g2pb.DeleteRecordResponse, error) {
+	_, err := service.deleteRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.DeleteRecordResponse{}, nil
+}
+
+func (service *g2engineServer) DeleteRecordWithInfo(ctx context.Context, request *g2pb.DeleteRecordWithInfoRequest) (*g2pb.DeleteRecordWithInfoResponse, error) {
+	result, err := service.deleteRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.DeleteRecordWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) Destroy(ctx context.Context, request *g2pb.DestroyRequest) (*g2pb.DestroyResponse, error) {
+	return nil, newDestroyError(g2engineProductId)
+}
+
+func (service *g2engineServer) ExportConfig(ctx context.Context, request *g2pb.ExportConfigRequest) (*g2pb.ExportConfigResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.ExportConfigResponse{Result: service.server.activeConfig().configJson}, nil
+}
+
+func (service *g2engineServer) ExportConfigAndConfigID(ctx context.Context, request *g2pb.ExportConfigAndConfigIDRequest) (*g2pb.ExportConfigAndConfigIDResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.ExportConfigAndConfigIDResponse{Config: service.server.activeConfig().configJson, ConfigID: service.server.activeConfigID}, nil
+}
+
+func (service *g2engineServer) ExportCSVEntityReport(ctx context.Context, request *g2pb.ExportCSVEntityReportRequest) (*g2pb.ExportCSVEntityReportResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	lines := service.exportCsvLines(request.GetCsvColumnList(), g2flags.Flags(request.GetFlags()))
+	return &g2pb.ExportCSVEntityReportResponse{Result: service.server.newExport(lines)}, nil
+}
+
+func (service *g2engineServer) ExportJSONEntityReport(ctx context.Context, request *g2pb.ExportJSONEntityReportRequest) (*g2pb.ExportJSONEntityReportResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	lines := service.exportJsonLines(g2flags.Flags(request.GetFlags()))
+	return &g2pb.ExportJSONEntityReportResponse{Result: service.server.newExport(lines)}, nil
+}
+
+func (service *g2engineServer) FetchNext(ctx context.Context, request *g2pb.FetchNextRequest) (*g2pb.FetchNextResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	export, ok := service.server.exports[request.GetResponseHandle()]
+	if !ok {
+		return nil, newCallError(g2engineProductId, 2, "Invalid export handle: %d", request.GetResponseHandle())
+	}
+	return &g2pb.FetchNextResponse{Result: export.fetch()}, nil
+}
+
+func (service *g2engineServer) FindInterestingEntitiesByEntityID(ctx context.Context, request *g2pb.FindInterestingEntitiesByEntityIDRequest) (*g2pb.FindInterestingEntitiesByEntityIDResponse, error) {
+	result, err := service.findInterestingEntities(func() (*storedRecord, error) {
+		return service.entity(request.GetEntityID())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindInterestingEntitiesByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindInterestingEntitiesByRecordID(ctx context.Context, request *g2pb.FindInterestingEntitiesByRecordIDRequest) (*g2pb.FindInterestingEntitiesByRecordIDResponse, error) {
+	result, err := service.findInterestingEntities(func() (*storedRecord, error) {
+		return service.record(newRecordKey(request.GetDataSourceCode(), request.GetRecordID()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindInterestingEntitiesByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindNetworkByEntityID(ctx context.Context, request *g2pb.FindNetworkByEntityIDRequest) (*g2pb.FindNetworkByEntityIDResponse, error) {
+	result, err := service.findNetworkByEntityID(request.GetEntityList(), g2flags.FindNetworkDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindNetworkByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindNetworkByEntityID_V2(ctx context.Context, request *g2pb.FindNetworkByEntityID_V2Request) (*g2pb.FindNetworkByEntityID_V2Response, error) {
+	result, err := service.findNetworkByEntityID(request.GetEntityList(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindNetworkByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindNetworkByRecordID(ctx context.Context, request *g2pb.FindNetworkByRecordIDRequest) (*g2pb.FindNetworkByRecordIDResponse, error) {
+	result, err := service.findNetworkByRecordID(request.GetRecordList(), g2flags.FindNetworkDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindNetworkByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindNetworkByRecordID_V2(ctx context.Context, request *g2pb.FindNetworkByRecordID_V2Request) (*g2pb.FindNetworkByRecordID_V2Response, error) {
+	result, err := service.findNetworkByRecordID(request.GetRecordList(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindNetworkByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathByEntityID(ctx context.Context, request *g2pb.FindPathByEntityIDRequest) (*g2pb.FindPathByEntityIDResponse, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathByEntityID_V2(ctx context.Context, request *g2pb.FindPathByEntityID_V2Request) (*g2pb.FindPathByEntityID_V2Response, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathByRecordID(ctx context.Context, request *g2pb.FindPathByRecordIDRequest) (*g2pb.FindPathByRecordIDResponse, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathByRecordID_V2(ctx context.Context, request *g2pb.FindPathByRecordID_V2Request) (*g2pb.FindPathByRecordID_V2Response, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathExcludingByEntityID(ctx context.Context, request *g2pb.FindPathExcludingByEntityIDRequest) (*g2pb.FindPathExcludingByEntityIDResponse, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathExcludingByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathExcludingByEntityID_V2(ctx context.Context, request *g2pb.FindPathExcludingByEntityID_V2Request) (*g2pb.FindPathExcludingByEntityID_V2Response, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathExcludingByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathExcludingByRecordID(ctx context.Context, request *g2pb.FindPathExcludingByRecordIDRequest) (*g2pb.FindPathExcludingByRecordIDResponse, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathExcludingByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathExcludingByRecordID_V2(ctx context.Context, request *g2pb.FindPathExcludingByRecordID_V2Request) (*g2pb.FindPathExcludingByRecordID_V2Response, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathExcludingByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathIncludingSourceByEntityID(ctx context.Context, request *g2pb.FindPathIncludingSourceByEntityIDRequest) (*g2pb.FindPathIncludingSourceByEntityIDResponse, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathIncludingSourceByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathIncludingSourceByEntityID_V2(ctx context.Context, request *g2pb.FindPathIncludingSourceByEntityID_V2Request) (*g2pb.FindPathIncludingSourceByEntityID_V2Response, error) {
+	result, err := service.findPathByEntityID(request.GetEntityID1(), request.GetEntityID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathIncludingSourceByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathIncludingSourceByRecordID(ctx context.Context, request *g2pb.FindPathIncludingSourceByRecordIDRequest) (*g2pb.FindPathIncludingSourceByRecordIDResponse, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.FindPathDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathIncludingSourceByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) FindPathIncludingSourceByRecordID_V2(ctx context.Context, request *g2pb.FindPathIncludingSourceByRecordID_V2Request) (*g2pb.FindPathIncludingSourceByRecordID_V2Response, error) {
+	result, err := service.findPathByRecordID(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.FindPathIncludingSourceByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) GetActiveConfigID(ctx context.Context, request *g2pb.GetActiveConfigIDRequest) (*g2pb.GetActiveConfigIDResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.GetActiveConfigIDResponse{Result: service.server.activeConfigID}, nil
+}
+
+func (service *g2engineServer) GetEntityByEntityID(ctx context.Context, request *g2pb.GetEntityByEntityIDRequest) (*g2pb.GetEntityByEntityIDResponse, error) {
+	result, err := service.getEntityByEntityID(request.GetEntityID(), g2flags.EntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) GetEntityByEntityID_V2(ctx context.Context, request *g2pb.GetEntityByEntityID_V2Request) (*g2pb.GetEntityByEntityID_V2Response, error) {
+	result, err := service.getEntityByEntityID(request.GetEntityID(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) GetEntityByRecordID(ctx context.Context, request *g2pb.GetEntityByRecordIDRequest) (*g2pb.GetEntityByRecordIDResponse, error) {
+	result, err := service.getEntityByRecordID(request.GetDataSourceCode(), request.GetRecordID(), g2flags.EntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) GetEntityByRecordID_V2(ctx context.Context, request *g2pb.GetEntityByRecordID_V2Request) (*g2pb.GetEntityByRecordID_V2Response, error) {
+	result, err := service.getEntityByRecordID(request.GetDataSourceCode(), request.GetRecordID(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetEntityByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) GetRecord(ctx context.Context, request *g2pb.GetRecordRequest) (*g2pb.GetRecordResponse, error) {
+	result, err := service.getRecord(request.GetDataSourceCode(), request.GetRecordID(), g2flags.RecordDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetRecordResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) GetRecord_V2(ctx context.Context, request *g2pb.GetRecord_V2Request) (*g2pb.GetRecord_V2Response, error) {
+	result, err := service.getRecord(request.GetDataSourceCode(), request.GetRecordID(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetRecord_V2Response{Result: result}, nil
+}
+
+// GetRedoRecord takes the first redo record, or returns "" if there is none.
+func (service *g2engineServer) GetRedoRecord(ctx context.Context, request *g2pb.GetRedoRecordRequest) (*g2pb.GetRedoRecordResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if len(service.server.redoRecords) == 0 {
+		return &g2pb.GetRedoRecordResponse{}, nil
+	}
+	result := service.server.redoRecords[0]
+	service.server.redoRecords = service.server.redoRecords[1:]
+	return &g2pb.GetRedoRecordResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) GetRepositoryLastModifiedTime(ctx context.Context, request *g2pb.GetRepositoryLastModifiedTimeRequest) (*g2pb.GetRepositoryLastModifiedTimeResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	return &g2pb.GetRepositoryLastModifiedTimeResponse{Result: service.server.lastModified.UnixMilli()}, nil
+}
+
+func (service *g2engineServer) GetVirtualEntityByRecordID(ctx context.Context, request *g2pb.GetVirtualEntityByRecordIDRequest) (*g2pb.GetVirtualEntityByRecordIDResponse, error) {
+	result, err := service.getVirtualEntityByRecordID(request.GetRecordList(), g2flags.EntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetVirtualEntityByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) GetVirtualEntityByRecordID_V2(ctx context.Context, request *g2pb.GetVirtualEntityByRecordID_V2Request) (*g2pb.GetVirtualEntityByRecordID_V2Response, error) {
+	result, err := service.getVirtualEntityByRecordID(request.GetRecordList(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.GetVirtualEntityByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) HowEntityByEntityID(ctx context.Context, request *g2pb.HowEntityByEntityIDRequest) (*g2pb.HowEntityByEntityIDResponse, error) {
+	result, err := service.howEntityByEntityID(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.HowEntityByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) HowEntityByEntityID_V2(ctx context.Context, request *g2pb.HowEntityByEntityID_V2Request) (*g2pb.HowEntityByEntityID_V2Response, error) {
+	result, err := service.howEntityByEntityID(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.HowEntityByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) Init(ctx context.Context, request *g2pb.InitRequest) (*g2pb.InitResponse, error) {
+	return nil, newInitError(g2engineProductId, initMessageNumber)
+}
+
+func (service *g2engineServer) InitWithConfigID(ctx context.Context, request *g2pb.InitWithConfigIDRequest) (*g2pb.InitWithConfigIDResponse, error) {
+	return nil, newInitError(g2engineProductId, initWithConfigIDMessageNumber)
+}
+
+func (service *g2engineServer) PrimeEngine(ctx context.Context, request *g2pb.PrimeEngineRequest) (*g2pb.PrimeEngineResponse, error) {
+	return &g2pb.PrimeEngineResponse{}, nil
+}
+
+func (service *g2engineServer) Process(ctx context.Context, request *g2pb.ProcessRequest) (*g2pb.ProcessResponse, error) {
+	_, err := service.process(request.GetRecord())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessResponse{}, nil
+}
+
+func (service *g2engineServer) ProcessRedoRecord(ctx context.Context, request *g2pb.ProcessRedoRecordRequest) (*g2pb.ProcessRedoRecordResponse, error) {
+	result, _, err := service.processRedoRecord()
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessRedoRecordResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) ProcessRedoRecordWithInfo(ctx context.Context, request *g2pb.ProcessRedoRecordWithInfoRequest) (*g2pb.ProcessRedoRecordWithInfoResponse, error) {
+	result, withInfo, err := service.processRedoRecord()
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessRedoRecordWithInfoResponse{Result: result, WithInfo: withInfo}, nil
+}
+
+func (service *g2engineServer) ProcessWithInfo(ctx context.Context, request *g2pb.ProcessWithInfoRequest) (*g2pb.ProcessWithInfoResponse, error) {
+	result, err := service.process(request.GetRecord())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) ProcessWithResponse(ctx context.Context, request *g2pb.ProcessWithResponseRequest) (*g2pb.ProcessWithResponseResponse, error) {
+	result, err := service.process(request.GetRecord())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessWithResponseResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) ProcessWithResponseResize(ctx context.Context, request *g2pb.ProcessWithResponseResizeRequest) (*g2pb.ProcessWithResponseResizeResponse, error) {
+	result, err := service.process(request.GetRecord())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ProcessWithResponseResizeResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) PurgeRepository(ctx context.Context, request *g2pb.PurgeRepositoryRequest) (*g2pb.PurgeRepositoryResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	service.server.purge()
+	return &g2pb.PurgeRepositoryResponse{}, nil
+}
+
+func (service *g2engineServer) ReevaluateEntity(ctx context.Context, request *g2pb.ReevaluateEntityRequest) (*g2pb.ReevaluateEntityResponse, error) {
+	_, err := service.reevaluateEntity(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReevaluateEntityResponse{}, nil
+}
+
+func (service *g2engineServer) ReevaluateEntityWithInfo(ctx context.Context, request *g2pb.ReevaluateEntityWithInfoRequest) (*g2pb.ReevaluateEntityWithInfoResponse, error) {
+	result, err := service.reevaluateEntity(request.GetEntityID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReevaluateEntityWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) ReevaluateRecord(ctx context.Context, request *g2pb.ReevaluateRecordRequest) (*g2pb.ReevaluateRecordResponse, error) {
+	_, err := service.reevaluateRecord(request.GetDataSourceCode(), request.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReevaluateRecordResponse{}, nil
+}
+
+func (service *g2engineServer) ReevaluateRecordWithInfo(ctx context.Context, request *g2pb.ReevaluateRecordWithInfoRequest) (*g2pb.ReevaluateRecordWithInfoResponse, error) {
+	result, err := service.reevaluateRecord(request.GetDataSourceCode(), request.GetRecordID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReevaluateRecordWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) Reinit(ctx context.Context, request *g2pb.ReinitRequest) (*g2pb.ReinitResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	if err := service.server.reinit(g2engineProductId, request.GetInitConfigID()); err != nil {
+		return nil, err
+	}
+	return &g2pb.ReinitResponse{}, nil
+}
+
+func (service *g2engineServer) ReplaceRecord(ctx context.Context, request *g2pb.ReplaceRecordRequest) (*g2pb.ReplaceRecordResponse, error) {
+	_, _, err := service.addRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID(), request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReplaceRecordResponse{}, nil
+}
+
+func (service *g2engineServer) ReplaceRecordWithInfo(ctx context.Context, request *g2pb.ReplaceRecordWithInfoRequest) (*g2pb.ReplaceRecordWithInfoResponse, error) {
+	result, _, err := service.addRecordWithInfo(request.GetDataSourceCode(), request.GetRecordID(), request.GetJsonData(), request.GetLoadID())
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.ReplaceRecordWithInfoResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) SearchByAttributes(ctx context.Context, request *g2pb.SearchByAttributesRequest) (*g2pb.SearchByAttributesResponse, error) {
+	result, err := service.searchByAttributes(request.GetJsonData(), g2flags.SearchByAttributesDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.SearchByAttributesResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) SearchByAttributes_V2(ctx context.Context, request *g2pb.SearchByAttributes_V2Request) (*g2pb.SearchByAttributes_V2Response, error) {
+	result, err := service.searchByAttributes(request.GetJsonData(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.SearchByAttributes_V2Response{Result: result}, nil
+}
+
+// Stats reports the workload since the previous call, as Senzing does.
+func (service *g2engineServer) Stats(ctx context.Context, request *g2pb.StatsRequest) (*g2pb.StatsResponse, error) {
+	service.server.lock.Lock()
+	defer service.server.lock.Unlock()
+	workload := service.server.stats
+	workload.APIVersion = apiVersion
+	workload.LoadedRecords = int64(len(service.server.records))
+	workload.RedoTriggers = int64(len(service.server.redoRecords))
+	service.server.stats = workloadDocument{}
+	return &g2pb.StatsResponse{Result: encodeJson(statsDocument{Workload: workload})}, nil
+}
+
+func (service *g2engineServer) WhyEntities(ctx context.Context, request *g2pb.WhyEntitiesRequest) (*g2pb.WhyEntitiesResponse, error) {
+	result, err := service.whyEntities(request.GetEntityID1(), request.GetEntityID2(), g2flags.WhyEntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntitiesResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyEntities_V2(ctx context.Context, request *g2pb.WhyEntities_V2Request) (*g2pb.WhyEntities_V2Response, error) {
+	result, err := service.whyEntities(request.GetEntityID1(), request.GetEntityID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntities_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyEntityByEntityID(ctx context.Context, request *g2pb.WhyEntityByEntityIDRequest) (*g2pb.WhyEntityByEntityIDResponse, error) {
+	result, err := service.whyEntity(func() (*storedRecord, error) {
+		return service.entity(request.GetEntityID())
+	}, g2flags.WhyEntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntityByEntityIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyEntityByEntityID_V2(ctx context.Context, request *g2pb.WhyEntityByEntityID_V2Request) (*g2pb.WhyEntityByEntityID_V2Response, error) {
+	result, err := service.whyEntity(func() (*storedRecord, error) {
+		return service.entity(request.GetEntityID())
+	}, g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntityByEntityID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyEntityByRecordID(ctx context.Context, request *g2pb.WhyEntityByRecordIDRequest) (*g2pb.WhyEntityByRecordIDResponse, error) {
+	result, err := service.whyEntity(func() (*storedRecord, error) {
+		return service.record(newRecordKey(request.GetDataSourceCode(), request.GetRecordID()))
+	}, g2flags.WhyEntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntityByRecordIDResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyEntityByRecordID_V2(ctx context.Context, request *g2pb.WhyEntityByRecordID_V2Request) (*g2pb.WhyEntityByRecordID_V2Response, error) {
+	result, err := service.whyEntity(func() (*storedRecord, error) {
+		return service.record(newRecordKey(request.GetDataSourceCode(), request.GetRecordID()))
+	}, g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyEntityByRecordID_V2Response{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyRecords(ctx context.Context, request *g2pb.WhyRecordsRequest) (*g2pb.WhyRecordsResponse, error) {
+	result, err := service.whyRecords(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.WhyEntityDefault)
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyRecordsResponse{Result: result}, nil
+}
+
+func (service *g2engineServer) WhyRecords_V2(ctx context.Context, request *g2pb.WhyRecords_V2Request) (*g2pb.WhyRecords_V2Response, error) {
+	result, err := service.whyRecords(request.GetDataSourceCode1(), request.GetRecordID1(), request.GetDataSourceCode2(), request.GetRecordID2(), g2flags.Flags(request.GetFlags()))
+	if err != nil {
+		return nil, err
+	}
+	return &g2pb.WhyRecords_V2Response{Result: result}, nil
+}
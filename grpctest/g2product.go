@@ -0,0 +1,40 @@
+package grpctest
+
+import (
+	"context"
+
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2product"
+)
+
+// The G2Product service of a Server.
+type g2productServer struct {
+	g2pb.UnimplementedG2ProductServer
+}
+
+// ----------------------------------------------------------------------------
+// G2ProductServer methods
+// ----------------------------------------------------------------------------
+
+func (service *g2productServer) Destroy(ctx context.Context, request *g2pb.DestroyRequest) (*g2pb.DestroyResponse, error) {
+	return nil, newDestroyError(g2productProductId)
+}
+
+func (service *g2productServer) Init(ctx context.Context, request *g2pb.InitRequest) (*g2pb.InitResponse, error) {
+	return nil, newInitError(g2productProductId, initMessageNumber)
+}
+
+func (service *g2productServer) License(ctx context.Context, request *g2pb.LicenseRequest) (*g2pb.LicenseResponse, error) {
+	return &g2pb.LicenseResponse{Result: licenseJson}, nil
+}
+
+func (service *g2productServer) ValidateLicenseFile(ctx context.Context, request *g2pb.ValidateLicenseFileRequest) (*g2pb.ValidateLicenseFileResponse, error) {
+	return &g2pb.ValidateLicenseFileResponse{Result: "Success"}, nil
+}
+
+func (service *g2productServer) ValidateLicenseStringBase64(ctx context.Context, request *g2pb.ValidateLicenseStringBase64Request) (*g2pb.ValidateLicenseStringBase64Response, error) {
+	return &g2pb.ValidateLicenseStringBase64Response{Result: "Success"}, nil
+}
+
+func (service *g2productServer) Version(ctx context.Context, request *g2pb.VersionRequest) (*g2pb.VersionResponse, error) {
+	return &g2pb.VersionResponse{Result: versionJson}, nil
+}
@@ -0,0 +1,274 @@
+/*
+ *
+ */
+
+// Package grpctest is an in-process fake of the Senzing gRPC server.
+package grpctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
+	g2configmgrpb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
+	g2diagnosticpb "github.com/senzing/g2-sdk-proto/go/g2diagnostic"
+	g2pb "github.com/senzing/g2-sdk-proto/go/g2engine"
+	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Server is an in-memory Senzing gRPC server. It is safe for concurrent use.
+type Server struct {
+	activeConfigID  int64
+	configHandles   map[int64]*configDocument
+	configs         map[int64]*storedConfig
+	configIDs       []int64 // In the order they were added.
+	defaultConfigID int64
+	entities        map[int64]recordKey
+	entityLists     map[string]*lineIterator
+	exports         map[int64]*lineIterator
+	grpcServer      *grpc.Server
+	health          *health.Server
+	lastModified    time.Time
+	listener        *bufconn.Listener
+	lock            sync.Mutex
+	nextEntityID    int64
+	nextHandle      int64
+	records         map[recordKey]*storedRecord
+	redoRecords     []string
+	stats           workloadDocument // Since the last G2Engine.Stats().
+}
+
+// Lines of an export or entity list, fetched one at a time.
+type lineIterator struct {
+	lines []string
+	next  int
+}
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	sharedServer *Server
+	sharedOnce   sync.Once
+)
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewServer function starts a Server with an empty repository.
+Its default and active configuration is the template configuration, which has the TEST and SEARCH data sources.
+
+Input
+  - opts: Options of the gRPC server, e.g. grpc.ChainUnaryInterceptor() to check the metadata of calls.
+*/
+func NewServer(opts ...grpc.ServerOption) *Server {
+	server := &Server{
+		configHandles: map[int64]*configDocument{},
+		configs:       map[int64]*storedConfig{},
+		entities:      map[int64]recordKey{},
+		entityLists:   map[string]*lineIterator{},
+		exports:       map[int64]*lineIterator{},
+		grpcServer:    grpc.NewServer(opts...),
+		health:        health.NewServer(),
+		lastModified:  time.Now(),
+		listener:      bufconn.Listen(bufferSize),
+		nextEntityID:  1,
+		nextHandle:    1,
+		records:       map[recordKey]*storedRecord{},
+	}
+	template, err := parseConfig(templateConfigJson)
+	if err != nil {
+		panic(err)
+	}
+	server.defaultConfigID = server.addConfig(template.json(), templateConfigComments)
+	server.activeConfigID = server.defaultConfigID
+
+	g2configpb.RegisterG2ConfigServer(server.grpcServer, &g2configServer{server: server})
+	g2configmgrpb.RegisterG2ConfigMgrServer(server.grpcServer, &g2configmgrServer{server: server})
+	g2diagnosticpb.RegisterG2DiagnosticServer(server.grpcServer, &g2diagnosticServer{server: server})
+	g2pb.RegisterG2EngineServer(server.grpcServer, &g2engineServer{server: server})
+	g2productpb.RegisterG2ProductServer(server.grpcServer, &g2productServer{})
+	healthpb.RegisterHealthServer(server.grpcServer, server.health)
+	go func() {
+		_ = server.grpcServer.Serve(server.listener)
+	}()
+	return server
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// A gRPC error carrying a Senzing message, as the Senzing gRPC server returns it.
+func newError(productId int, messageNumber int, text string) error {
+	message, err := json.Marshal(map[string]string{
+		"id":    fmt.Sprintf("senzing-%d%d", productId, messageNumber),
+		"level": "ERROR",
+		"text":  text,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return status.Error(codes.Unknown, string(message))
+}
+
+// The error of a failed call, with a Senzing error code, e.g. "0033E|Unknown record".
+func newCallError(productId int, senzingCode int, format string, args ...interface{}) error {
+	return newError(productId, callMessageNumber, fmt.Sprintf("%04dE|", senzingCode)+fmt.Sprintf(format, args...))
+}
+
+// The errors of lifecycle methods, which the server does not allow clients to call.
+func newDestroyError(productId int) error {
+	return newError(productId, destroyMessageNumber, "Destroy is not allowed; the server owns the Senzing instance")
+}
+
+func newInitError(productId int, messageNumber int) error {
+	return newError(productId, messageNumber, "0053E|Module is already initialized by the server")
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Register a handle for lines to be fetched one at a time.
+func (server *Server) newExport(lines []string) int64 {
+	handle := server.nextHandle
+	server.nextHandle++
+	server.exports[handle] = &lineIterator{lines: lines}
+	return handle
+}
+
+// The next line, or "" after the last one.
+func (iterator *lineIterator) fetch() string {
+	if iterator.next >= len(iterator.lines) {
+		return ""
+	}
+	result := iterator.lines[iterator.next]
+	iterator.next++
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddRedoRecord method queues a redo record, as Senzing does when loading a record affects other entities.
+The fake never queues redo records itself.
+
+Input
+  - redoRecord: A JSON document with "DATA_SOURCE" and "RECORD_ID" of the record to reevaluate.
+*/
+func (server *Server) AddRedoRecord(redoRecord string) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.redoRecords = append(server.redoRecords, redoRecord)
+}
+
+/*
+The Close method stops the Server. Connections to it fail afterwards.
+*/
+func (server *Server) Close() {
+	server.grpcServer.Stop()
+}
+
+/*
+The Dial method connects to the Server.
+
+Input
+  - ctx: A context to control lifecycle.
+  - opts: Additional options, e.g. grpc.WithUnaryInterceptor().
+
+Output
+  - A connection to pass to the generated gRPC clients.
+*/
+func (server *Server) Dial(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{server.DialOption(), grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	return grpc.DialContext(ctx, "bufnet", opts...)
+}
+
+/*
+The DialOption method returns the option that routes a connection to the Server, whatever its target.
+It is for callers that build their own connection, e.g. with g2client.
+*/
+func (server *Server) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+		return server.listener.DialContext(ctx)
+	})
+}
+
+/*
+The Reset method empties the repository and restores the template configuration, as NewServer() leaves it.
+*/
+func (server *Server) Reset() {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.configHandles = map[int64]*configDocument{}
+	server.configs = map[int64]*storedConfig{}
+	server.configIDs = nil
+	server.purge()
+	server.nextEntityID = 1
+	server.stats = workloadDocument{}
+	template, _ := parseConfig(templateConfigJson)
+	server.defaultConfigID = server.addConfig(template.json(), templateConfigComments)
+	server.activeConfigID = server.defaultConfigID
+	server.health.Resume()
+}
+
+/*
+The SetServing method sets the status the Server reports to the standard gRPC health service.
+A Server starts serving; Reset() makes it serve again.
+*/
+func (server *Server) SetServing(serving bool) {
+	if serving {
+		server.health.Resume()
+	} else {
+		server.health.Shutdown()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Enabled function reports whether the SENZING_TOOLS_GRPC_TEST_FAKE environment variable asks for the fake server.
+*/
+func Enabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv(EnvironmentVariable))
+	return err == nil && enabled
+}
+
+/*
+The Dial function connects to a Server shared by the whole test binary, starting it on first use.
+
+Input
+  - ctx: A context to control lifecycle.
+  - opts: Additional options, e.g. grpc.WithUnaryInterceptor().
+*/
+func Dial(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	sharedOnce.Do(func() {
+		sharedServer = NewServer()
+	})
+	return sharedServer.Dial(ctx, opts...)
+}
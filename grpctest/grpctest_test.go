@@ -0,0 +1,270 @@
+package grpctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2client"
+	"github.com/senzing/g2-sdk-go-grpc/g2error"
+	"github.com/senzing/g2-sdk-go-grpc/g2flags"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testServer *Server
+)
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getTestObject(ctx context.Context, test *testing.T) *g2client.ClientSet {
+	testServer.Reset()
+	grpcConnection, err := testServer.Dial(ctx)
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+	return g2client.NewClientSetFromConnection(grpcConnection)
+}
+
+func testError(test *testing.T, err error) {
+	if err != nil {
+		assert.FailNow(test, err.Error())
+	}
+}
+
+// Add a data source to the default configuration, returning the new configuration ID.
+func addDataSource(ctx context.Context, test *testing.T, clientSet *g2client.ClientSet, dataSourceCode string) int64 {
+	configHandle, err := clientSet.G2config.Create(ctx)
+	testError(test, err)
+	_, err = clientSet.G2config.AddDataSource(ctx, configHandle, fmt.Sprintf(`{"DSRC_CODE": "%s"}`, dataSourceCode))
+	testError(test, err)
+	configJson, err := clientSet.G2config.Save(ctx, configHandle)
+	testError(test, err)
+	testError(test, clientSet.G2config.Close(ctx, configHandle))
+	configID, err := clientSet.G2configmgr.AddConfig(ctx, configJson, "Add "+dataSourceCode)
+	testError(test, err)
+	testError(test, clientSet.G2configmgr.SetDefaultConfigID(ctx, configID))
+	return configID
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	testServer = NewServer()
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	testServer.Close()
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestServer_Config(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	templateConfigID, err := clientSet.G2configmgr.GetDefaultConfigID(ctx)
+	testError(test, err)
+	configID := addDataSource(ctx, test, clientSet, "customers")
+	assert.NotEqual(test, templateConfigID, configID)
+
+	configJson, err := clientSet.G2configmgr.GetConfig(ctx, configID)
+	testError(test, err)
+	configHandle, err := clientSet.G2config.Create(ctx)
+	testError(test, err)
+	testError(test, clientSet.G2config.Load(ctx, configHandle, configJson))
+	dataSources, err := clientSet.G2config.ListDataSources(ctx, configHandle)
+	testError(test, err)
+	assert.Equal(test, `{"DATA_SOURCES":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH"},{"DSRC_ID":1001,"DSRC_CODE":"CUSTOMERS"}]}`, dataSources)
+	_, err = clientSet.G2config.AddDataSource(ctx, configHandle, `{"DSRC_CODE": "CUSTOMERS"}`)
+	assert.True(test, g2error.Is(err, g2error.G2Configuration), err)
+	testError(test, clientSet.G2config.Close(ctx, configHandle))
+
+	configList, err := clientSet.G2configmgr.GetConfigList(ctx)
+	testError(test, err)
+	assert.Contains(test, configList, `"CONFIG_COMMENTS":"Add customers"`)
+}
+
+func TestServer_ReplaceDefaultConfigID(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	oldConfigID, err := clientSet.G2configmgr.GetDefaultConfigID(ctx)
+	testError(test, err)
+	newConfigID := addDataSource(ctx, test, clientSet, "CUSTOMERS")
+	err = clientSet.G2configmgr.ReplaceDefaultConfigID(ctx, oldConfigID, newConfigID)
+	assert.True(test, g2error.Is(err, g2error.G2Retryable), err)
+	err = clientSet.G2configmgr.ReplaceDefaultConfigID(ctx, newConfigID, oldConfigID)
+	testError(test, err)
+	err = clientSet.G2configmgr.SetDefaultConfigID(ctx, 1)
+	assert.True(test, g2error.Is(err, g2error.G2Configuration), err)
+}
+
+func TestServer_Records(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	err := clientSet.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, "")
+	assert.True(test, g2error.Is(err, g2error.G2MissingDataSource), err)
+
+	configID := addDataSource(ctx, test, clientSet, "CUSTOMERS")
+	err = clientSet.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith"}`, "")
+	testError(test, err)
+	activeConfigID, err := clientSet.G2engine.GetActiveConfigID(ctx)
+	testError(test, err)
+	assert.Equal(test, configID, activeConfigID)
+	err = clientSet.G2engine.AddRecord(ctx, "CUSTOMERS", "1002", `{"RECORD_ID": "1003"}`, "")
+	assert.True(test, g2error.Is(err, g2error.G2BadUserInput), err)
+
+	actual, err := clientSet.G2engine.GetEntityByRecordID_V2(ctx, "CUSTOMERS", "1001", g2flags.New(g2flags.EntityIncludeEntityName).Int64())
+	testError(test, err)
+	assert.Equal(test, `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"Robert Smith"}}`, actual)
+	actual, err = clientSet.G2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	testError(test, err)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","JSON_DATA":{"DATA_SOURCE":"CUSTOMERS","NAME_FULL":"Robert Smith","RECORD_ID":"1001"}}`, actual)
+
+	actual, err = clientSet.G2engine.DeleteRecordWithInfo(ctx, "CUSTOMERS", "1001", "", 0)
+	testError(test, err)
+	assert.Equal(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001","AFFECTED_ENTITIES":[{"ENTITY_ID":1}],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`, actual)
+	_, err = clientSet.G2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	assert.True(test, g2error.Is(err, g2error.G2NotFound), err)
+	_, err = clientSet.G2engine.GetEntityByEntityID(ctx, 1)
+	assert.True(test, g2error.Is(err, g2error.G2NotFound), err)
+}
+
+func TestServer_SearchByAttributes(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{"NAME_LAST": "Smith", "DATE_OF_BIRTH": "1985-04-05"}`, ""))
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "2", `{"NAME_LAST": "Jones"}`, ""))
+	actual, err := clientSet.G2engine.SearchByAttributes(ctx, `{"NAME_LAST": "SMITH", "DATE_OF_BIRTH": "1985-04-05"}`)
+	testError(test, err)
+	result := struct {
+		ResolvedEntities []searchResultDocument `json:"RESOLVED_ENTITIES"`
+	}{}
+	testError(test, json.Unmarshal([]byte(actual), &result))
+	assert.Len(test, result.ResolvedEntities, 1)
+	assert.Equal(test, "+DATE_OF_BIRTH+NAME_LAST", result.ResolvedEntities[0].MatchInfo.MatchKey)
+	assert.Equal(test, int64(1), result.ResolvedEntities[0].Entity.ResolvedEntity.EntityID)
+}
+
+func TestServer_ExportJSONEntityReport(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, ""))
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "2", `{"NAME_FULL": "Mary Jones"}`, ""))
+	responseHandle, err := clientSet.G2engine.ExportJSONEntityReport(ctx, 0)
+	testError(test, err)
+	lines := []string{}
+	for {
+		line, err := clientSet.G2engine.FetchNext(ctx, responseHandle)
+		testError(test, err)
+		if len(line) == 0 {
+			break
+		}
+		lines = append(lines, line)
+	}
+	testError(test, clientSet.G2engine.CloseExport(ctx, responseHandle))
+	assert.Equal(test, []string{
+		`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}` + "\n",
+		`{"RESOLVED_ENTITY":{"ENTITY_ID":2}}` + "\n",
+	}, lines)
+}
+
+func TestServer_RedoRecords(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	testError(test, clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, ""))
+	redoRecord := `{"DATA_SOURCE": "TEST", "RECORD_ID": "1"}`
+	testServer.AddRedoRecord(redoRecord)
+	count, err := clientSet.G2engine.CountRedoRecords(ctx)
+	testError(test, err)
+	assert.Equal(test, int64(1), count)
+	actual, withInfo, err := clientSet.G2engine.ProcessRedoRecordWithInfo(ctx, 0)
+	testError(test, err)
+	assert.Equal(test, redoRecord, actual)
+	assert.Contains(test, withInfo, `"AFFECTED_ENTITIES":[{"ENTITY_ID":1}]`)
+	actual, err = clientSet.G2engine.GetRedoRecord(ctx)
+	testError(test, err)
+	assert.Equal(test, "", actual)
+}
+
+func TestServer_Lifecycle(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	err := clientSet.G2engine.Init(ctx, "Test module name", "{}", 0)
+	assert.True(test, g2error.Is(err, g2error.G2NotInitialized), err)
+	err = clientSet.G2engine.Destroy(ctx)
+	assert.Contains(test, err.Error(), "senzing-60144001")
+}
+
+func TestServer_Reset(test *testing.T) {
+	ctx := context.TODO()
+	clientSet := getTestObject(ctx, test)
+	defer clientSet.Close()
+	addDataSource(ctx, test, clientSet, "CUSTOMERS")
+	testError(test, clientSet.G2engine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, ""))
+	testServer.Reset()
+	_, err := clientSet.G2engine.GetRecord(ctx, "CUSTOMERS", "1001")
+	assert.True(test, g2error.Is(err, g2error.G2NotFound), err)
+	configList, err := clientSet.G2configmgr.GetConfigList(ctx)
+	testError(test, err)
+	assert.NotContains(test, configList, "CUSTOMERS")
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleServer_Dial() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/grpctest/grpctest_test.go
+	ctx := context.TODO()
+	server := NewServer()
+	defer server.Close()
+	grpcConnection, err := server.Dial(ctx)
+	if err != nil {
+		fmt.Println(err)
+	}
+	clientSet := g2client.NewClientSetFromConnection(grpcConnection)
+	defer clientSet.Close()
+	err = clientSet.G2engine.AddRecord(ctx, "TEST", "1", `{"NAME_FULL": "Robert Smith"}`, "")
+	if err != nil {
+		fmt.Println(err)
+	}
+	result, err := clientSet.G2engine.GetEntityByRecordID(ctx, "TEST", "1")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(result)
+	// Output: {"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"Robert Smith","RECORD_SUMMARY":[{"DATA_SOURCE":"TEST","RECORD_COUNT":1}],"RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"1","ENTITY_TYPE":"TEST","INTERNAL_ID":1,"ENTITY_KEY":"1","ENTITY_DESC":"Robert Smith"}]},"RELATED_ENTITIES":[]}
+}
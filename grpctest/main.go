@@ -0,0 +1,57 @@
+package grpctest
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The environment variable that makes the test suites of this repository use the fake server.
+const EnvironmentVariable = "SENZING_TOOLS_GRPC_TEST_FAKE"
+
+// Identify the services in error message IDs, e.g. "senzing-60144002".
+const (
+	g2configProductId     = 6011
+	g2configmgrProductId  = 6012
+	g2diagnosticProductId = 6013
+	g2engineProductId     = 6014
+	g2productProductId    = 6016
+)
+
+// Message numbers of errors, appended to the product ID.
+const (
+	destroyMessageNumber          = 4001
+	initMessageNumber             = 4002
+	initWithConfigIDMessageNumber = 4003
+	callMessageNumber             = 4999
+)
+
+const (
+	// The Senzing API version reported by G2Engine.Stats().
+	apiVersion = "3.4.0.23012"
+
+	// The memory reported by G2Diagnostic.GetAvailableMemory() and GetTotalSystemMemory().
+	availableMemory   = 8 * 1024 * 1024 * 1024
+	totalSystemMemory = 16 * 1024 * 1024 * 1024
+
+	bufferSize = 1024 * 1024
+
+	// The database reported by G2Diagnostic.GetDBInfo().
+	dbInfoJson = `{"Hybrid Mode":false,"Database Details":[{"Name":"grpctest","Type":"in-memory"}]}`
+
+	// The columns of G2Engine.ExportCSVEntityReport() when the caller does not choose.
+	defaultCsvColumnList = "RESOLVED_ENTITY_ID,RELATED_ENTITY_ID,MATCH_LEVEL,MATCH_KEY,DATA_SOURCE,RECORD_ID"
+
+	// The first ID given to a data source added to a configuration; lower IDs are Senzing's own.
+	firstDataSourceId = 1001
+
+	// The license reported by G2Product.License().
+	licenseJson = `{"customer":"Senzing Public Test License","contract":"EVALUATION - support@senzing.com","issueDate":"2022-11-29","licenseType":"EVAL (Solutions)","licenseLevel":"STANDARD","billing":"MONTHLY","expireDate":"2023-11-29","recordLimit":50000}`
+
+	// The version reported by G2Product.Version().
+	versionJson = `{"PRODUCT_NAME":"Senzing API","VERSION":"3.4.0","BUILD_VERSION":"3.4.0.23012","BUILD_DATE":"2023-01-12","BUILD_NUMBER":"2023_01_12__10_52","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"},"SCHEMA_VERSION":{"ENGINE_SCHEMA_VERSION":"3.4","MINIMUM_REQUIRED_SCHEMA_VERSION":"3.0","MAXIMUM_REQUIRED_SCHEMA_VERSION":"3.99"}}`
+
+	// The configuration returned by G2Config.Create().
+	templateConfigJson = `{"G2_CONFIG":{"CFG_ATTR":[{"ATTR_ID":1001,"ATTR_CODE":"DATA_SOURCE","ATTR_CLASS":"OBSERVATION","FTYPE_CODE":null,"FELEM_CODE":null,"FELEM_REQ":"Yes","DEFAULT_VALUE":null,"ADVANCED":"Yes","INTERNAL":"No"},{"ATTR_ID":1003,"ATTR_CODE":"RECORD_ID","ATTR_CLASS":"OBSERVATION","FTYPE_CODE":null,"FELEM_CODE":null,"FELEM_REQ":"No","DEFAULT_VALUE":null,"ADVANCED":"No","INTERNAL":"No"}],"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST","DSRC_DESC":"Test","DSRC_RELY":1,"RETENTION_LEVEL":"Remember","CONVERSATIONAL":"No"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH","DSRC_DESC":"Search","DSRC_RELY":1,"RETENTION_LEVEL":"Forget","CONVERSATIONAL":"No"}],"CONFIG_BASE_VERSION":{"VERSION":"3.4.0","BUILD_VERSION":"3.4.0.23012","BUILD_DATE":"2023-01-12","BUILD_NUMBER":"2023_01_12__10_52","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"}}}}`
+
+	// The comment on the configuration every Server starts with.
+	templateConfigComments = "Default configuration of grpctest.Server"
+)
@@ -0,0 +1,275 @@
+package grpctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A configuration held by the configuration manager.
+type storedConfig struct {
+	comments   string
+	configJson string
+	createdAt  time.Time
+	document   *configDocument
+}
+
+// The contents of "G2_CONFIG". Numbers are kept as json.Number so they round-trip unchanged.
+type configDocument struct {
+	contents map[string]interface{}
+}
+
+// A data source of a configuration, in "CFG_DSRC".
+type dataSource struct {
+	code string
+	id   int64
+}
+
+// Identifies a record.
+type recordKey struct {
+	dataSourceCode string
+	recordID       string
+}
+
+// A record of the repository. Every record is an entity of its own.
+type storedRecord struct {
+	entityID int64
+	jsonData map[string]interface{}
+	key      recordKey
+	loadID   string
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Decode JSON, keeping numbers as json.Number.
+func decodeJson(jsonString string, target interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(jsonString))
+	decoder.UseNumber()
+	return decoder.Decode(target)
+}
+
+// Encode JSON without escaping "<", ">" and "&", as Senzing does.
+func encodeJson(value interface{}) string {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func newRecordKey(dataSourceCode string, recordID string) recordKey {
+	return recordKey{dataSourceCode: strings.ToUpper(dataSourceCode), recordID: recordID}
+}
+
+func parseConfig(configJson string) (*configDocument, error) {
+	document := map[string]interface{}{}
+	if err := decodeJson(configJson, &document); err != nil {
+		return nil, err
+	}
+	contents, ok := document["G2_CONFIG"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("missing G2_CONFIG")
+	}
+	return &configDocument{contents: contents}, nil
+}
+
+// The "DSRC_CODE" of a data source document like {"DSRC_CODE": "CUSTOMERS"}.
+func parseDataSourceCode(inputJson string) (string, error) {
+	input := struct {
+		DsrcCode string `json:"DSRC_CODE"`
+	}{}
+	if err := json.Unmarshal([]byte(inputJson), &input); err != nil {
+		return "", err
+	}
+	if input.DsrcCode == "" {
+		return "", fmt.Errorf("missing DSRC_CODE")
+	}
+	return strings.ToUpper(input.DsrcCode), nil
+}
+
+// ----------------------------------------------------------------------------
+// configDocument methods
+// ----------------------------------------------------------------------------
+
+func (document *configDocument) json() string {
+	return encodeJson(map[string]interface{}{"G2_CONFIG": document.contents})
+}
+
+func (document *configDocument) dataSourceEntries() []interface{} {
+	entries, _ := document.contents["CFG_DSRC"].([]interface{})
+	return entries
+}
+
+func (document *configDocument) dataSources() []dataSource {
+	result := []dataSource{}
+	for _, entry := range document.dataSourceEntries() {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		code, _ := fields["DSRC_CODE"].(string)
+		number, _ := fields["DSRC_ID"].(json.Number)
+		id, _ := number.Int64()
+		result = append(result, dataSource{code: code, id: id})
+	}
+	return result
+}
+
+func (document *configDocument) hasDataSource(code string) bool {
+	for _, dataSource := range document.dataSources() {
+		if dataSource.code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Add a data source, returning its ID. Adding an existing data source returns the existing ID.
+func (document *configDocument) addDataSource(code string) int64 {
+	var maxId int64 = firstDataSourceId - 1
+	for _, dataSource := range document.dataSources() {
+		if dataSource.code == code {
+			return dataSource.id
+		}
+		if dataSource.id > maxId {
+			maxId = dataSource.id
+		}
+	}
+	id := maxId + 1
+	document.contents["CFG_DSRC"] = append(document.dataSourceEntries(), map[string]interface{}{
+		"DSRC_ID":         json.Number(fmt.Sprint(id)),
+		"DSRC_CODE":       code,
+		"DSRC_DESC":       code,
+		"DSRC_RELY":       json.Number("1"),
+		"RETENTION_LEVEL": "Remember",
+		"CONVERSATIONAL":  "No",
+	})
+	return id
+}
+
+// Delete a data source. Deleting a missing data source does nothing.
+func (document *configDocument) deleteDataSource(code string) {
+	entries := []interface{}{}
+	for _, entry := range document.dataSourceEntries() {
+		if fields, ok := entry.(map[string]interface{}); ok && fields["DSRC_CODE"] == code {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	document.contents["CFG_DSRC"] = entries
+}
+
+// ----------------------------------------------------------------------------
+// Server methods for configurations; the caller holds the lock.
+// ----------------------------------------------------------------------------
+
+// Store a configuration, returning its ID. Like Senzing, the ID is derived from the contents.
+func (server *Server) addConfig(configJson string, comments string) int64 {
+	configID := int64(crc32.ChecksumIEEE([]byte(configJson)))
+	if _, ok := server.configs[configID]; ok {
+		return configID
+	}
+	document, _ := parseConfig(configJson)
+	server.configs[configID] = &storedConfig{
+		comments:   comments,
+		configJson: configJson,
+		createdAt:  time.Now().UTC(),
+		document:   document,
+	}
+	server.configIDs = append(server.configIDs, configID)
+	return configID
+}
+
+func (server *Server) activeConfig() *storedConfig {
+	return server.configs[server.activeConfigID]
+}
+
+// Make a configuration active, as Reinit() does.
+func (server *Server) reinit(productId int, configID int64) error {
+	if _, ok := server.configs[configID]; !ok {
+		return newCallError(productId, 7221, "No configuration found for CONFIG_ID: %d", configID)
+	}
+	server.activeConfigID = configID
+	return nil
+}
+
+// Report whether the active configuration has a data source.
+// Like Senzing, an unknown data source makes the engine adopt a newer default configuration first.
+func (server *Server) knowsDataSource(code string) bool {
+	if server.activeConfig().document.hasDataSource(code) {
+		return true
+	}
+	if server.defaultConfigID != server.activeConfigID {
+		server.activeConfigID = server.defaultConfigID
+	}
+	return server.activeConfig().document.hasDataSource(code)
+}
+
+// ----------------------------------------------------------------------------
+// Server methods for records; the caller holds the lock.
+// ----------------------------------------------------------------------------
+
+// Add or replace a record. The caller validates it.
+func (server *Server) putRecord(key recordKey, jsonData map[string]interface{}, loadID string) *storedRecord {
+	record, ok := server.records[key]
+	if !ok {
+		record = &storedRecord{key: key, entityID: server.nextEntityID}
+		server.nextEntityID++
+		server.records[key] = record
+		server.entities[record.entityID] = key
+	}
+	record.jsonData = jsonData
+	record.loadID = loadID
+	server.lastModified = time.Now()
+	return record
+}
+
+// Delete a record, returning its entity ID, or 0 if there was no such record.
+func (server *Server) deleteRecord(key recordKey) int64 {
+	record, ok := server.records[key]
+	if !ok {
+		return 0
+	}
+	delete(server.records, key)
+	delete(server.entities, record.entityID)
+	server.lastModified = time.Now()
+	return record.entityID
+}
+
+func (server *Server) recordByEntityID(entityID int64) (*storedRecord, bool) {
+	key, ok := server.entities[entityID]
+	if !ok {
+		return nil, false
+	}
+	return server.records[key], true
+}
+
+// Records in entity ID order, i.e. the order they were first added.
+func (server *Server) sortedRecords() []*storedRecord {
+	result := make([]*storedRecord, 0, len(server.records))
+	for _, record := range server.records {
+		result = append(result, record)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].entityID < result[j].entityID })
+	return result
+}
+
+// Empty the repository. Configurations are kept, as they are by Senzing.
+func (server *Server) purge() {
+	server.entities = map[int64]recordKey{}
+	server.entityLists = map[string]*lineIterator{}
+	server.exports = map[int64]*lineIterator{}
+	server.records = map[recordKey]*storedRecord{}
+	server.redoRecords = nil
+	server.lastModified = time.Now()
+}
@@ -0,0 +1,51 @@
+/*
+ *
+ */
+
+// Package detached stands in for context.WithoutCancel(), which Go 1.20 does not have.
+package detached
+
+import (
+	"context"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A context keeping the values of its parent but never cancelled.
+type detachedContext struct {
+	context.Context
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (ctx detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (ctx detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (ctx detachedContext) Err() error {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The WithoutCancel function returns a context carrying the values of parent but neither its deadline nor its cancellation.
+It behaves like context.WithoutCancel() of Go 1.21, which replaces it once the module requires that version.
+
+Input
+  - parent: The context whose values are kept.
+*/
+func WithoutCancel(parent context.Context) context.Context {
+	return detachedContext{parent}
+}
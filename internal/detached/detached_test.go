@@ -0,0 +1,28 @@
+package detached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The key of the value set in the tests.
+type testKey struct{}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWithoutCancel(test *testing.T) {
+	parent, cancel := context.WithTimeout(context.WithValue(context.Background(), testKey{}, "value"), time.Minute)
+	ctx := WithoutCancel(parent)
+	cancel()
+	assert.Error(test, parent.Err())
+	assert.Nil(test, ctx.Err())
+	assert.Nil(test, ctx.Done())
+	_, hasDeadline := ctx.Deadline()
+	assert.False(test, hasDeadline)
+	assert.Equal(test, "value", ctx.Value(testKey{}))
+}
@@ -0,0 +1,10 @@
+/*
+The loader package loads many records into Senzing through G2engine, using a bounded number of concurrent calls.
+
+Records are read from a JSON-lines io.Reader or from a channel.
+Each record is sent with AddRecord(), AddRecordWithInfo() or ReplaceRecord(), depending on the Mode.
+Records that fail are collected in a Report rather than stopping the load.
+The Report's Checkpoint is the last line for which every earlier line has been acknowledged;
+passing it to LoadReader() resumes an interrupted load without skipping or repeating work.
+*/
+package loader
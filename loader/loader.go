@@ -0,0 +1,348 @@
+/*
+ *
+ */
+
+// Package loader loads records into Senzing with bounded concurrency.
+package loader
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Loader sends records to G2engine. The zero value of each optional field is usable.
+type Loader struct {
+	Concurrency     int                                  // Number of concurrent calls. Defaults to DefaultConcurrency.
+	Flags           int64                                // Flags for ModeAddRecordWithInfo.
+	G2engine        g2api.G2engine                       // Required.
+	LoadID          string                               // Load identifier passed to G2engine.
+	Mode            Mode                                 // G2engine method used for each record.
+	OnCheckpoint    func(lineNumber int64)               // Optional. Called, serially, each time the Checkpoint advances.
+	WithInfoHandler func(record Record, withInfo string) // Optional. Receives the result of ModeAddRecordWithInfo.
+	failed          int64
+	processed       int64
+	startTime       int64 // UnixNano
+	succeeded       int64
+}
+
+// Record is one record to load.
+type Record struct {
+	DataSourceCode string // If empty, taken from the DATA_SOURCE of JsonData.
+	JsonData       string // The Senzing JSON record.
+	LineNumber     int64  // Position in the input. If 0, records are numbered in the order received.
+	RecordID       string // If empty, taken from the RECORD_ID of JsonData.
+}
+
+// Failure describes a record that could not be loaded.
+type Failure struct {
+	DataSourceCode string `json:"dataSourceCode,omitempty"`
+	Error          error  `json:"-"`
+	LineNumber     int64  `json:"lineNumber"`
+	RecordID       string `json:"recordID,omitempty"`
+}
+
+// Metrics is a snapshot of the throughput of a load.
+type Metrics struct {
+	Elapsed          time.Duration
+	Failed           int64
+	Processed        int64
+	RecordsPerSecond float64
+	Succeeded        int64
+}
+
+// Report is the outcome of a load.
+type Report struct {
+	Checkpoint int64     // Last line for which it, and all lines before it, were acknowledged.
+	Failures   []Failure // Records that were rejected, in the order they failed.
+	Metrics    Metrics
+}
+
+// A record with its position in dispatch order.
+type sequencedRecord struct {
+	record   Record
+	sequence int64
+}
+
+// Tracks the highest line number below which every record has been acknowledged.
+type checkpointTracker struct {
+	acknowledged map[int64]int64 // sequence -> line number
+	checkpoint   int64
+	lock         sync.Mutex
+	nextSequence int64
+	onCheckpoint func(lineNumber int64)
+}
+
+// Fields of a Senzing JSON record used to identify it.
+type recordKeys struct {
+	DataSource string `json:"DATA_SOURCE"`
+	RecordID   string `json:"RECORD_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (tracker *checkpointTracker) acknowledge(sequence int64, lineNumber int64) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	tracker.acknowledged[sequence] = lineNumber
+	advanced := false
+	for {
+		lineNumber, ok := tracker.acknowledged[tracker.nextSequence]
+		if !ok {
+			break
+		}
+		delete(tracker.acknowledged, tracker.nextSequence)
+		tracker.checkpoint = lineNumber
+		tracker.nextSequence++
+		advanced = true
+	}
+	if advanced && tracker.onCheckpoint != nil {
+		tracker.onCheckpoint(tracker.checkpoint)
+	}
+}
+
+func (tracker *checkpointTracker) getCheckpoint() int64 {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	return tracker.checkpoint
+}
+
+// Send one record to G2engine, first filling in its keys from the JSON if needed.
+func (loader *Loader) loadRecord(ctx context.Context, record *Record) error {
+	if record.DataSourceCode == "" || record.RecordID == "" {
+		keys := recordKeys{}
+		if err := json.Unmarshal([]byte(record.JsonData), &keys); err != nil {
+			return fmt.Errorf("line %d is not a JSON record: %w", record.LineNumber, err)
+		}
+		if record.DataSourceCode == "" {
+			record.DataSourceCode = keys.DataSource
+		}
+		if record.RecordID == "" {
+			record.RecordID = keys.RecordID
+		}
+	}
+	switch loader.Mode {
+	case ModeAddRecordWithInfo:
+		withInfo, err := loader.G2engine.AddRecordWithInfo(ctx, record.DataSourceCode, record.RecordID, record.JsonData, loader.LoadID, loader.Flags)
+		if err == nil && loader.WithInfoHandler != nil {
+			loader.WithInfoHandler(*record, withInfo)
+		}
+		return err
+	case ModeReplaceRecord:
+		return loader.G2engine.ReplaceRecord(ctx, record.DataSourceCode, record.RecordID, record.JsonData, loader.LoadID)
+	default:
+		return loader.G2engine.AddRecord(ctx, record.DataSourceCode, record.RecordID, record.JsonData, loader.LoadID)
+	}
+}
+
+// Fan records out to the workers and collect the results.
+func (loader *Loader) load(ctx context.Context, records <-chan Record, checkpoint int64) (*Report, error) {
+	if loader.G2engine == nil {
+		return nil, errors.New("Loader.G2engine is required")
+	}
+	concurrency := loader.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	atomic.StoreInt64(&loader.failed, 0)
+	atomic.StoreInt64(&loader.processed, 0)
+	atomic.StoreInt64(&loader.succeeded, 0)
+	atomic.StoreInt64(&loader.startTime, time.Now().UnixNano())
+	tracker := &checkpointTracker{
+		acknowledged: map[int64]int64{},
+		checkpoint:   checkpoint,
+		onCheckpoint: loader.OnCheckpoint,
+	}
+	report := &Report{}
+	var reportLock sync.Mutex
+	work := make(chan sequencedRecord)
+	var waitGroup sync.WaitGroup
+
+	// Workers.
+
+	for i := 0; i < concurrency; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for item := range work {
+				if len(item.record.JsonData) == 0 {
+					tracker.acknowledge(item.sequence, item.record.LineNumber)
+					continue
+				}
+				err := loader.loadRecord(ctx, &item.record)
+				if err != nil && ctx.Err() != nil {
+					continue // Interrupted, not rejected; leave it unacknowledged so a resumed load retries it.
+				}
+				atomic.AddInt64(&loader.processed, 1)
+				if err != nil {
+					atomic.AddInt64(&loader.failed, 1)
+					reportLock.Lock()
+					report.Failures = append(report.Failures, Failure{
+						DataSourceCode: item.record.DataSourceCode,
+						Error:          err,
+						LineNumber:     item.record.LineNumber,
+						RecordID:       item.record.RecordID,
+					})
+					reportLock.Unlock()
+				} else {
+					atomic.AddInt64(&loader.succeeded, 1)
+				}
+				tracker.acknowledge(item.sequence, item.record.LineNumber)
+			}
+		}()
+	}
+
+	// Dispatcher.
+
+	var sequence int64 = 0
+	lineNumber := checkpoint
+dispatch:
+	for {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case record, ok := <-records:
+			if !ok {
+				break dispatch
+			}
+			if record.LineNumber == 0 {
+				record.LineNumber = lineNumber + 1
+			}
+			lineNumber = record.LineNumber
+			select {
+			case work <- sequencedRecord{record: record, sequence: sequence}:
+				sequence++
+			case <-ctx.Done():
+				break dispatch
+			}
+		}
+	}
+	close(work)
+	waitGroup.Wait()
+	report.Checkpoint = tracker.getCheckpoint()
+	report.Metrics = loader.Metrics()
+	return report, ctx.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The LoadChannel method loads records received from a channel until it is closed or ctx is cancelled.
+
+Input
+  - ctx: A context to control lifecycle. Cancelling it stops dispatching new records.
+  - records: The records to load.
+
+Output
+  - A Report of failures, throughput and the checkpoint reached.
+  - ctx.Err() if the load was cancelled. Record failures are reported only in the Report.
+*/
+func (loader *Loader) LoadChannel(ctx context.Context, records <-chan Record) (*Report, error) {
+	return loader.load(ctx, records, 0)
+}
+
+/*
+The LoadReader method loads JSON-lines records from a reader.
+Blank lines are skipped. Lines up to and including checkpoint are skipped, to resume an earlier load.
+
+Input
+  - ctx: A context to control lifecycle. Cancelling it stops dispatching new records.
+  - reader: JSON lines, one Senzing record per line.
+  - checkpoint: The Report.Checkpoint of an earlier, interrupted load, or 0.
+
+Output
+  - A Report of failures, throughput and the checkpoint reached.
+  - ctx.Err() if the load was cancelled, or the error reading input.
+*/
+func (loader *Loader) LoadReader(ctx context.Context, reader io.Reader, checkpoint int64) (*Report, error) {
+	readCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	records := make(chan Record)
+	var readErr error
+	go func() {
+		defer close(records)
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 0, 64*1024), MaxLineSize)
+		var lineNumber int64 = 0
+		for scanner.Scan() {
+			lineNumber++
+			if lineNumber <= checkpoint {
+				continue
+			}
+			select {
+			case records <- Record{JsonData: scanner.Text(), LineNumber: lineNumber}:
+			case <-readCtx.Done():
+				return
+			}
+		}
+		readErr = scanner.Err()
+	}()
+	report, err := loader.load(ctx, records, checkpoint)
+	cancel()
+	for range records {
+		// Wait for the reader goroutine to finish before reading readErr.
+	}
+	if err == nil {
+		err = readErr
+	}
+	return report, err
+}
+
+/*
+The Metrics method returns the throughput of the current, or most recent, load.
+It is safe to call while a load is running.
+*/
+func (loader *Loader) Metrics() Metrics {
+	result := Metrics{
+		Failed:    atomic.LoadInt64(&loader.failed),
+		Processed: atomic.LoadInt64(&loader.processed),
+		Succeeded: atomic.LoadInt64(&loader.succeeded),
+	}
+	startTime := atomic.LoadInt64(&loader.startTime)
+	if startTime > 0 {
+		result.Elapsed = time.Since(time.Unix(0, startTime))
+		if result.Elapsed > 0 {
+			result.RecordsPerSecond = float64(result.Processed) / result.Elapsed.Seconds()
+		}
+	}
+	return result
+}
+
+/*
+The WriteFailures method writes the failures as JSON lines, one per failed record.
+
+Input
+  - writer: Destination of the error report.
+*/
+func (report *Report) WriteFailures(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	for _, failure := range report.Failures {
+		line := struct {
+			Failure
+			Error string `json:"error"`
+		}{
+			Failure: failure,
+			Error:   failure.Error.Error(),
+		}
+		if err := encoder.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
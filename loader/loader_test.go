@@ -0,0 +1,242 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// A G2engine that records what it is asked to load.
+// Methods not overridden panic, because the embedded interface is nil.
+type fakeG2engine struct {
+	g2api.G2engine
+	block    chan struct{}
+	calls    []string
+	failIDs  map[string]bool
+	inFlight int
+	lock     sync.Mutex
+	maxDepth int
+}
+
+func (engine *fakeG2engine) call(ctx context.Context, method string, recordID string) error {
+	engine.lock.Lock()
+	engine.inFlight++
+	if engine.inFlight > engine.maxDepth {
+		engine.maxDepth = engine.inFlight
+	}
+	engine.lock.Unlock()
+	defer func() {
+		engine.lock.Lock()
+		engine.inFlight--
+		engine.lock.Unlock()
+	}()
+	if engine.block != nil && recordID == "BLOCK" {
+		select {
+		case <-engine.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	time.Sleep(time.Millisecond)
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+	engine.calls = append(engine.calls, method+":"+recordID)
+	if engine.failIDs[recordID] {
+		return errors.New("rejected " + recordID)
+	}
+	return nil
+}
+
+func (engine *fakeG2engine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	return engine.call(ctx, "AddRecord", recordID)
+}
+
+func (engine *fakeG2engine) AddRecordWithInfo(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string, flags int64) (string, error) {
+	return `{"DATA_SOURCE":"` + dataSourceCode + `","RECORD_ID":"` + recordID + `"}`, engine.call(ctx, "AddRecordWithInfo", recordID)
+}
+
+func (engine *fakeG2engine) ReplaceRecord(ctx context.Context, dataSourceCode string, recordID string, jsonData string, loadID string) error {
+	return engine.call(ctx, "ReplaceRecord", recordID)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func jsonLines(count int) string {
+	var buffer bytes.Buffer
+	for i := 1; i <= count; i++ {
+		fmt.Fprintf(&buffer, `{"DATA_SOURCE":"TEST","RECORD_ID":"%d","NAME_FULL":"Name %d"}`+"\n", i, i)
+	}
+	return buffer.String()
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLoader_LoadReader(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	loader := &Loader{
+		Concurrency: 4,
+		G2engine:    engine,
+	}
+	report, err := loader.LoadReader(ctx, strings.NewReader(jsonLines(100)), 0)
+	assert.Nil(test, err)
+	assert.Equal(test, int64(100), report.Checkpoint)
+	assert.Equal(test, int64(100), report.Metrics.Succeeded)
+	assert.Empty(test, report.Failures)
+	assert.Len(test, engine.calls, 100)
+	assert.LessOrEqual(test, engine.maxDepth, 4)
+	assert.Greater(test, engine.maxDepth, 1)
+}
+
+func TestLoader_LoadReader_Failures(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{failIDs: map[string]bool{"3": true, "7": true}}
+	loader := &Loader{G2engine: engine}
+	input := jsonLines(5) + "\nnot json\n" + `{"DATA_SOURCE":"TEST","RECORD_ID":"7"}` + "\n"
+	report, err := loader.LoadReader(ctx, strings.NewReader(input), 0)
+	assert.Nil(test, err)
+	assert.Equal(test, int64(8), report.Checkpoint)
+	assert.Equal(test, int64(7), report.Metrics.Processed)
+	assert.Equal(test, int64(3), report.Metrics.Failed)
+	assert.Len(test, report.Failures, 3)
+
+	var buffer bytes.Buffer
+	assert.Nil(test, report.WriteFailures(&buffer))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Len(test, lines, 3)
+	failures := map[int64]map[string]interface{}{}
+	for _, line := range lines {
+		failure := map[string]interface{}{}
+		assert.Nil(test, json.Unmarshal([]byte(line), &failure))
+		failures[int64(failure["lineNumber"].(float64))] = failure
+	}
+	assert.Equal(test, "3", failures[3]["recordID"])
+	assert.Equal(test, "TEST", failures[3]["dataSourceCode"])
+	assert.Contains(test, failures[7]["error"], "not a JSON record")
+	assert.Equal(test, "rejected 7", failures[8]["error"])
+}
+
+func TestLoader_LoadReader_Resume(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	loader := &Loader{G2engine: engine, Mode: ModeReplaceRecord}
+	report, err := loader.LoadReader(ctx, strings.NewReader(jsonLines(10)), 6)
+	assert.Nil(test, err)
+	assert.Equal(test, int64(10), report.Checkpoint)
+	assert.ElementsMatch(test, []string{"ReplaceRecord:7", "ReplaceRecord:8", "ReplaceRecord:9", "ReplaceRecord:10"}, engine.calls)
+}
+
+func TestLoader_LoadReader_Cancel(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	engine := &fakeG2engine{block: make(chan struct{})}
+	checkpoints := []int64{}
+	loader := &Loader{
+		Concurrency:  2,
+		G2engine:     engine,
+		OnCheckpoint: func(lineNumber int64) { checkpoints = append(checkpoints, lineNumber) },
+	}
+	input := jsonLines(3) + `{"DATA_SOURCE":"TEST","RECORD_ID":"BLOCK"}` + "\n" + jsonLines(50)
+	time.AfterFunc(50*time.Millisecond, cancel)
+	report, err := loader.LoadReader(ctx, strings.NewReader(input), 0)
+	assert.Equal(test, context.Canceled, err)
+
+	// Line 4 never completed, so the checkpoint cannot pass it.
+
+	assert.Equal(test, int64(3), report.Checkpoint)
+	assert.Equal(test, int64(3), checkpoints[len(checkpoints)-1])
+	assert.Empty(test, report.Failures)
+}
+
+func TestLoader_LoadChannel_WithInfo(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	var lock sync.Mutex
+	withInfos := map[string]string{}
+	loader := &Loader{
+		G2engine: engine,
+		Mode:     ModeAddRecordWithInfo,
+		WithInfoHandler: func(record Record, withInfo string) {
+			lock.Lock()
+			defer lock.Unlock()
+			withInfos[record.RecordID] = withInfo
+		},
+	}
+	records := make(chan Record)
+	go func() {
+		defer close(records)
+		records <- Record{DataSourceCode: "TEST", RecordID: "A", JsonData: `{"NAME_FULL":"A"}`}
+		records <- Record{DataSourceCode: "TEST", RecordID: "B", JsonData: `{"NAME_FULL":"B"}`}
+	}()
+	report, err := loader.LoadChannel(ctx, records)
+	assert.Nil(test, err)
+	assert.Equal(test, int64(2), report.Checkpoint)
+	assert.Equal(test, `{"DATA_SOURCE":"TEST","RECORD_ID":"B"}`, withInfos["B"])
+}
+
+func TestLoader_Metrics(test *testing.T) {
+	loader := &Loader{}
+	assert.Equal(test, Metrics{}, loader.Metrics())
+	_, err := loader.LoadReader(context.TODO(), strings.NewReader(""), 0)
+	assert.NotNil(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleLoader_LoadReader() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/loader/loader_test.go
+	ctx := context.TODO()
+	loader := &Loader{
+		Concurrency: 16,
+		G2engine:    &fakeG2engine{},
+	}
+	report, err := loader.LoadReader(ctx, strings.NewReader(jsonLines(3)), 0)
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(report.Checkpoint, report.Metrics.Succeeded)
+	// Output: 3 3
+}
@@ -0,0 +1,25 @@
+package loader
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Mode selects the G2engine method used to load each record.
+type Mode int
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Load modes.
+const (
+	ModeAddRecord Mode = iota
+	ModeAddRecordWithInfo
+	ModeReplaceRecord
+)
+
+// Number of concurrent calls used when Loader.Concurrency is not set.
+const DefaultConcurrency = 8
+
+// Maximum size, in bytes, of one line read by LoadReader().
+const MaxLineSize = 10 * 1024 * 1024
@@ -8,17 +8,8 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/senzing/g2-sdk-go-grpc/g2config"
-	"github.com/senzing/g2-sdk-go-grpc/g2configmgr"
-	"github.com/senzing/g2-sdk-go-grpc/g2diagnostic"
-	"github.com/senzing/g2-sdk-go-grpc/g2engine"
-	"github.com/senzing/g2-sdk-go-grpc/g2product"
+	"github.com/senzing/g2-sdk-go-grpc/g2client"
 	"github.com/senzing/g2-sdk-go/g2api"
-	g2configpb "github.com/senzing/g2-sdk-proto/go/g2config"
-	g2configmgrpb "github.com/senzing/g2-sdk-proto/go/g2configmgr"
-	g2diagnosticpb "github.com/senzing/g2-sdk-proto/go/g2diagnostic"
-	g2enginepb "github.com/senzing/g2-sdk-proto/go/g2engine"
-	g2productpb "github.com/senzing/g2-sdk-proto/go/g2product"
 	"github.com/senzing/go-common/truthset"
 	"github.com/senzing/go-logging/messageformat"
 	"github.com/senzing/go-logging/messageid"
@@ -27,8 +18,6 @@ import (
 	"github.com/senzing/go-logging/messagestatus"
 	"github.com/senzing/go-logging/messagetext"
 	"github.com/senzing/go-observing/observer"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // ----------------------------------------------------------------------------
@@ -58,70 +47,61 @@ var buildVersion string = "0.0.0"
 var buildIteration string = "0"
 
 var (
-	grpcAddress    = "localhost:8258"
-	grpcConnection *grpc.ClientConn
-	logger         messagelogger.MessageLoggerInterface
+	clientSet   *g2client.ClientSet
+	grpcAddress = "localhost:8258"
+	logger      messagelogger.MessageLoggerInterface
 )
 
 // ----------------------------------------------------------------------------
 // Internal methods
 // ----------------------------------------------------------------------------
 
-func getGrpcConnection() *grpc.ClientConn {
+func getClientSet(ctx context.Context) (*g2client.ClientSet, error) {
 	var err error = nil
-	if grpcConnection == nil {
-		grpcConnection, err = grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
-		if err != nil {
-			fmt.Printf("Did not connect: %v\n", err)
-		}
-		//		defer grpcConnection.Close()
+	if clientSet == nil {
+		clientSet, err = g2client.NewClientSet(ctx, g2client.WithAddress(grpcAddress))
 	}
-	return grpcConnection
+	return clientSet, err
 }
 
 func getG2config(ctx context.Context) (g2api.G2config, error) {
-	var err error = nil
-	grpcConnection := getGrpcConnection()
-	result := &g2config.G2config{
-		GrpcClient: g2configpb.NewG2ConfigClient(grpcConnection),
+	clientSet, err := getClientSet(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return clientSet.G2config, err
 }
 
 func getG2configmgr(ctx context.Context) (g2api.G2configmgr, error) {
-	var err error = nil
-	grpcConnection := getGrpcConnection()
-	result := &g2configmgr.G2configmgr{
-		GrpcClient: g2configmgrpb.NewG2ConfigMgrClient(grpcConnection),
+	clientSet, err := getClientSet(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return clientSet.G2configmgr, err
 }
 
 func getG2diagnostic(ctx context.Context) (g2api.G2diagnostic, error) {
-	var err error = nil
-	grpcConnection := getGrpcConnection()
-	result := &g2diagnostic.G2diagnostic{
-		GrpcClient: g2diagnosticpb.NewG2DiagnosticClient(grpcConnection),
+	clientSet, err := getClientSet(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return clientSet.G2diagnostic, err
 }
 
 func getG2engine(ctx context.Context) (g2api.G2engine, error) {
-	var err error = nil
-	grpcConnection := getGrpcConnection()
-	result := &g2engine.G2engine{
-		GrpcClient: g2enginepb.NewG2EngineClient(grpcConnection),
+	clientSet, err := getClientSet(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return clientSet.G2engine, err
 }
 
 func getG2product(ctx context.Context) (g2api.G2product, error) {
-	var err error = nil
-	grpcConnection := getGrpcConnection()
-	result := &g2product.G2product{
-		GrpcClient: g2productpb.NewG2ProductClient(grpcConnection),
+	clientSet, err := getClientSet(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return result, err
+	return clientSet.G2product, err
 }
 
 func getLogger(ctx context.Context) (messagelogger.MessageLoggerInterface, error) {
@@ -332,5 +312,10 @@ func main() {
 		failOnError(5015, err)
 	}
 
+	err = clientSet.Close()
+	if err != nil {
+		failOnError(5016, err)
+	}
+
 	fmt.Printf("\n-------------------------------------------------------------------------------\n\n")
 }
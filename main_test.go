@@ -1,12 +1,24 @@
 package main
 
 import (
+	"context"
 	"testing"
+
+	"github.com/senzing/g2-sdk-go-grpc/g2client"
+	"github.com/senzing/g2-sdk-go-grpc/grpctest"
 )
 
 /*
  * The unit tests in this file simulate command line invocation.
+ * With SENZING_TOOLS_GRPC_TEST_FAKE=true, they run against the grpctest fake server.
  */
 func TestMain(testing *testing.T) {
+	if grpctest.Enabled() {
+		connection, err := grpctest.Dial(context.TODO())
+		if err != nil {
+			testing.Fatal(err)
+		}
+		clientSet = g2client.NewClientSetFromConnection(connection)
+	}
 	main()
 }
@@ -0,0 +1,11 @@
+/*
+The redo package drains the Senzing redo queue through G2engine.
+
+A Processor runs a fixed number of workers, each calling ProcessRedoRecord() (or ProcessRedoRecordWithInfo()
+when a WithInfoHandler is set) in a loop.
+When the queue is empty, workers poll at an interval that grows up to a maximum and resets as soon as work reappears.
+Cancelling the context passed to Run() stops workers from taking new redo records;
+records already being processed are allowed to finish, for up to ShutdownTimeout.
+Registered observers are periodically told the depth of the queue, as reported by CountRedoRecords().
+*/
+package redo
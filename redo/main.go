@@ -0,0 +1,19 @@
+package redo
+
+import "time"
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the redo package found messages having the format "senzing-6028xxxx".
+const ProductId = 6028
+
+// Default values used when the corresponding Processor field is not set.
+const (
+	DefaultIdleInterval       = 100 * time.Millisecond
+	DefaultMaxIdleInterval    = 10 * time.Second
+	DefaultQueueDepthInterval = 30 * time.Second
+	DefaultShutdownTimeout    = time.Minute
+	DefaultWorkers            = 4
+)
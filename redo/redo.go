@@ -0,0 +1,264 @@
+/*
+ *
+ */
+
+// Package redo processes the Senzing redo queue with a pool of workers.
+package redo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/senzing/g2-sdk-go-grpc/internal/detached"
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/senzing/go-observing/observer"
+	"github.com/senzing/go-observing/subject"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Processor drains the redo queue. The zero value of each optional field is usable.
+type Processor struct {
+	ErrorHandler       func(err error)                          // Optional. Receives each failed call; the worker then waits as if the queue were empty.
+	Flags              int64                                    // Flags for ProcessRedoRecordWithInfo().
+	G2engine           g2api.G2engine                           // Required.
+	IdleInterval       time.Duration                            // First wait after finding the queue empty. Defaults to DefaultIdleInterval.
+	MaxIdleInterval    time.Duration                            // Longest wait while the queue stays empty. Defaults to DefaultMaxIdleInterval.
+	QueueDepthInterval time.Duration                            // Time between queue depth notifications. Defaults to DefaultQueueDepthInterval.
+	ShutdownTimeout    time.Duration                            // Longest time one redo record is processed, so it bounds shutdown. Defaults to DefaultShutdownTimeout.
+	WithInfoHandler    func(redoRecord string, withInfo string) // Optional. If set, ProcessRedoRecordWithInfo() is used and its result forwarded here.
+	Workers            int                                      // Number of concurrent workers. Defaults to DefaultWorkers.
+	failed             int64
+	lock               sync.Mutex
+	observers          subject.Subject
+	processed          int64
+}
+
+// Stats counts the redo records handled since Run() was called.
+type Stats struct {
+	Failed    int64
+	Processed int64
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+// Notify registered observers.
+func (processor *Processor) notify(ctx context.Context, messageId int, err error, details map[string]string) {
+	observers := processor.getObservers()
+	if observers == nil {
+		return
+	}
+	now := time.Now()
+	details["subjectId"] = strconv.Itoa(ProductId)
+	details["messageId"] = strconv.Itoa(messageId)
+	details["messageTime"] = strconv.FormatInt(now.UnixNano(), 10)
+	if err != nil {
+		details["error"] = err.Error()
+	}
+	message, err := json.Marshal(details)
+	if err != nil {
+		fmt.Printf("Error: %s", err.Error())
+	} else {
+		observers.NotifyObservers(ctx, string(message))
+	}
+}
+
+func (processor *Processor) getObservers() subject.Subject {
+	processor.lock.Lock()
+	defer processor.lock.Unlock()
+	return processor.observers
+}
+
+// Process one redo record. An empty redoRecord means the queue was empty.
+func (processor *Processor) processRedoRecord(ctx context.Context) (string, error) {
+	if processor.WithInfoHandler == nil {
+		return processor.G2engine.ProcessRedoRecord(ctx)
+	}
+	redoRecord, withInfo, err := processor.G2engine.ProcessRedoRecordWithInfo(ctx, processor.Flags)
+	if err == nil && len(redoRecord) > 0 {
+		processor.WithInfoHandler(redoRecord, withInfo)
+	}
+	return redoRecord, err
+}
+
+// Report the queue depth to observers until ctx is cancelled.
+func (processor *Processor) monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if processor.getObservers() != nil {
+			queueDepth, err := processor.G2engine.CountRedoRecords(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			stats := processor.Stats()
+			details := map[string]string{
+				"failed":    strconv.FormatInt(stats.Failed, 10),
+				"processed": strconv.FormatInt(stats.Processed, 10),
+			}
+			if err == nil {
+				details["queueDepth"] = strconv.FormatInt(queueDepth, 10)
+			}
+			processor.notify(ctx, 8001, err, details)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Take redo records until ctx is cancelled, waiting longer each time the queue is found empty.
+func (processor *Processor) work(ctx context.Context, idleInterval time.Duration, maxIdleInterval time.Duration, shutdownTimeout time.Duration) {
+	wait := idleInterval
+	for ctx.Err() == nil {
+		// A redo record taken from the queue is finished, within shutdownTimeout, after shutdown begins.
+		callCtx, cancel := context.WithTimeout(detached.WithoutCancel(ctx), shutdownTimeout)
+		redoRecord, err := processor.processRedoRecord(callCtx)
+		cancel()
+		if err == nil && len(redoRecord) > 0 {
+			atomic.AddInt64(&processor.processed, 1)
+			wait = idleInterval
+			continue
+		}
+		if err != nil {
+			atomic.AddInt64(&processor.failed, 1)
+			if processor.ErrorHandler != nil {
+				processor.ErrorHandler(err)
+			}
+			processor.notify(ctx, 8002, err, map[string]string{})
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		wait *= 2
+		if wait > maxIdleInterval {
+			wait = maxIdleInterval
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+The RegisterObserver method adds the observer to the list of observers notified by the Processor.
+Queue depth is reported with messageId 8001; failed calls are reported with messageId 8002.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (processor *Processor) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	processor.lock.Lock()
+	defer processor.lock.Unlock()
+	if processor.observers == nil {
+		processor.observers = &subject.SubjectImpl{}
+	}
+	return processor.observers.RegisterObserver(ctx, observer)
+}
+
+/*
+The Run method processes redo records until ctx is cancelled.
+After cancellation, it returns once the redo records being processed have finished,
+or ShutdownTimeout after they were taken from the queue.
+
+Input
+  - ctx: A context to control lifecycle. Cancelling it shuts the Processor down.
+
+Output
+  - nil after a graceful shutdown, or an error if the Processor is misconfigured.
+*/
+func (processor *Processor) Run(ctx context.Context) error {
+	if processor.G2engine == nil {
+		return errors.New("Processor.G2engine is required")
+	}
+	workers := processor.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	idleInterval := processor.IdleInterval
+	if idleInterval <= 0 {
+		idleInterval = DefaultIdleInterval
+	}
+	maxIdleInterval := processor.MaxIdleInterval
+	if maxIdleInterval < idleInterval {
+		maxIdleInterval = DefaultMaxIdleInterval
+		if maxIdleInterval < idleInterval {
+			maxIdleInterval = idleInterval
+		}
+	}
+	queueDepthInterval := processor.QueueDepthInterval
+	if queueDepthInterval <= 0 {
+		queueDepthInterval = DefaultQueueDepthInterval
+	}
+	shutdownTimeout := processor.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = DefaultShutdownTimeout
+	}
+	atomic.StoreInt64(&processor.failed, 0)
+	atomic.StoreInt64(&processor.processed, 0)
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		processor.monitor(ctx, queueDepthInterval)
+	}()
+	for i := 0; i < workers; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			processor.work(ctx, idleInterval, maxIdleInterval, shutdownTimeout)
+		}()
+	}
+	waitGroup.Wait()
+	return nil
+}
+
+/*
+The Stats method returns the number of redo records processed and failed by the current, or most recent, Run().
+It is safe to call while the Processor is running.
+*/
+func (processor *Processor) Stats() Stats {
+	return Stats{
+		Failed:    atomic.LoadInt64(&processor.failed),
+		Processed: atomic.LoadInt64(&processor.processed),
+	}
+}
+
+/*
+The UnregisterObserver method removes the observer from the list of observers notified by the Processor.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (processor *Processor) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	processor.lock.Lock()
+	defer processor.lock.Unlock()
+	if processor.observers == nil {
+		return nil
+	}
+	err := processor.observers.UnregisterObserver(ctx, observer)
+	if !processor.observers.HasObservers(ctx) {
+		processor.observers = nil
+	}
+	return err
+}
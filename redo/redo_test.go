@@ -0,0 +1,353 @@
+package redo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing/g2-sdk-go/g2api"
+	"github.com/stretchr/testify/assert"
+)
+
+// A G2engine holding an in-memory redo queue.
+// Methods not overridden panic, because the embedded interface is nil.
+type fakeG2engine struct {
+	g2api.G2engine
+	calls    int
+	failNext int
+	lock     sync.Mutex
+	queue    []string
+	slow     time.Duration
+}
+
+func (engine *fakeG2engine) push(redoRecords ...string) {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+	engine.queue = append(engine.queue, redoRecords...)
+}
+
+func (engine *fakeG2engine) pop(ctx context.Context) (string, error) {
+	engine.lock.Lock()
+	engine.calls++
+	if engine.failNext > 0 {
+		engine.failNext--
+		engine.lock.Unlock()
+		return "", errors.New("redo failed")
+	}
+	if len(engine.queue) == 0 {
+		engine.lock.Unlock()
+		return "", nil
+	}
+	redoRecord := engine.queue[0]
+	engine.queue = engine.queue[1:]
+	engine.lock.Unlock()
+	select {
+	case <-time.After(engine.slow):
+		return redoRecord, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (engine *fakeG2engine) getCalls() int {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+	return engine.calls
+}
+
+func (engine *fakeG2engine) CountRedoRecords(ctx context.Context) (int64, error) {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+	return int64(len(engine.queue)), nil
+}
+
+func (engine *fakeG2engine) ProcessRedoRecord(ctx context.Context) (string, error) {
+	return engine.pop(ctx)
+}
+
+func (engine *fakeG2engine) ProcessRedoRecordWithInfo(ctx context.Context, flags int64) (string, string, error) {
+	redoRecord, err := engine.pop(ctx)
+	return redoRecord, "info:" + redoRecord, err
+}
+
+// An observer that records the messages it receives.
+type testObserver struct {
+	lock     sync.Mutex
+	messages []map[string]string
+}
+
+func (observer *testObserver) GetObserverId(ctx context.Context) string {
+	return "testObserver"
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, message string) {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(message), &details)
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.messages = append(observer.messages, details)
+}
+
+func (observer *testObserver) withMessageId(messageId string) []map[string]string {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	result := []map[string]string{}
+	for _, message := range observer.messages {
+		if message["messageId"] == messageId {
+			result = append(result, message)
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func redoRecords(count int) []string {
+	result := []string{}
+	for i := 1; i <= count; i++ {
+		result = append(result, fmt.Sprintf(`{"DATA_SOURCE":"TEST","RECORD_ID":"%d"}`, i))
+	}
+	return result
+}
+
+// Run the processor in the background; the returned function stops it and waits.
+func start(ctx context.Context, processor *Processor) func() error {
+	ctx, cancel := context.WithCancel(ctx)
+	result := make(chan error, 1)
+	go func() {
+		result <- processor.Run(ctx)
+	}()
+	return func() error {
+		cancel()
+		return <-result
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func TestMain(m *testing.M) {
+	err := setup()
+	if err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	err = teardown()
+	if err != nil {
+		fmt.Print(err)
+	}
+	os.Exit(code)
+}
+
+func setup() error {
+	var err error = nil
+	return err
+}
+
+func teardown() error {
+	var err error = nil
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestProcessor_Run(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	engine.push(redoRecords(50)...)
+	processor := &Processor{
+		G2engine:     engine,
+		IdleInterval: time.Millisecond,
+		Workers:      3,
+	}
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return processor.Stats().Processed == 50 }, time.Second, time.Millisecond)
+	assert.Nil(test, stop())
+	assert.Equal(test, Stats{Processed: 50}, processor.Stats())
+}
+
+func TestProcessor_Run_IdleBackoff(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	processor := &Processor{
+		G2engine:        engine,
+		IdleInterval:    10 * time.Millisecond,
+		MaxIdleInterval: 40 * time.Millisecond,
+		Workers:         1,
+	}
+	stop := start(ctx, processor)
+	time.Sleep(200 * time.Millisecond)
+
+	// Waits of 10, 20, 40, 40, 40... allow about 7 polls, where fixed polling would make 20.
+
+	calls := engine.getCalls()
+	assert.GreaterOrEqual(test, calls, 3)
+	assert.LessOrEqual(test, calls, 10)
+
+	// New work is picked up within MaxIdleInterval.
+
+	engine.push(redoRecords(1)...)
+	assert.Eventually(test, func() bool { return processor.Stats().Processed == 1 }, time.Second, time.Millisecond)
+	assert.Nil(test, stop())
+}
+
+func TestProcessor_Run_WithInfo(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{}
+	engine.push(redoRecords(5)...)
+	var lock sync.Mutex
+	withInfos := map[string]string{}
+	processor := &Processor{
+		G2engine:     engine,
+		IdleInterval: time.Millisecond,
+		WithInfoHandler: func(redoRecord string, withInfo string) {
+			lock.Lock()
+			defer lock.Unlock()
+			withInfos[redoRecord] = withInfo
+		},
+	}
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return processor.Stats().Processed == 5 }, time.Second, time.Millisecond)
+	assert.Nil(test, stop())
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Len(test, withInfos, 5)
+	assert.Equal(test, `info:{"DATA_SOURCE":"TEST","RECORD_ID":"3"}`, withInfos[`{"DATA_SOURCE":"TEST","RECORD_ID":"3"}`])
+}
+
+func TestProcessor_Run_Errors(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{failNext: 2}
+	engine.push(redoRecords(3)...)
+	var lock sync.Mutex
+	errs := []error{}
+	observer := &testObserver{}
+	processor := &Processor{
+		ErrorHandler: func(err error) {
+			lock.Lock()
+			defer lock.Unlock()
+			errs = append(errs, err)
+		},
+		G2engine:     engine,
+		IdleInterval: time.Millisecond,
+		Workers:      1,
+	}
+	assert.Nil(test, processor.RegisterObserver(ctx, observer))
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return processor.Stats().Processed == 3 }, time.Second, time.Millisecond)
+	assert.Nil(test, stop())
+	assert.Equal(test, Stats{Failed: 2, Processed: 3}, processor.Stats())
+	lock.Lock()
+	assert.Len(test, errs, 2)
+	lock.Unlock()
+	failures := observer.withMessageId("8002")
+	assert.Len(test, failures, 2)
+	assert.Equal(test, "redo failed", failures[0]["error"])
+}
+
+func TestProcessor_Run_GracefulShutdown(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{slow: 100 * time.Millisecond}
+	engine.push(redoRecords(2)...)
+	processor := &Processor{
+		G2engine:     engine,
+		IdleInterval: time.Millisecond,
+		Workers:      2,
+	}
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return engine.getCalls() == 2 }, time.Second, time.Millisecond)
+
+	// Both redo records are in flight; shutting down waits for them.
+
+	assert.Nil(test, stop())
+	assert.Equal(test, int64(2), processor.Stats().Processed)
+}
+
+func TestProcessor_Run_ShutdownTimeout(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{slow: time.Hour}
+	engine.push(redoRecords(1)...)
+	processor := &Processor{
+		G2engine:        engine,
+		IdleInterval:    time.Millisecond,
+		ShutdownTimeout: 50 * time.Millisecond,
+		Workers:         1,
+	}
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return engine.getCalls() == 1 }, time.Second, time.Millisecond)
+
+	// The redo record in flight never finishes; shutting down gives up on it after ShutdownTimeout.
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- stop() }()
+	select {
+	case err := <-stopped:
+		assert.Nil(test, err)
+	case <-time.After(time.Second):
+		assert.FailNow(test, "shutdown was not bounded by ShutdownTimeout")
+	}
+	assert.Equal(test, Stats{Failed: 1}, processor.Stats())
+}
+
+func TestProcessor_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	engine := &fakeG2engine{slow: 5 * time.Millisecond}
+	engine.push(redoRecords(20)...)
+	observer := &testObserver{}
+	processor := &Processor{
+		G2engine:           engine,
+		IdleInterval:       time.Millisecond,
+		QueueDepthInterval: 10 * time.Millisecond,
+		Workers:            1,
+	}
+	assert.Nil(test, processor.RegisterObserver(ctx, observer))
+	stop := start(ctx, processor)
+	assert.Eventually(test, func() bool { return processor.Stats().Processed == 20 }, time.Second, time.Millisecond)
+	assert.Eventually(test, func() bool {
+		depths := observer.withMessageId("8001")
+		return len(depths) > 2 && depths[len(depths)-1]["queueDepth"] == "0"
+	}, time.Second, time.Millisecond)
+	assert.Nil(test, stop())
+	depths := observer.withMessageId("8001")
+	assert.Equal(test, "6028", depths[0]["subjectId"])
+	assert.NotEqual(test, "0", depths[0]["queueDepth"])
+	assert.Nil(test, processor.UnregisterObserver(ctx, observer))
+}
+
+func TestProcessor_Run_Misconfigured(test *testing.T) {
+	processor := &Processor{}
+	assert.NotNil(test, processor.Run(context.TODO()))
+}
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleProcessor_Run() {
+	// For more information, visit https://github.com/Senzing/g2-sdk-go-grpc/blob/main/redo/redo_test.go
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	engine := &fakeG2engine{}
+	engine.push(redoRecords(3)...)
+	processor := &Processor{
+		G2engine: engine,
+		Workers:  2,
+	}
+	err := processor.Run(ctx) // Returns after ctx is done.
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(processor.Stats().Processed)
+	// Output: 3
+}